@@ -3,6 +3,7 @@ package v1beta1
 import (
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -29,9 +30,11 @@ type HyperConvergedTuningPolicy string
 
 // HyperConvergedAnnotationTuningPolicy defines a static configuration of the kubevirt query per seconds (qps) and burst values
 // through annotation values.
+// HyperConvergedCustomTuningPolicy takes the kubevirt qps and burst values from the spec.customTuningPolicy field.
 const (
 	HyperConvergedAnnotationTuningPolicy HyperConvergedTuningPolicy = "annotation"
 	HyperConvergedHighBurstProfile       HyperConvergedTuningPolicy = "highBurst"
+	HyperConvergedCustomTuningPolicy     HyperConvergedTuningPolicy = "custom"
 )
 
 // HyperConvergedSpec defines the desired state of HyperConverged
@@ -46,12 +49,19 @@ type HyperConvergedSpec struct {
 
 	// TuningPolicy allows to configure the mode in which the RateLimits of kubevirt are set.
 	// If TuningPolicy is not present the default kubevirt values are used.
-	// It can be set to `annotation` for fine-tuning the kubevirt queryPerSeconds (qps) and burst values.
-	// Qps and burst values are taken from the annotation hco.kubevirt.io/tuningPolicy
-	// +kubebuilder:validation:Enum=annotation;highBurst
+	// It can be set to `custom` for fine-tuning the kubevirt queryPerSeconds (qps) and burst values, per component.
+	// The qps and burst values are then taken from the customTuningPolicy field.
+	// Deprecated: the `annotation` value takes the qps and burst values from the annotation hco.kubevirt.io/tuningPolicy.
+	// It is migrated to the `custom` tuning policy on upgrade; use the `custom` tuning policy instead.
+	// +kubebuilder:validation:Enum=annotation;highBurst;custom
 	// +optional
 	TuningPolicy HyperConvergedTuningPolicy `json:"tuningPolicy,omitempty"`
 
+	// CustomTuningPolicy defines the kubevirt queryPerSeconds (qps) and burst values, when the tuningPolicy field is
+	// set to `custom`.
+	// +optional
+	CustomTuningPolicy *CustomTuningPolicy `json:"customTuningPolicy,omitempty"`
+
 	// infra HyperConvergedConfig influences the pod configuration (currently only placement)
 	// for all the infra components needed on the virtualization enabled cluster
 	// but not necessarily directly on each node running VMs/VMIs.
@@ -76,6 +86,13 @@ type HyperConvergedSpec struct {
 	// +optional
 	LiveMigrationConfig LiveMigrationConfigurations `json:"liveMigrationConfig,omitempty"`
 
+	// MigrationPolicies is a list of named live migration policies. HCO translates each policy to a KubeVirt
+	// MigrationPolicy, that overrides the liveMigrationConfig fields for the VMIs it selects.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	MigrationPolicies []LiveMigrationPolicy `json:"migrationPolicies,omitempty"`
+
 	// PermittedHostDevices holds information about devices allowed for passthrough
 	// +optional
 	PermittedHostDevices *PermittedHostDevices `json:"permittedHostDevices,omitempty"`
@@ -224,6 +241,19 @@ type HyperConvergedSpec struct {
 	// +optional
 	CommonBootImageNamespace *string `json:"commonBootImageNamespace,omitempty"`
 
+	// BootImageRegistryMirrors maps registry prefixes of the golden images sources to mirror registries, e.g. in
+	// disconnected clusters. The registry URLs of the DataImportCronTemplates in the SSP CR are rewritten using the
+	// longest matching prefix.
+	// +listType=map
+	// +listMapKey=source
+	// +optional
+	BootImageRegistryMirrors []RegistryMirror `json:"bootImageRegistryMirrors,omitempty"`
+
+	// BootImageArchitecturePolicy controls which CPU architectures are imported for the golden images, in
+	// heterogeneous clusters. It is only used when the enableMultiArchBootImageImport feature gate is enabled.
+	// +optional
+	BootImageArchitecturePolicy *BootImageArchitecturePolicy `json:"bootImageArchitecturePolicy,omitempty"`
+
 	// KSMConfiguration holds the information regarding
 	// the enabling the KSM in the nodes (if available).
 	// +optional
@@ -277,8 +307,257 @@ type HyperConvergedSpec struct {
 	// max guest memory and max hotplug ratio. This setting can affect VM CPU and memory settings.
 	// +optional
 	LiveUpdateConfiguration *v1.LiveUpdateConfiguration `json:"liveUpdateConfiguration,omitempty"`
+
+	// UnmanagedOperands is a list of operands that HCO stops reconciling; e.g. during an incident response. HCO does
+	// not create, update or revert modifications of the listed operand CRs, but it still reports their conditions.
+	// While this list is not empty, the UnmanagedOperands condition is set in the HyperConverged status.
+	// Note: HCO upgrade can't be completed while an operand is unmanaged.
+	// +listType=set
+	// +optional
+	UnmanagedOperands []OperandKind `json:"unmanagedOperands,omitempty"`
+
+	// ConfigRolloutStrategy defines how HCO rolls out changes of the workloads and the workloadUpdateStrategy fields.
+	// By default, the changes are applied to the whole cluster at once.
+	// +optional
+	ConfigRolloutStrategy *ConfigRolloutStrategy `json:"configRolloutStrategy,omitempty"`
+
+	// AlertSilences is a list of Alertmanager silences that HCO maintains, in addition to the silences that HCO
+	// declares on its own. HCO recreates a silence that was deleted, renews it before it expires, and deletes the
+	// silences that are removed from this list.
+	// +listType=atomic
+	// +optional
+	AlertSilences []AlertSilence `json:"alertSilences,omitempty"`
+
+	// AlertRuleOverrides modifies or disables the alerts that HCO deploys; e.g. to change the threshold, the duration
+	// or the severity of an alert. An invalid override is ignored, and reported in the ObservabilityReconciled
+	// condition, or by a warning event; the other overrides are still applied.
+	// +listType=map
+	// +listMapKey=alert
+	// +optional
+	AlertRuleOverrides []AlertRuleOverride `json:"alertRuleOverrides,omitempty"`
+
+	// ServiceLevelObjectives are the SLOs of the virtualization service. HCO generates the SLO recording rules and the
+	// multi-window burn-rate alerts of each objective.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ServiceLevelObjectives []ServiceLevelObjective `json:"serviceLevelObjectives,omitempty"`
+}
+
+// AlertSilence is an Alertmanager silence that HCO maintains
+// +kubebuilder:validation:XValidation:rule="!has(self.duration) || duration(self.duration) >= duration('1h')",message="duration must be at least 1h"
+// +k8s:openapi-gen=true
+type AlertSilence struct {
+	// Comment describes the reason for the silence. HCO identifies the silence by its comment and matchers.
+	// +kubebuilder:validation:MinLength=1
+	Comment string `json:"comment"`
+
+	// Matchers select the alerts to silence. An alert is silenced if it matches all the matchers.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Matchers []AlertSilenceMatcher `json:"matchers"`
+
+	// Duration is the length of the silence. HCO renews the silence before it expires, so the duration only limits
+	// how long the silence stays in effect if HCO stops renewing it; e.g. when HCO is removed. If not set, the
+	// silence never expires.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// AlertSilenceMatcher matches the alerts by one of their labels
+// +k8s:openapi-gen=true
+type AlertSilenceMatcher struct {
+	// Name is the name of the alert label
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Value is the value to compare the label value with
+	Value string `json:"value"`
+
+	// IsRegex if true, the value is a regular expression
+	// +optional
+	IsRegex bool `json:"isRegex,omitempty"`
+
+	// IsNotEqual if true, the matcher selects the alerts that their label does not match the value
+	// +optional
+	IsNotEqual bool `json:"isNotEqual,omitempty"`
 }
 
+// AlertRuleOverride modifies or disables one of the alerts that HCO deploys
+// +kubebuilder:validation:XValidation:rule="!has(self.for) || duration(self.for) >= duration('0s')",message="for must not be negative"
+// +k8s:openapi-gen=true
+type AlertRuleOverride struct {
+	// Alert is the name of the alert to modify
+	// +kubebuilder:validation:MinLength=1
+	Alert string `json:"alert"`
+
+	// Disabled if true, the alert is removed
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Threshold replaces the threshold of the alert. Only supported by the alerts with a configurable threshold:
+	// HighCPUWorkload, HighNodeCPUFrequency and PersistentVolumeFillingUp. The threshold is a ratio between 0 and 1,
+	// and not a percentage. HighCPUWorkload and HighNodeCPUFrequency fire above the threshold; e.g. "0.95" for 95% of
+	// the CPU utilization or of the maximum CPU frequency. PersistentVolumeFillingUp fires below the threshold of the
+	// available space of the volume; e.g. "0.05" for less than 5% of free space (default: "0.1").
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	// +optional
+	Threshold *string `json:"threshold,omitempty"`
+
+	// For replaces the duration that the alert condition must be met before the alert fires
+	// +optional
+	For *metav1.Duration `json:"for,omitempty"`
+
+	// Severity replaces the severity of the alert
+	// +kubebuilder:validation:Enum=critical;warning;info
+	// +optional
+	Severity *string `json:"severity,omitempty"`
+
+	// Labels are added to the labels of the alert. The severity, operator_health_impact,
+	// kubernetes_operator_part_of and kubernetes_operator_component labels can't be modified.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// ServiceLevelIndicator is the measured aspect of the virtualization service, of a service level objective
+// +kubebuilder:validation:Enum=VMAvailability;LiveMigrationSuccess;BootImageFreshness
+type ServiceLevelIndicator string
+
+const (
+	// VMAvailabilityIndicator is the ratio of the virtual machine instances that are not in the Failed or in the
+	// Unknown phase
+	VMAvailabilityIndicator ServiceLevelIndicator = "VMAvailability"
+	// LiveMigrationSuccessIndicator is the ratio of the completed live migrations that succeeded
+	LiveMigrationSuccessIndicator ServiceLevelIndicator = "LiveMigrationSuccess"
+	// BootImageFreshnessIndicator is the ratio of the golden images that are up to date with their source registry
+	BootImageFreshnessIndicator ServiceLevelIndicator = "BootImageFreshness"
+)
+
+// ServiceLevelObjective is the target ratio of the good events of a service level indicator, over a time window
+// +kubebuilder:validation:XValidation:rule="!has(self.window) || duration(self.window) >= duration('24h')",message="window must be at least 24h"
+// +k8s:openapi-gen=true
+type ServiceLevelObjective struct {
+	// Name identifies the objective. It is set as the slo label of the recording rules and the alerts of the
+	// objective.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Indicator is the measured aspect of the service; one of VMAvailability, LiveMigrationSuccess or
+	// BootImageFreshness.
+	Indicator ServiceLevelIndicator `json:"indicator"`
+
+	// Objective is the target percentage of the good events; e.g. "99.9". Must be greater than 0 and less than 100.
+	// +kubebuilder:validation:Pattern=`^[0-9]{1,2}(\.[0-9]+)?$`
+	Objective string `json:"objective"`
+
+	// Window is the time window of the objective. The error budget, and the burn rates of the alerts, are computed
+	// for this window.
+	// +kubebuilder:default="720h0m0s"
+	// +default="720h0m0s"
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+}
+
+// ConfigRolloutType is the type of the configuration rollout
+// +kubebuilder:validation:Enum=Immediate;Canary
+type ConfigRolloutType string
+
+const (
+	// ConfigRolloutImmediate applies the configuration changes to the whole cluster at once
+	ConfigRolloutImmediate ConfigRolloutType = "Immediate"
+	// ConfigRolloutCanary applies the configuration changes to a canary node pool first
+	ConfigRolloutCanary ConfigRolloutType = "Canary"
+)
+
+// ConfigRolloutStrategy defines how HCO rolls out changes of the workloads and the workloadUpdateStrategy fields.
+//
+// In the Canary mode, a new workloads node placement is first applied to the nodes that match the canaryNodeSelector,
+// while the other nodes keep the previous node placement. If all the components stay healthy for the bakeTime, HCO
+// applies the change to the whole cluster. If a component that runs the workloads becomes degraded during the canary
+// phase, or the components are not healthy before the progressDeadline, HCO rolls the change back.
+//
+// KubeVirt has no per-node workload update strategy, so the previous workloadUpdateStrategy is kept for the whole
+// cluster during the canary phase, and the new one is applied when the change is promoted. The maintenance windows are
+// not subject to the rollout strategy.
+// +kubebuilder:validation:XValidation:rule="self.type != 'Canary' || (has(self.canaryNodeSelector) && size(self.canaryNodeSelector) > 0)",message="canaryNodeSelector is required for the Canary rollout type"
+// +k8s:openapi-gen=true
+type ConfigRolloutStrategy struct {
+	// Type is the rollout type; one of Immediate or Canary.
+	// +kubebuilder:default=Immediate
+	// +default="Immediate"
+	// +optional
+	Type ConfigRolloutType `json:"type,omitempty"`
+
+	// CanaryNodeSelector selects the nodes of the canary node pool, by their labels.
+	// +optional
+	CanaryNodeSelector map[string]string `json:"canaryNodeSelector,omitempty"`
+
+	// BakeTime is how long all the components must stay healthy with the canary configuration, before the change is
+	// applied to the whole cluster.
+	// +kubebuilder:default="10m"
+	// +default="10m"
+	// +optional
+	BakeTime *metav1.Duration `json:"bakeTime,omitempty"`
+
+	// ProgressDeadline is the maximum time for the components to become healthy with the canary configuration. HCO
+	// rolls the change back if the components are not healthy by then.
+	// +kubebuilder:default="30m"
+	// +default="30m"
+	// +optional
+	ProgressDeadline *metav1.Duration `json:"progressDeadline,omitempty"`
+}
+
+// CustomTuningPolicy defines the rate limiters of the REST clients of the kubevirt components. A component that is not
+// explicitly set, uses the default rate limiter. If the default rate limiter is not set either, the kubevirt default
+// values are used for this component.
+// +k8s:openapi-gen=true
+type CustomTuningPolicy struct {
+	// Default is the rate limiter of all the components that are not explicitly set
+	// +optional
+	Default *ComponentRateLimiter `json:"default,omitempty"`
+
+	// API is the rate limiter of the virt-api component
+	// +optional
+	API *ComponentRateLimiter `json:"api,omitempty"`
+
+	// Controller is the rate limiter of the virt-controller component
+	// +optional
+	Controller *ComponentRateLimiter `json:"controller,omitempty"`
+
+	// Handler is the rate limiter of the virt-handler component
+	// +optional
+	Handler *ComponentRateLimiter `json:"handler,omitempty"`
+
+	// Webhook is the rate limiter of the kubevirt webhooks
+	// +optional
+	Webhook *ComponentRateLimiter `json:"webhook,omitempty"`
+}
+
+// ComponentRateLimiter defines the token bucket rate limiter of the REST client of a kubevirt component
+// +k8s:openapi-gen=true
+type ComponentRateLimiter struct {
+	// QPS is the number of queries per second the component is allowed to send to the API server. It may be a
+	// fractional number; e.g. 0.5, or 500m.
+	QPS resource.Quantity `json:"qps"`
+
+	// Burst is the maximum number of queries the component is allowed to send to the API server at once
+	// +kubebuilder:validation:Minimum=1
+	Burst uint32 `json:"burst"`
+}
+
+// OperandKind is the kind of an operand CR, that HCO deploys
+// +kubebuilder:validation:Enum=KubeVirt;CDI;NetworkAddonsConfig;SSP;AAQ
+type OperandKind string
+
+const (
+	OperandKindKubeVirt            OperandKind = "KubeVirt"
+	OperandKindCDI                 OperandKind = "CDI"
+	OperandKindNetworkAddonsConfig OperandKind = "NetworkAddonsConfig"
+	OperandKindSSP                 OperandKind = "SSP"
+	OperandKindAAQ                 OperandKind = "AAQ"
+)
+
 // CertRotateConfigCA contains the tunables for TLS certificates.
 // +k8s:openapi-gen=true
 type CertRotateConfigCA struct {
@@ -386,6 +665,11 @@ type LiveMigrationConfigurations struct {
 	// +optional
 	Network *string `json:"network,omitempty"`
 
+	// DedicatedNetwork makes HCO create and own a NetworkAttachmentDefinition for the live migrations, and use it as
+	// the migration network. It can't be set together with the network field.
+	// +optional
+	DedicatedNetwork *LiveMigrationNetwork `json:"dedicatedNetwork,omitempty"`
+
 	// AllowAutoConverge allows the platform to compromise performance/availability of VMIs to
 	// guarantee successful VMI live migrations. Defaults to false
 	// +optional
@@ -689,6 +973,137 @@ type StorageImportConfig struct {
 	InsecureRegistries []string `json:"insecureRegistries,omitempty"`
 }
 
+// LiveMigrationNetwork is a dedicated network for the live migrations, that is provisioned by HCO
+// +k8s:openapi-gen=true
+type LiveMigrationNetwork struct {
+	// Interface is the name of the node network interface, that the migration network is attached to. It must exist
+	// on all the nodes that run virtual machines.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern=`^[^\s/:]+$`
+	Interface string `json:"interface"`
+
+	// VLAN is the VLAN ID of the migration network. If set, the migration traffic is tagged with this VLAN ID on the
+	// node interface.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	// +optional
+	VLAN *int32 `json:"vlan,omitempty"`
+
+	// IPRange is the CIDR of the IP addresses to allocate to the migration network interfaces of virt-handler; e.g.
+	// "10.200.5.0/24". The addresses are allocated by the whereabouts IPAM plugin.
+	// +kubebuilder:validation:MinLength=1
+	IPRange string `json:"ipRange"`
+
+	// ExcludeRanges is a list of CIDRs within the IP range, that must not be allocated.
+	// +listType=set
+	// +optional
+	ExcludeRanges []string `json:"excludeRanges,omitempty"`
+}
+
+// LiveMigrationPolicy is a named live migration policy, for the VMIs selected by its namespace and VMI selectors
+// +k8s:openapi-gen=true
+type LiveMigrationPolicy struct {
+	// Name is the name of the policy. The name of the KubeVirt MigrationPolicy is "hco-policy-<name>".
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// NamespaceSelector selects the namespaces of the VMIs, by their labels. All the labels must match.
+	// +optional
+	NamespaceSelector map[string]string `json:"namespaceSelector,omitempty"`
+
+	// VirtualMachineInstanceSelector selects the VMIs, by their labels. All the labels must match.
+	// +optional
+	VirtualMachineInstanceSelector map[string]string `json:"virtualMachineInstanceSelector,omitempty"`
+
+	// AllowAutoConverge allows the platform to compromise performance/availability of VMIs to guarantee successful
+	// VMI live migrations.
+	// +optional
+	AllowAutoConverge *bool `json:"allowAutoConverge,omitempty"`
+
+	// AllowPostCopy enables post-copy live migrations. Such migrations allow even the busiest VMIs to successfully
+	// live-migrate. However, events like a network failure can cause a VMI crash.
+	// +optional
+	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
+
+	// BandwidthPerMigration limits the amount of network bandwidth live migrations are allowed to use.
+	// +optional
+	BandwidthPerMigration *resource.Quantity `json:"bandwidthPerMigration,omitempty"`
+
+	// CompletionTimeoutPerGiB is the time, in seconds, per GiB of memory, a live migration is allowed to take before
+	// it is cancelled.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CompletionTimeoutPerGiB *int64 `json:"completionTimeoutPerGiB,omitempty"`
+}
+
+// RegistryMirror maps a registry prefix to a mirror registry
+// +k8s:openapi-gen=true
+type RegistryMirror struct {
+	// Source is a registry, or a registry and a repository prefix, to be replaced; e.g. "quay.io" or
+	// "quay.io/containerdisks"
+	// +kubebuilder:validation:MinLength=1
+	Source string `json:"source"`
+
+	// Mirror is the registry, or the registry and the repository prefix, that replaces the source; e.g.
+	// "mirror.example.com:5000/containerdisks"
+	// +kubebuilder:validation:MinLength=1
+	Mirror string `json:"mirror"`
+
+	// Insecure adds the host of the mirror to the insecure registries of CDI. The host of a mirror of a registry that
+	// is listed in storageImport.insecureRegistries is added anyway.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// BootImageArchitecturePolicy is the architecture policy of the golden images. The policy fields apply to all the
+// golden images, unless overridden for a specific DataImportCronTemplate.
+// +k8s:openapi-gen=true
+type BootImageArchitecturePolicy struct {
+	ArchitecturePolicy `json:",inline"`
+
+	// Templates overrides the policy fields for specific DataImportCronTemplates, by their names. A field that is not
+	// set in the override, is taken from the policy.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Templates []DataImportCronTemplateArchitecturePolicy `json:"templates,omitempty"`
+}
+
+// ArchitecturePolicy selects the CPU architectures to import for a golden image
+// +k8s:openapi-gen=true
+type ArchitecturePolicy struct {
+	// Architectures is the list of the CPU architectures to import. An architecture that is not supported by the image,
+	// or that has no workload nodes, is ignored. If not set, all the architectures of the workload nodes, that the image
+	// supports, are imported.
+	// +listType=set
+	// +optional
+	Architectures []string `json:"architectures,omitempty"`
+
+	// PreferredArchitecture is the default architecture of the golden image; i.e. the architecture of the image that
+	// the DataSource without an architecture suffix points to, if the image is imported for this architecture.
+	// +optional
+	PreferredArchitecture string `json:"preferredArchitecture,omitempty"`
+
+	// MinArchitectures is the minimum number of architectures that a golden image must be imported for. A golden
+	// image with fewer architectures is not deployed.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinArchitectures *int32 `json:"minArchitectures,omitempty"`
+}
+
+// DataImportCronTemplateArchitecturePolicy is the architecture policy of a specific DataImportCronTemplate
+// +k8s:openapi-gen=true
+type DataImportCronTemplateArchitecturePolicy struct {
+	// Name is the name of the DataImportCronTemplate
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	ArchitecturePolicy `json:",inline"`
+}
+
 // HyperConvergedWorkloadUpdateStrategy defines options related to updating a KubeVirt install
 //
 // +k8s:openapi-gen=true
@@ -720,6 +1135,28 @@ type HyperConvergedWorkloadUpdateStrategy struct {
 	// +default="1m0s"
 	// +optional
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+
+	// MaintenanceWindows restricts automated workload updates, and the live migrations triggered by HCO upgrades,
+	// to the listed time windows. Outside all the windows, HCO configures KubeVirt with no workload update
+	// methods, and reports the HyperConverged Operator as not upgradeable, until the next window opens.
+	// When empty, workload updates and upgrades are allowed at any time.
+	//
+	// +listType=atomic
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// MaintenanceWindow is a recurring time window, in which disruptive workload updates are allowed
+// +k8s:openapi-gen=true
+type MaintenanceWindow struct {
+	// Schedule is a cron expression, in the standard five-field format (minute, hour, day of month, month and
+	// day of week), that defines when the window opens. The schedule is evaluated in UTC.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window stays open, after each time it opens.
+	Duration metav1.Duration `json:"duration"`
 }
 
 // HyperConvergedStatus defines the observed state of HyperConverged
@@ -764,6 +1201,13 @@ type HyperConvergedStatus struct {
 	// +optional
 	SystemHealthStatus string `json:"systemHealthStatus,omitempty"`
 
+	// ComponentsHealth reflects the health of each of the components that HCO deploys, based on the component's own
+	// conditions. Unlike SystemHealthStatus, it shows all the unhealthy components, and not only the last one.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ComponentsHealth []ComponentHealth `json:"componentsHealth,omitempty"`
+
 	// InfrastructureHighlyAvailable describes whether the cluster has only one worker node
 	// (false) or more (true).
 	// +optional
@@ -771,6 +1215,158 @@ type HyperConvergedStatus struct {
 
 	// NodeInfo holds information about the cluster nodes
 	NodeInfo NodeInfoStatus `json:"nodeInfo,omitempty"`
+
+	// OperandDrifts is a list of the latest out-of-band modifications of the operand CRs, that were reverted by HCO.
+	// The list is limited to the 10 latest modifications, ordered from the oldest to the newest.
+	// +listType=atomic
+	// +optional
+	OperandDrifts []OperandDrift `json:"operandDrifts,omitempty"`
+
+	// UpgradeHistory is a list of the latest HCO upgrades, with the modifications that HCO applied on its own during
+	// each upgrade. The list is limited to the 5 latest upgrades, ordered from the oldest to the newest.
+	// +listType=atomic
+	// +optional
+	UpgradeHistory []UpgradeHistoryEntry `json:"upgradeHistory,omitempty"`
+
+	// ConfigRollout is the state of the configuration rollout, when the Canary configRolloutStrategy is used.
+	// +optional
+	ConfigRollout *ConfigRolloutStatus `json:"configRollout,omitempty"`
+}
+
+// ComponentHealth is the health of a single component that HCO deploys
+type ComponentHealth struct {
+	// Name is the name of the component; e.g. KubeVirt, CDI or WaspAgent
+	Name string `json:"name"`
+
+	// HealthStatus is the health of the component: healthy, warning or error
+	HealthStatus string `json:"healthStatus"`
+
+	// Conditions are the Available, Progressing and Degraded conditions of the component
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ConfigRolloutPhase is the phase of the configuration rollout
+type ConfigRolloutPhase string
+
+const (
+	// ConfigRolloutPhaseCanary means that the new configuration is applied to the canary node pool only
+	ConfigRolloutPhaseCanary ConfigRolloutPhase = "Canary"
+	// ConfigRolloutPhaseCompleted means that the configuration is applied to the whole cluster
+	ConfigRolloutPhaseCompleted ConfigRolloutPhase = "Completed"
+	// ConfigRolloutPhaseRolledBack means that the new configuration failed in the canary node pool, and was rolled back
+	ConfigRolloutPhaseRolledBack ConfigRolloutPhase = "RolledBack"
+)
+
+// ConfigRolloutStatus is the state of the configuration rollout
+type ConfigRolloutStatus struct {
+	// Phase is the current phase of the rollout
+	Phase ConfigRolloutPhase `json:"phase"`
+
+	// StableConfigHash is the hash of the latest workloads configuration that was applied to the whole cluster
+	StableConfigHash string `json:"stableConfigHash"`
+
+	// StableNodePlacement is the workloads node placement of the stable configuration. It is kept out of the canary
+	// node pool during the canary phase, and restored on a rollback.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	StableNodePlacement *sdkapi.NodePlacement `json:"stableNodePlacement,omitempty"`
+
+	// StableWorkloadUpdateStrategy is the workload update strategy of the stable configuration, without the
+	// maintenance windows. It is applied to the whole cluster until the new configuration is promoted.
+	// +optional
+	StableWorkloadUpdateStrategy *HyperConvergedWorkloadUpdateStrategy `json:"stableWorkloadUpdateStrategy,omitempty"`
+
+	// TargetConfigHash is the hash of the workloads configuration that is currently being rolled out, or that was
+	// rolled back
+	// +optional
+	TargetConfigHash string `json:"targetConfigHash,omitempty"`
+
+	// CanaryStartTime is the time when the canary phase of the current rollout started
+	// +optional
+	CanaryStartTime *metav1.Time `json:"canaryStartTime,omitempty"`
+
+	// CanaryHealthySince is the time since all the components are healthy with the canary configuration
+	// +optional
+	CanaryHealthySince *metav1.Time `json:"canaryHealthySince,omitempty"`
+
+	// Message is a human-readable description of the rollout state
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// UpgradeHistoryEntry describes the modifications that HCO applied during a single upgrade
+type UpgradeHistoryEntry struct {
+	// FromVersion is the HCO version before the upgrade
+	FromVersion string `json:"fromVersion"`
+
+	// ToVersion is the HCO version after the upgrade
+	ToVersion string `json:"toVersion"`
+
+	// StartTime is the time when HCO started the upgrade
+	StartTime metav1.Time `json:"startTime"`
+
+	// AppliedPatches is the list of the upgrade patches that were applied to the HyperConverged CR
+	// +listType=atomic
+	// +optional
+	AppliedPatches []UpgradePatchRecord `json:"appliedPatches,omitempty"`
+
+	// SkippedPatches is the list of the upgrade patches that were not applied to the HyperConverged CR, because one of
+	// their test operations failed
+	// +listType=atomic
+	// +optional
+	SkippedPatches []UpgradePatchRecord `json:"skippedPatches,omitempty"`
+
+	// RemovedObjects is the list of the leftover objects that were deleted by HCO during the upgrade
+	// +listType=atomic
+	// +optional
+	RemovedObjects []corev1.ObjectReference `json:"removedObjects,omitempty"`
+}
+
+// UpgradePatchRecord identifies a single upgrade patch
+type UpgradePatchRecord struct {
+	// Index is the index of the patch in the upgrade patch list
+	Index int `json:"index"`
+
+	// SemverRange is the range of the source versions affected by the patch
+	SemverRange string `json:"semverRange"`
+}
+
+// OperandDrift describes an out-of-band modification of an operand CR, that was reverted by HCO
+type OperandDrift struct {
+	// Kind is the kind of the modified operand CR
+	Kind string `json:"kind"`
+
+	// Name is the name of the modified operand CR
+	Name string `json:"name"`
+
+	// RevertTime is the time when HCO reverted the modification
+	RevertTime metav1.Time `json:"revertTime"`
+
+	// Fields is the list of the reverted fields. The list is limited to the first 10 fields.
+	// +listType=atomic
+	// +optional
+	Fields []DriftedField `json:"fields,omitempty"`
+}
+
+// DriftedField describes a single field of an operand CR, that was modified out-of-band and then reverted by HCO
+type DriftedField struct {
+	// Path is the JSON pointer of the field in the operand CR
+	Path string `json:"path"`
+
+	// Previous is the JSON representation of the modified value, before HCO reverted it. Empty if the field was
+	// added out-of-band. Long values are truncated.
+	// +optional
+	Previous string `json:"previous,omitempty"`
+
+	// Desired is the JSON representation of the value that HCO restored. Empty if HCO removed the field. Long values
+	// are truncated.
+	// +optional
+	Desired string `json:"desired,omitempty"`
 }
 
 type Version struct {
@@ -805,6 +1401,44 @@ type DataImportCronStatus struct {
 	// OriginalSupportedArchitectures is a comma-separated list of CPU architectures that the original
 	// template supports.
 	OriginalSupportedArchitectures string `json:"originalSupportedArchitectures,omitempty"`
+
+	// Source is the catalog that a common template was read from: "built-in" for the templates that are shipped
+	// with HCO, "configmap/<name>" for a catalog ConfigMap in the HCO namespace, or "oci/<reference>" for a catalog
+	// image in the OCI image layout directory, where the reference is the image reference name, or the manifest digest
+	// if the image has no reference name. Empty for custom templates.
+	Source string `json:"source,omitempty"`
+
+	// Import is the live state of the DataImportCron and of the DataSource that were created from the
+	// DataImportCronTemplate. It is empty until the DataImportCron is created.
+	// +optional
+	Import *DataImportCronImportStatus `json:"import,omitempty"`
+}
+
+// DataImportCronImportStatus aggregates the state of a DataImportCron and of its managed DataSource
+type DataImportCronImportStatus struct {
+	// LastImportTimestamp is the time of the last successful import
+	// +optional
+	LastImportTimestamp *metav1.Time `json:"lastImportTimestamp,omitempty"`
+
+	// CurrentDigest is the digest of the image that is currently being imported, or of the last imported image, if
+	// there is no import in progress
+	// +optional
+	CurrentDigest string `json:"currentDigest,omitempty"`
+
+	// UpToDate indicates whether the last imported image is the latest image in the source registry
+	UpToDate bool `json:"upToDate"`
+
+	// FailureReason is the reason reported by CDI, when the DataImportCron is not up-to-date, and there is no
+	// import in progress
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// SourceKind is the kind of the disk image source of the DataSource: "PersistentVolumeClaim" or "VolumeSnapshot"
+	// +optional
+	SourceKind string `json:"sourceKind,omitempty"`
+
+	// SourceReady indicates whether the DataSource, and the PVC or snapshot it points to, are ready to be used
+	SourceReady bool `json:"sourceReady"`
 }
 
 // DataImportCronTemplate defines the template type for DataImportCrons.
@@ -885,6 +1519,20 @@ const (
 	// has been applied to the HyperConverged resource via a specialized annotation.
 	// This condition is exposed only when its value is True, and is otherwise hidden.
 	ConditionTaintedConfiguration = "TaintedConfiguration"
+
+	// ConditionUnmanagedOperands indicates that HCO does not reconcile some of its operands, as requested in the
+	// spec.unmanagedOperands field. This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionUnmanagedOperands = "UnmanagedOperands"
+
+	// ConditionConfigRollout reports the progress of the canary configuration rollout. It is True while a new
+	// configuration is applied to the canary node pool only. This condition is exposed only when the Canary
+	// configRolloutStrategy is used.
+	ConditionConfigRollout = "ConfigRollout"
+
+	// ConditionObservabilityReconciled reports the outcome of the last reconciliation of the observability
+	// controller, that maintains the alertmanager silences and the PrometheusRule of the observability alerts. This
+	// condition is exposed only when the observability controller is running.
+	ConditionObservabilityReconciled = "ObservabilityReconciled"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
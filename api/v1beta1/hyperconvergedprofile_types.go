@@ -0,0 +1,118 @@
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// HyperConvergedProfileName is the only allowed name of a HyperConvergedProfile resource. It allows a single
+// HyperConvergedProfile in each namespace.
+const HyperConvergedProfileName = "kubevirt-hyperconverged-profile"
+
+// HyperConvergedProfileSpec defines the VM defaults of a single namespace. Each one of the fields overrides the
+// matching cluster-wide default of the HyperConverged CR, for the VMs in the namespace.
+// +k8s:openapi-gen=true
+type HyperConvergedProfileSpec struct {
+	// EvictionStrategy is the eviction strategy of the VMIs in the namespace, that do not set their own eviction
+	// strategy. Overrides the evictionStrategy field of the HyperConverged CR.
+	// +kubebuilder:validation:Enum=None;LiveMigrate;LiveMigrateIfPossible;External
+	// +optional
+	EvictionStrategy *v1.EvictionStrategy `json:"evictionStrategy,omitempty"`
+
+	// DefaultCPUModel is the CPU model of the VMIs in the namespace, that do not set their own CPU model. Overrides
+	// the defaultCPUModel field of the HyperConverged CR.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	DefaultCPUModel *string `json:"defaultCPUModel,omitempty"`
+
+	// DefaultRuntimeClass is the RuntimeClass of the virt-launcher pods in the namespace, when the cluster-wide
+	// defaultRuntimeClass field of the HyperConverged CR is not set.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	DefaultRuntimeClass *string `json:"defaultRuntimeClass,omitempty"`
+
+	// LiveMigrationPolicy is the live migration policy of the VMIs in the namespace. HCO translates it to a KubeVirt
+	// MigrationPolicy, that selects the namespace. Overrides the liveMigrationConfig field of the HyperConverged CR.
+	// +optional
+	LiveMigrationPolicy *ProfileLiveMigrationPolicy `json:"liveMigrationPolicy,omitempty"`
+}
+
+// ProfileLiveMigrationPolicy is the live migration policy of the VMIs in a single namespace
+// +k8s:openapi-gen=true
+type ProfileLiveMigrationPolicy struct {
+	// AllowAutoConverge allows the platform to compromise performance/availability of VMIs to guarantee successful
+	// VMI live migrations.
+	// +optional
+	AllowAutoConverge *bool `json:"allowAutoConverge,omitempty"`
+
+	// AllowPostCopy enables post-copy live migrations. Such migrations allow even the busiest VMIs to successfully
+	// live-migrate. However, events like a network failure can cause a VMI crash.
+	// +optional
+	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
+
+	// BandwidthPerMigration limits the amount of network bandwidth live migrations are allowed to use.
+	// +optional
+	BandwidthPerMigration *resource.Quantity `json:"bandwidthPerMigration,omitempty"`
+
+	// CompletionTimeoutPerGiB is the time, in seconds, per GiB of memory, a live migration is allowed to take before
+	// it is cancelled.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CompletionTimeoutPerGiB *int64 `json:"completionTimeoutPerGiB,omitempty"`
+}
+
+// HyperConvergedProfileStatus defines the observed state of a HyperConvergedProfile
+// +k8s:openapi-gen=true
+type HyperConvergedProfileStatus struct {
+	// Conditions describes the state of the HyperConvergedProfile resource.
+	// +listType=atomic
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration reflects the HyperConvergedProfile resource generation. If the ObservedGeneration is less
+	// than the resource generation in metadata, the status is out of date
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// MigrationPolicy is the name of the KubeVirt MigrationPolicy that HCO created from the liveMigrationPolicy field.
+	// Empty if the liveMigrationPolicy field is not set.
+	// +optional
+	MigrationPolicy string `json:"migrationPolicy,omitempty"`
+}
+
+const (
+	// ConditionProfileReconciled indicates whether HCO successfully applied the HyperConvergedProfile.
+	ConditionProfileReconciled = "Reconciled"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HyperConvergedProfile is the Schema for the hyperconvergedprofiles API. It overrides a subset of the VM defaults
+// of the HyperConverged CR, for a single namespace.
+// +k8s:openapi-gen=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:resource:scope=Namespaced,shortName={hcprofile,hcprofiles}
+// +kubebuilder:subresource:status
+type HyperConvergedProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec   HyperConvergedProfileSpec   `json:"spec,omitempty"`
+	Status HyperConvergedProfileStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HyperConvergedProfileList contains a list of HyperConvergedProfile
+type HyperConvergedProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HyperConvergedProfile `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HyperConvergedProfile{}, &HyperConvergedProfileList{})
+}
@@ -34,6 +34,86 @@ import (
 	corev1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleOverride) DeepCopyInto(out *AlertRuleOverride) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(string)
+		**out = **in
+	}
+	if in.For != nil {
+		in, out := &in.For, &out.For
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleOverride.
+func (in *AlertRuleOverride) DeepCopy() *AlertRuleOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilence) DeepCopyInto(out *AlertSilence) {
+	*out = *in
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]AlertSilenceMatcher, len(*in))
+		copy(*out, *in)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilence.
+func (in *AlertSilence) DeepCopy() *AlertSilence {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilenceMatcher) DeepCopyInto(out *AlertSilenceMatcher) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilenceMatcher.
+func (in *AlertSilenceMatcher) DeepCopy() *AlertSilenceMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilenceMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationAwareConfigurations) DeepCopyInto(out *ApplicationAwareConfigurations) {
 	*out = *in
@@ -60,6 +140,56 @@ func (in *ApplicationAwareConfigurations) DeepCopy() *ApplicationAwareConfigurat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchitecturePolicy) DeepCopyInto(out *ArchitecturePolicy) {
+	*out = *in
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinArchitectures != nil {
+		in, out := &in.MinArchitectures, &out.MinArchitectures
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchitecturePolicy.
+func (in *ArchitecturePolicy) DeepCopy() *ArchitecturePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchitecturePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootImageArchitecturePolicy) DeepCopyInto(out *BootImageArchitecturePolicy) {
+	*out = *in
+	in.ArchitecturePolicy.DeepCopyInto(&out.ArchitecturePolicy)
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]DataImportCronTemplateArchitecturePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootImageArchitecturePolicy.
+func (in *BootImageArchitecturePolicy) DeepCopy() *BootImageArchitecturePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BootImageArchitecturePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertRotateConfigCA) DeepCopyInto(out *CertRotateConfigCA) {
 	*out = *in
@@ -112,6 +242,173 @@ func (in *CertRotateConfigServer) DeepCopy() *CertRotateConfigServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentHealth) DeepCopyInto(out *ComponentHealth) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentHealth.
+func (in *ComponentHealth) DeepCopy() *ComponentHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentRateLimiter) DeepCopyInto(out *ComponentRateLimiter) {
+	*out = *in
+	out.QPS = in.QPS.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentRateLimiter.
+func (in *ComponentRateLimiter) DeepCopy() *ComponentRateLimiter {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentRateLimiter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRolloutStatus) DeepCopyInto(out *ConfigRolloutStatus) {
+	*out = *in
+	if in.StableNodePlacement != nil {
+		in, out := &in.StableNodePlacement, &out.StableNodePlacement
+		*out = (*in).DeepCopy()
+	}
+	if in.StableWorkloadUpdateStrategy != nil {
+		in, out := &in.StableWorkloadUpdateStrategy, &out.StableWorkloadUpdateStrategy
+		*out = new(HyperConvergedWorkloadUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CanaryStartTime != nil {
+		in, out := &in.CanaryStartTime, &out.CanaryStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CanaryHealthySince != nil {
+		in, out := &in.CanaryHealthySince, &out.CanaryHealthySince
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRolloutStatus.
+func (in *ConfigRolloutStatus) DeepCopy() *ConfigRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRolloutStrategy) DeepCopyInto(out *ConfigRolloutStrategy) {
+	*out = *in
+	if in.CanaryNodeSelector != nil {
+		in, out := &in.CanaryNodeSelector, &out.CanaryNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BakeTime != nil {
+		in, out := &in.BakeTime, &out.BakeTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ProgressDeadline != nil {
+		in, out := &in.ProgressDeadline, &out.ProgressDeadline
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRolloutStrategy.
+func (in *ConfigRolloutStrategy) DeepCopy() *ConfigRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomTuningPolicy) DeepCopyInto(out *CustomTuningPolicy) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(ComponentRateLimiter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.API != nil {
+		in, out := &in.API, &out.API
+		*out = new(ComponentRateLimiter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Controller != nil {
+		in, out := &in.Controller, &out.Controller
+		*out = new(ComponentRateLimiter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Handler != nil {
+		in, out := &in.Handler, &out.Handler
+		*out = new(ComponentRateLimiter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(ComponentRateLimiter)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTuningPolicy.
+func (in *CustomTuningPolicy) DeepCopy() *CustomTuningPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomTuningPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataImportCronImportStatus) DeepCopyInto(out *DataImportCronImportStatus) {
+	*out = *in
+	if in.LastImportTimestamp != nil {
+		in, out := &in.LastImportTimestamp, &out.LastImportTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImportCronImportStatus.
+func (in *DataImportCronImportStatus) DeepCopy() *DataImportCronImportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataImportCronImportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataImportCronStatus) DeepCopyInto(out *DataImportCronStatus) {
 	*out = *in
@@ -122,6 +419,11 @@ func (in *DataImportCronStatus) DeepCopyInto(out *DataImportCronStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(DataImportCronImportStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -157,6 +459,23 @@ func (in *DataImportCronTemplate) DeepCopy() *DataImportCronTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataImportCronTemplateArchitecturePolicy) DeepCopyInto(out *DataImportCronTemplateArchitecturePolicy) {
+	*out = *in
+	in.ArchitecturePolicy.DeepCopyInto(&out.ArchitecturePolicy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImportCronTemplateArchitecturePolicy.
+func (in *DataImportCronTemplateArchitecturePolicy) DeepCopy() *DataImportCronTemplateArchitecturePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DataImportCronTemplateArchitecturePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataImportCronTemplateStatus) DeepCopyInto(out *DataImportCronTemplateStatus) {
 	*out = *in
@@ -175,6 +494,22 @@ func (in *DataImportCronTemplateStatus) DeepCopy() *DataImportCronTemplateStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftedField) DeepCopyInto(out *DriftedField) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedField.
+func (in *DriftedField) DeepCopy() *DriftedField {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftedField)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HigherWorkloadDensityConfiguration) DeepCopyInto(out *HigherWorkloadDensityConfiguration) {
 	*out = *in
@@ -373,41 +708,161 @@ func (in *HyperConvergedList) DeepCopyInto(out *HyperConvergedList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedList.
-func (in *HyperConvergedList) DeepCopy() *HyperConvergedList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedList.
+func (in *HyperConvergedList) DeepCopy() *HyperConvergedList {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HyperConvergedList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedObsoleteCPUs) DeepCopyInto(out *HyperConvergedObsoleteCPUs) {
+	*out = *in
+	if in.CPUModels != nil {
+		in, out := &in.CPUModels, &out.CPUModels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedObsoleteCPUs.
+func (in *HyperConvergedObsoleteCPUs) DeepCopy() *HyperConvergedObsoleteCPUs {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedObsoleteCPUs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedProfile) DeepCopyInto(out *HyperConvergedProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedProfile.
+func (in *HyperConvergedProfile) DeepCopy() *HyperConvergedProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HyperConvergedProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedProfileList) DeepCopyInto(out *HyperConvergedProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HyperConvergedProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedProfileList.
+func (in *HyperConvergedProfileList) DeepCopy() *HyperConvergedProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HyperConvergedProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedProfileSpec) DeepCopyInto(out *HyperConvergedProfileSpec) {
+	*out = *in
+	if in.EvictionStrategy != nil {
+		in, out := &in.EvictionStrategy, &out.EvictionStrategy
+		*out = new(corev1.EvictionStrategy)
+		**out = **in
+	}
+	if in.DefaultCPUModel != nil {
+		in, out := &in.DefaultCPUModel, &out.DefaultCPUModel
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultRuntimeClass != nil {
+		in, out := &in.DefaultRuntimeClass, &out.DefaultRuntimeClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.LiveMigrationPolicy != nil {
+		in, out := &in.LiveMigrationPolicy, &out.LiveMigrationPolicy
+		*out = new(ProfileLiveMigrationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedProfileSpec.
+func (in *HyperConvergedProfileSpec) DeepCopy() *HyperConvergedProfileSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HyperConvergedList)
+	out := new(HyperConvergedProfileSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HyperConvergedList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HyperConvergedObsoleteCPUs) DeepCopyInto(out *HyperConvergedObsoleteCPUs) {
+func (in *HyperConvergedProfileStatus) DeepCopyInto(out *HyperConvergedProfileStatus) {
 	*out = *in
-	if in.CPUModels != nil {
-		in, out := &in.CPUModels, &out.CPUModels
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedObsoleteCPUs.
-func (in *HyperConvergedObsoleteCPUs) DeepCopy() *HyperConvergedObsoleteCPUs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedProfileStatus.
+func (in *HyperConvergedProfileStatus) DeepCopy() *HyperConvergedProfileStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(HyperConvergedObsoleteCPUs)
+	out := new(HyperConvergedProfileStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -415,10 +870,22 @@ func (in *HyperConvergedObsoleteCPUs) DeepCopy() *HyperConvergedObsoleteCPUs {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 	*out = *in
+	if in.CustomTuningPolicy != nil {
+		in, out := &in.CustomTuningPolicy, &out.CustomTuningPolicy
+		*out = new(CustomTuningPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Infra.DeepCopyInto(&out.Infra)
 	in.Workloads.DeepCopyInto(&out.Workloads)
 	in.FeatureGates.DeepCopyInto(&out.FeatureGates)
 	in.LiveMigrationConfig.DeepCopyInto(&out.LiveMigrationConfig)
+	if in.MigrationPolicies != nil {
+		in, out := &in.MigrationPolicies, &out.MigrationPolicies
+		*out = make([]LiveMigrationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.PermittedHostDevices != nil {
 		in, out := &in.PermittedHostDevices, &out.PermittedHostDevices
 		*out = new(PermittedHostDevices)
@@ -528,6 +995,16 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.BootImageRegistryMirrors != nil {
+		in, out := &in.BootImageRegistryMirrors, &out.BootImageRegistryMirrors
+		*out = make([]RegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	if in.BootImageArchitecturePolicy != nil {
+		in, out := &in.BootImageArchitecturePolicy, &out.BootImageArchitecturePolicy
+		*out = new(BootImageArchitecturePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KSMConfiguration != nil {
 		in, out := &in.KSMConfiguration, &out.KSMConfiguration
 		*out = new(corev1.KSMConfiguration)
@@ -580,6 +1057,37 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 		*out = new(corev1.LiveUpdateConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UnmanagedOperands != nil {
+		in, out := &in.UnmanagedOperands, &out.UnmanagedOperands
+		*out = make([]OperandKind, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfigRolloutStrategy != nil {
+		in, out := &in.ConfigRolloutStrategy, &out.ConfigRolloutStrategy
+		*out = new(ConfigRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertSilences != nil {
+		in, out := &in.AlertSilences, &out.AlertSilences
+		*out = make([]AlertSilence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AlertRuleOverrides != nil {
+		in, out := &in.AlertRuleOverrides, &out.AlertRuleOverrides
+		*out = make([]AlertRuleOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceLevelObjectives != nil {
+		in, out := &in.ServiceLevelObjectives, &out.ServiceLevelObjectives
+		*out = make([]ServiceLevelObjective, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -620,12 +1128,38 @@ func (in *HyperConvergedStatus) DeepCopyInto(out *HyperConvergedStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ComponentsHealth != nil {
+		in, out := &in.ComponentsHealth, &out.ComponentsHealth
+		*out = make([]ComponentHealth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.InfrastructureHighlyAvailable != nil {
 		in, out := &in.InfrastructureHighlyAvailable, &out.InfrastructureHighlyAvailable
 		*out = new(bool)
 		**out = **in
 	}
 	in.NodeInfo.DeepCopyInto(&out.NodeInfo)
+	if in.OperandDrifts != nil {
+		in, out := &in.OperandDrifts, &out.OperandDrifts
+		*out = make([]OperandDrift, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpgradeHistory != nil {
+		in, out := &in.UpgradeHistory, &out.UpgradeHistory
+		*out = make([]UpgradeHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConfigRollout != nil {
+		in, out := &in.ConfigRollout, &out.ConfigRollout
+		*out = new(ConfigRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -657,6 +1191,11 @@ func (in *HyperConvergedWorkloadUpdateStrategy) DeepCopyInto(out *HyperConverged
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -703,6 +1242,11 @@ func (in *LiveMigrationConfigurations) DeepCopyInto(out *LiveMigrationConfigurat
 		*out = new(string)
 		**out = **in
 	}
+	if in.DedicatedNetwork != nil {
+		in, out := &in.DedicatedNetwork, &out.DedicatedNetwork
+		*out = new(LiveMigrationNetwork)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AllowAutoConverge != nil {
 		in, out := &in.AllowAutoConverge, &out.AllowAutoConverge
 		*out = new(bool)
@@ -726,6 +1270,82 @@ func (in *LiveMigrationConfigurations) DeepCopy() *LiveMigrationConfigurations {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiveMigrationNetwork) DeepCopyInto(out *LiveMigrationNetwork) {
+	*out = *in
+	if in.VLAN != nil {
+		in, out := &in.VLAN, &out.VLAN
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExcludeRanges != nil {
+		in, out := &in.ExcludeRanges, &out.ExcludeRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiveMigrationNetwork.
+func (in *LiveMigrationNetwork) DeepCopy() *LiveMigrationNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(LiveMigrationNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiveMigrationPolicy) DeepCopyInto(out *LiveMigrationPolicy) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VirtualMachineInstanceSelector != nil {
+		in, out := &in.VirtualMachineInstanceSelector, &out.VirtualMachineInstanceSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AllowAutoConverge != nil {
+		in, out := &in.AllowAutoConverge, &out.AllowAutoConverge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowPostCopy != nil {
+		in, out := &in.AllowPostCopy, &out.AllowPostCopy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BandwidthPerMigration != nil {
+		in, out := &in.BandwidthPerMigration, &out.BandwidthPerMigration
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CompletionTimeoutPerGiB != nil {
+		in, out := &in.CompletionTimeoutPerGiB, &out.CompletionTimeoutPerGiB
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiveMigrationPolicy.
+func (in *LiveMigrationPolicy) DeepCopy() *LiveMigrationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LiveMigrationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogVerbosityConfiguration) DeepCopyInto(out *LogVerbosityConfiguration) {
 	*out = *in
@@ -752,6 +1372,23 @@ func (in *LogVerbosityConfiguration) DeepCopy() *LogVerbosityConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
 	*out = *in
@@ -860,6 +1497,28 @@ func (in *NodeMediatedDeviceTypesConfig) DeepCopy() *NodeMediatedDeviceTypesConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandDrift) DeepCopyInto(out *OperandDrift) {
+	*out = *in
+	in.RevertTime.DeepCopyInto(&out.RevertTime)
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]DriftedField, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandDrift.
+func (in *OperandDrift) DeepCopy() *OperandDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperandResourceRequirements) DeepCopyInto(out *OperandResourceRequirements) {
 	*out = *in
@@ -940,6 +1599,79 @@ func (in *PermittedHostDevices) DeepCopy() *PermittedHostDevices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileLiveMigrationPolicy) DeepCopyInto(out *ProfileLiveMigrationPolicy) {
+	*out = *in
+	if in.AllowAutoConverge != nil {
+		in, out := &in.AllowAutoConverge, &out.AllowAutoConverge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowPostCopy != nil {
+		in, out := &in.AllowPostCopy, &out.AllowPostCopy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BandwidthPerMigration != nil {
+		in, out := &in.BandwidthPerMigration, &out.BandwidthPerMigration
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CompletionTimeoutPerGiB != nil {
+		in, out := &in.CompletionTimeoutPerGiB, &out.CompletionTimeoutPerGiB
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileLiveMigrationPolicy.
+func (in *ProfileLiveMigrationPolicy) DeepCopy() *ProfileLiveMigrationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileLiveMigrationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirror) DeepCopyInto(out *RegistryMirror) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirror.
+func (in *RegistryMirror) DeepCopy() *RegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjective) DeepCopyInto(out *ServiceLevelObjective) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjective.
+func (in *ServiceLevelObjective) DeepCopy() *ServiceLevelObjective {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjective)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageImportConfig) DeepCopyInto(out *StorageImportConfig) {
 	*out = *in
@@ -998,6 +1730,54 @@ func (in *USBSelector) DeepCopy() *USBSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeHistoryEntry) DeepCopyInto(out *UpgradeHistoryEntry) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.AppliedPatches != nil {
+		in, out := &in.AppliedPatches, &out.AppliedPatches
+		*out = make([]UpgradePatchRecord, len(*in))
+		copy(*out, *in)
+	}
+	if in.SkippedPatches != nil {
+		in, out := &in.SkippedPatches, &out.SkippedPatches
+		*out = make([]UpgradePatchRecord, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemovedObjects != nil {
+		in, out := &in.RemovedObjects, &out.RemovedObjects
+		*out = make([]apicorev1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeHistoryEntry.
+func (in *UpgradeHistoryEntry) DeepCopy() *UpgradeHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePatchRecord) DeepCopyInto(out *UpgradePatchRecord) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePatchRecord.
+func (in *UpgradePatchRecord) DeepCopy() *UpgradePatchRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePatchRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Version) DeepCopyInto(out *Version) {
 	*out = *in
@@ -172,6 +172,47 @@ func SetObjectDefaults_HyperConverged(in *HyperConverged) {
 		var ptrVar1 bool = false
 		in.Spec.EnableApplicationAwareQuota = &ptrVar1
 	}
+	if in.Spec.ConfigRolloutStrategy != nil {
+		if in.Spec.ConfigRolloutStrategy.Type == "" {
+			in.Spec.ConfigRolloutStrategy.Type = "Immediate"
+		}
+		if in.Spec.ConfigRolloutStrategy.BakeTime == nil {
+			if err := json.Unmarshal([]byte(`"10m"`), &in.Spec.ConfigRolloutStrategy.BakeTime); err != nil {
+				panic(err)
+			}
+		}
+		if in.Spec.ConfigRolloutStrategy.ProgressDeadline == nil {
+			if err := json.Unmarshal([]byte(`"30m"`), &in.Spec.ConfigRolloutStrategy.ProgressDeadline); err != nil {
+				panic(err)
+			}
+		}
+	}
+	for i := range in.Spec.ServiceLevelObjectives {
+		a := &in.Spec.ServiceLevelObjectives[i]
+		if a.Window == nil {
+			if err := json.Unmarshal([]byte(`"720h0m0s"`), &a.Window); err != nil {
+				panic(err)
+			}
+		}
+	}
+	if in.Status.ConfigRollout != nil {
+		if in.Status.ConfigRollout.StableWorkloadUpdateStrategy != nil {
+			if in.Status.ConfigRollout.StableWorkloadUpdateStrategy.WorkloadUpdateMethods == nil {
+				if err := json.Unmarshal([]byte(`["LiveMigrate"]`), &in.Status.ConfigRollout.StableWorkloadUpdateStrategy.WorkloadUpdateMethods); err != nil {
+					panic(err)
+				}
+			}
+			if in.Status.ConfigRollout.StableWorkloadUpdateStrategy.BatchEvictionSize == nil {
+				var ptrVar1 int = 10
+				in.Status.ConfigRollout.StableWorkloadUpdateStrategy.BatchEvictionSize = &ptrVar1
+			}
+			if in.Status.ConfigRollout.StableWorkloadUpdateStrategy.BatchEvictionInterval == nil {
+				if err := json.Unmarshal([]byte(`"1m0s"`), &in.Status.ConfigRollout.StableWorkloadUpdateStrategy.BatchEvictionInterval); err != nil {
+					panic(err)
+				}
+			}
+		}
+	}
 }
 
 func SetObjectDefaults_HyperConvergedList(in *HyperConvergedList) {
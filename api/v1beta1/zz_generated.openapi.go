@@ -31,27 +31,205 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ApplicationAwareConfigurations":       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ApplicationAwareConfigurations(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigCA":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfigCA(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigServer":               schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfigServer(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConverged":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConverged(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig":             schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedCertConfig(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates":           schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedFeatureGates(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedObsoleteCPUs":           schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedObsoleteCPUs(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedSpec":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedSpec(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedStatus":                 schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedStatus(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedWorkloadUpdateStrategy": schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedWorkloadUpdateStrategy(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations":          schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LiveMigrationConfigurations(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LogVerbosityConfiguration":            schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LogVerbosityConfiguration(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration":         schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedDevicesConfiguration(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedHostDevice":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedHostDevice(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NodeMediatedDeviceTypesConfig":        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NodeMediatedDeviceTypesConfig(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements":          schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandResourceRequirements(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PciHostDevice":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PciHostDevice(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices":                 schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PermittedHostDevices(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_StorageImportConfig(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.USBHostDevice":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_USBHostDevice(ref),
-		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.USBSelector":                          schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_USBSelector(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertRuleOverride":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertRuleOverride(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilence":                             schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertSilence(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilenceMatcher":                      schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertSilenceMatcher(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ApplicationAwareConfigurations":           schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ApplicationAwareConfigurations(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ArchitecturePolicy":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ArchitecturePolicy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.BootImageArchitecturePolicy":              schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_BootImageArchitecturePolicy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigCA":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfigCA(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigServer":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfigServer(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentRateLimiter":                     schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ComponentRateLimiter(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ConfigRolloutStrategy":                    schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ConfigRolloutStrategy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CustomTuningPolicy":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CustomTuningPolicy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplateArchitecturePolicy": schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_DataImportCronTemplateArchitecturePolicy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConverged":                           schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConverged(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig":                 schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedCertConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates":               schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedFeatureGates(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedObsoleteCPUs":               schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedObsoleteCPUs(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedProfile":                    schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedProfile(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedProfileSpec":                schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedProfileSpec(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedProfileStatus":              schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedProfileStatus(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedSpec":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedSpec(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedStatus":                     schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedStatus(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedWorkloadUpdateStrategy":     schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedWorkloadUpdateStrategy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations":              schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LiveMigrationConfigurations(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationNetwork":                     schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LiveMigrationNetwork(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationPolicy":                      schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LiveMigrationPolicy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LogVerbosityConfiguration":                schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LogVerbosityConfiguration(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MaintenanceWindow":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MaintenanceWindow(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration":             schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedDevicesConfiguration(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedHostDevice":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedHostDevice(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NodeMediatedDeviceTypesConfig":            schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NodeMediatedDeviceTypesConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements":              schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandResourceRequirements(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PciHostDevice":                            schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PciHostDevice(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices":                     schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PermittedHostDevices(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ProfileLiveMigrationPolicy":               schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ProfileLiveMigrationPolicy(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.RegistryMirror":                           schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_RegistryMirror(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ServiceLevelObjective":                    schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ServiceLevelObjective(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig":                      schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_StorageImportConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.USBHostDevice":                            schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_USBHostDevice(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.USBSelector":                              schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_USBSelector(ref),
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertRuleOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertRuleOverride modifies or disables one of the alerts that HCO deploys",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"alert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Alert is the name of the alert to modify",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disabled if true, the alert is removed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"threshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Threshold replaces the threshold of the alert. Only supported by the alerts with a configurable threshold: HighCPUWorkload, HighNodeCPUFrequency and PersistentVolumeFillingUp. The threshold is a ratio between 0 and 1, and not a percentage. HighCPUWorkload and HighNodeCPUFrequency fire above the threshold; e.g. \"0.95\" for 95% of the CPU utilization or of the maximum CPU frequency. PersistentVolumeFillingUp fires below the threshold of the available space of the volume; e.g. \"0.05\" for less than 5% of free space (default: \"0.1\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"for": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For replaces the duration that the alert condition must be met before the alert fires",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"severity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Severity replaces the severity of the alert",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are added to the labels of the alert. The severity, operator_health_impact, kubernetes_operator_part_of and kubernetes_operator_component labels can't be modified.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"alert"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertSilence(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertSilence is an Alertmanager silence that HCO maintains",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comment describes the reason for the silence. HCO identifies the silence by its comment and matchers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matchers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Matchers select the alerts to silence. An alert is silenced if it matches all the matchers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilenceMatcher"),
+									},
+								},
+							},
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of the silence. HCO renews the silence before it expires, so the duration only limits how long the silence stays in effect if HCO stops renewing it; e.g. when HCO is removed. If not set, the silence never expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"comment", "matchers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilenceMatcher", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertSilenceMatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertSilenceMatcher matches the alerts by one of their labels",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the alert label",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value to compare the label value with",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"isRegex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IsRegex if true, the value is a regular expression",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"isNotEqual": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IsNotEqual if true, the matcher selects the alerts that their label does not match the value",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
 	}
 }
 
@@ -90,6 +268,124 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ApplicationAwar
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ArchitecturePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArchitecturePolicy selects the CPU architectures to import for a golden image",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"architectures": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Architectures is the list of the CPU architectures to import. An architecture that is not supported by the image, or that has no workload nodes, is ignored. If not set, all the architectures of the workload nodes, that the image supports, are imported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"preferredArchitecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreferredArchitecture is the default architecture of the golden image; i.e. the architecture of the image that the DataSource without an architecture suffix points to, if the image is imported for this architecture.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"minArchitectures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinArchitectures is the minimum number of architectures that a golden image must be imported for. A golden image with fewer architectures is not deployed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_BootImageArchitecturePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BootImageArchitecturePolicy is the architecture policy of the golden images. The policy fields apply to all the golden images, unless overridden for a specific DataImportCronTemplate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"architectures": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Architectures is the list of the CPU architectures to import. An architecture that is not supported by the image, or that has no workload nodes, is ignored. If not set, all the architectures of the workload nodes, that the image supports, are imported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"preferredArchitecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreferredArchitecture is the default architecture of the golden image; i.e. the architecture of the image that the DataSource without an architecture suffix points to, if the image is imported for this architecture.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"minArchitectures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinArchitectures is the minimum number of architectures that a golden image must be imported for. A golden image with fewer architectures is not deployed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"templates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Templates overrides the policy fields for specific DataImportCronTemplates, by their names. A field that is not set in the override, is taken from the policy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplateArchitecturePolicy"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplateArchitecturePolicy"},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfigCA(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -148,6 +444,190 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfi
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ComponentRateLimiter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentRateLimiter defines the token bucket rate limiter of the REST client of a kubevirt component",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"qps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QPS is the number of queries per second the component is allowed to send to the API server. It may be a fractional number; e.g. 0.5, or 500m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the maximum number of queries the component is allowed to send to the API server at once",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"qps", "burst"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ConfigRolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigRolloutStrategy defines how HCO rolls out changes of the workloads and the workloadUpdateStrategy fields.\n\nIn the Canary mode, a new workloads node placement is first applied to the nodes that match the canaryNodeSelector, while the other nodes keep the previous node placement. If all the components stay healthy for the bakeTime, HCO applies the change to the whole cluster. If a component that runs the workloads becomes degraded during the canary phase, or the components are not healthy before the progressDeadline, HCO rolls the change back.\n\nKubeVirt has no per-node workload update strategy, so the previous workloadUpdateStrategy is kept for the whole cluster during the canary phase, and the new one is applied when the change is promoted. The maintenance windows are not subject to the rollout strategy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the rollout type; one of Immediate or Canary.",
+							Default:     "Immediate",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"canaryNodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanaryNodeSelector selects the nodes of the canary node pool, by their labels.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"bakeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BakeTime is how long all the components must stay healthy with the canary configuration, before the change is applied to the whole cluster.",
+							Default:     "10m",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"progressDeadline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProgressDeadline is the maximum time for the components to become healthy with the canary configuration. HCO rolls the change back if the components are not healthy by then.",
+							Default:     "30m",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CustomTuningPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CustomTuningPolicy defines the rate limiters of the REST clients of the kubevirt components. A component that is not explicitly set, uses the default rate limiter. If the default rate limiter is not set either, the kubevirt default values are used for this component.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"default": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Default is the rate limiter of all the components that are not explicitly set",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentRateLimiter"),
+						},
+					},
+					"api": {
+						SchemaProps: spec.SchemaProps{
+							Description: "API is the rate limiter of the virt-api component",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentRateLimiter"),
+						},
+					},
+					"controller": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Controller is the rate limiter of the virt-controller component",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentRateLimiter"),
+						},
+					},
+					"handler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Handler is the rate limiter of the virt-handler component",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentRateLimiter"),
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook is the rate limiter of the kubevirt webhooks",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentRateLimiter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentRateLimiter"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_DataImportCronTemplateArchitecturePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataImportCronTemplateArchitecturePolicy is the architecture policy of a specific DataImportCronTemplate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the DataImportCronTemplate",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"architectures": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Architectures is the list of the CPU architectures to import. An architecture that is not supported by the image, or that has no workload nodes, is ignored. If not set, all the architectures of the workload nodes, that the image supports, are imported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"preferredArchitecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreferredArchitecture is the default architecture of the golden image; i.e. the architecture of the image that the DataSource without an architecture suffix points to, if the image is imported for this architecture.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"minArchitectures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinArchitectures is the minimum number of architectures that a golden image must be imported for. A golden image with fewer architectures is not deployed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConverged(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -329,77 +809,214 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedF
 					},
 					"enableApplicationAwareQuota": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Deprecated: This field is ignored and will be removed on the next version of the API. Use spec.enableApplicationAwareQuota instead",
-							Type:        []string{"boolean"},
+							Description: "Deprecated: This field is ignored and will be removed on the next version of the API. Use spec.enableApplicationAwareQuota instead",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"primaryUserDefinedNetworkBinding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "primaryUserDefinedNetworkBinding deploys the needed configurations for kubevirt users to be able to bind their VM to a UDN network on the VM's primary interface. Deprecated: this field is ignored and will be removed in the next version of the API.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"enableMultiArchBootImageImport": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnableMultiArchBootImageImport allows the HCO to run on heterogeneous clusters with different CPU architectures. Setting this field to true will allow the HCO to create Golden Images for different CPU architectures.\n\nThis feature is in Developer Preview.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"decentralizedLiveMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DecentralizedLiveMigration enables the decentralized live migration (cross-cluster migration) feature. This feature allows live migration of VirtualMachineInstances between different clusters. This feature is in Developer Preview.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedObsoleteCPUs(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minCPUModel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinCPUModel is the Minimum CPU model that is used for basic CPU features; e.g. Penryn or Haswell. The default value for this field is nil, but in KubeVirt, the default value is \"Penryn\", if nothing else is set. Use this field to override KubeVirt default value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cpuModels": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUModels is a list of obsolete CPU models. When the node-labeller obtains the list of obsolete CPU models, it eliminates those CPU models and creates labels for valid CPU models. The default values for this field is nil, however, HCO uses opinionated values, and adding values to this list will add them to the opinionated values.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedProfile is the Schema for the hyperconvergedprofiles API. It overrides a subset of the VM defaults of the HyperConverged CR, for a single namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedProfileSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedProfileStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedProfileSpec", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedProfileStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedProfileSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedProfileSpec defines the VM defaults of a single namespace. Each one of the fields overrides the matching cluster-wide default of the HyperConverged CR, for the VMs in the namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"evictionStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionStrategy is the eviction strategy of the VMIs in the namespace, that do not set their own eviction strategy. Overrides the evictionStrategy field of the HyperConverged CR.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"primaryUserDefinedNetworkBinding": {
+					"defaultCPUModel": {
 						SchemaProps: spec.SchemaProps{
-							Description: "primaryUserDefinedNetworkBinding deploys the needed configurations for kubevirt users to be able to bind their VM to a UDN network on the VM's primary interface. Deprecated: this field is ignored and will be removed in the next version of the API.",
-							Type:        []string{"boolean"},
+							Description: "DefaultCPUModel is the CPU model of the VMIs in the namespace, that do not set their own CPU model. Overrides the defaultCPUModel field of the HyperConverged CR.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"enableMultiArchBootImageImport": {
+					"defaultRuntimeClass": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EnableMultiArchBootImageImport allows the HCO to run on heterogeneous clusters with different CPU architectures. Setting this field to true will allow the HCO to create Golden Images for different CPU architectures.\n\nThis feature is in Developer Preview.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "DefaultRuntimeClass is the RuntimeClass of the virt-launcher pods in the namespace, when the cluster-wide defaultRuntimeClass field of the HyperConverged CR is not set.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"decentralizedLiveMigration": {
+					"liveMigrationPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DecentralizedLiveMigration enables the decentralized live migration (cross-cluster migration) feature. This feature allows live migration of VirtualMachineInstances between different clusters. This feature is in Developer Preview.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "LiveMigrationPolicy is the live migration policy of the VMIs in the namespace. HCO translates it to a KubeVirt MigrationPolicy, that selects the namespace. Overrides the liveMigrationConfig field of the HyperConverged CR.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ProfileLiveMigrationPolicy"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ProfileLiveMigrationPolicy"},
 	}
 }
 
-func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedObsoleteCPUs(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedProfileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HyperConvergedObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models",
+				Description: "HyperConvergedProfileStatus defines the observed state of a HyperConvergedProfile",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"minCPUModel": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MinCPUModel is the Minimum CPU model that is used for basic CPU features; e.g. Penryn or Haswell. The default value for this field is nil, but in KubeVirt, the default value is \"Penryn\", if nothing else is set. Use this field to override KubeVirt default value.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"cpuModels": {
+					"conditions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "CPUModels is a list of obsolete CPU models. When the node-labeller obtains the list of obsolete CPU models, it eliminates those CPU models and creates labels for valid CPU models. The default values for this field is nil, however, HCO uses opinionated values, and adding values to this list will add them to the opinionated values.",
+							Description: "Conditions describes the state of the HyperConvergedProfile resource.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
 									},
 								},
 							},
 						},
 					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration reflects the HyperConvergedProfile resource generation. If the ObservedGeneration is less than the resource generation in metadata, the status is out of date",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"migrationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationPolicy is the name of the KubeVirt MigrationPolicy that HCO created from the liveMigrationPolicy field. Empty if the liveMigrationPolicy field is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
 	}
 }
 
@@ -419,11 +1036,17 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 					},
 					"tuningPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TuningPolicy allows to configure the mode in which the RateLimits of kubevirt are set. If TuningPolicy is not present the default kubevirt values are used. It can be set to `annotation` for fine-tuning the kubevirt queryPerSeconds (qps) and burst values. Qps and burst values are taken from the annotation hco.kubevirt.io/tuningPolicy",
+							Description: "TuningPolicy allows to configure the mode in which the RateLimits of kubevirt are set. If TuningPolicy is not present the default kubevirt values are used. It can be set to `custom` for fine-tuning the kubevirt queryPerSeconds (qps) and burst values, per component. The qps and burst values are then taken from the customTuningPolicy field. Deprecated: the `annotation` value takes the qps and burst values from the annotation hco.kubevirt.io/tuningPolicy. It is migrated to the `custom` tuning policy on upgrade; use the `custom` tuning policy instead.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"customTuningPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomTuningPolicy defines the kubevirt queryPerSeconds (qps) and burst values, when the tuningPolicy field is set to `custom`.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CustomTuningPolicy"),
+						},
+					},
 					"infra": {
 						SchemaProps: spec.SchemaProps{
 							Description: "infra HyperConvergedConfig influences the pod configuration (currently only placement) for all the infra components needed on the virtualization enabled cluster but not necessarily directly on each node running VMs/VMIs.",
@@ -452,6 +1075,28 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations"),
 						},
 					},
+					"migrationPolicies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationPolicies is a list of named live migration policies. HCO translates each policy to a KubeVirt MigrationPolicy, that overrides the liveMigrationConfig fields for the VMIs it selects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationPolicy"),
+									},
+								},
+							},
+						},
+					},
 					"permittedHostDevices": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PermittedHostDevices holds information about devices allowed for passthrough",
@@ -625,6 +1270,34 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Format:      "",
 						},
 					},
+					"bootImageRegistryMirrors": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"source",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "BootImageRegistryMirrors maps registry prefixes of the golden images sources to mirror registries, e.g. in disconnected clusters. The registry URLs of the DataImportCronTemplates in the SSP CR are rewritten using the longest matching prefix.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.RegistryMirror"),
+									},
+								},
+							},
+						},
+					},
+					"bootImageArchitecturePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BootImageArchitecturePolicy controls which CPU architectures are imported for the golden images, in heterogeneous clusters. It is only used when the enableMultiArchBootImageImport feature gate is enabled.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.BootImageArchitecturePolicy"),
+						},
+					},
 					"ksmConfiguration": {
 						SchemaProps: spec.SchemaProps{
 							Description: "KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).",
@@ -701,11 +1374,100 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Ref:         ref("kubevirt.io/api/core/v1.LiveUpdateConfiguration"),
 						},
 					},
+					"unmanagedOperands": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "UnmanagedOperands is a list of operands that HCO stops reconciling; e.g. during an incident response. HCO does not create, update or revert modifications of the listed operand CRs, but it still reports their conditions. While this list is not empty, the UnmanagedOperands condition is set in the HyperConverged status. Note: HCO upgrade can't be completed while an operand is unmanaged.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"configRolloutStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigRolloutStrategy defines how HCO rolls out changes of the workloads and the workloadUpdateStrategy fields. By default, the changes are applied to the whole cluster at once.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ConfigRolloutStrategy"),
+						},
+					},
+					"alertSilences": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertSilences is a list of Alertmanager silences that HCO maintains, in addition to the silences that HCO declares on its own. HCO recreates a silence that was deleted, renews it before it expires, and deletes the silences that are removed from this list.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilence"),
+									},
+								},
+							},
+						},
+					},
+					"alertRuleOverrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"alert",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertRuleOverrides modifies or disables the alerts that HCO deploys; e.g. to change the threshold, the duration or the severity of an alert. An invalid override is ignored, and reported in the ObservabilityReconciled condition, or by a warning event; the other overrides are still applied.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertRuleOverride"),
+									},
+								},
+							},
+						},
+					},
+					"serviceLevelObjectives": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceLevelObjectives are the SLOs of the virtualization service. HCO generates the SLO recording rules and the multi-window burn-rate alerts of each objective.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ServiceLevelObjective"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ApplicationAwareConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplate", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HigherWorkloadDensityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedObsoleteCPUs", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedWorkloadUpdateStrategy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LogVerbosityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineOptions", "github.com/openshift/api/config/v1.TLSSecurityProfile", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.InterfaceBindingPlugin", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.FilesystemOverhead"},
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertRuleOverride", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilence", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ApplicationAwareConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.BootImageArchitecturePolicy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ConfigRolloutStrategy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CustomTuningPolicy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplate", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HigherWorkloadDensityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedObsoleteCPUs", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedWorkloadUpdateStrategy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationPolicy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LogVerbosityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.RegistryMirror", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ServiceLevelObjective", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineOptions", "github.com/openshift/api/config/v1.TLSSecurityProfile", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.InterfaceBindingPlugin", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.FilesystemOverhead"},
 	}
 }
 
@@ -810,6 +1572,28 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Format:      "",
 						},
 					},
+					"componentsHealth": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentsHealth reflects the health of each of the components that HCO deploys, based on the component's own conditions. Unlike SystemHealthStatus, it shows all the unhealthy components, and not only the last one.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentHealth"),
+									},
+								},
+							},
+						},
+					},
 					"infrastructureHighlyAvailable": {
 						SchemaProps: spec.SchemaProps{
 							Description: "InfrastructureHighlyAvailable describes whether the cluster has only one worker node (false) or more (true).",
@@ -824,11 +1608,55 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NodeInfoStatus"),
 						},
 					},
+					"operandDrifts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "OperandDrifts is a list of the latest out-of-band modifications of the operand CRs, that were reverted by HCO. The list is limited to the 10 latest modifications, ordered from the oldest to the newest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandDrift"),
+									},
+								},
+							},
+						},
+					},
+					"upgradeHistory": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradeHistory is a list of the latest HCO upgrades, with the modifications that HCO applied on its own during each upgrade. The list is limited to the 5 latest upgrades, ordered from the oldest to the newest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.UpgradeHistoryEntry"),
+									},
+								},
+							},
+						},
+					},
+					"configRollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigRollout is the state of the configuration rollout, when the Canary configRolloutStrategy is used.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ConfigRolloutStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplateStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NodeInfoStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.Version", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentHealth", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ConfigRolloutStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplateStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NodeInfoStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandDrift", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.UpgradeHistoryEntry", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.Version", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
 	}
 }
 
@@ -860,27 +1688,46 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedW
 							},
 						},
 					},
-					"batchEvictionSize": {
-						SchemaProps: spec.SchemaProps{
-							Description: "BatchEvictionSize Represents the number of VMIs that can be forced updated per the BatchShutdownInterval interval",
-							Default:     10,
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"batchEvictionInterval": {
-						SchemaProps: spec.SchemaProps{
-							Description: "BatchEvictionInterval Represents the interval to wait before issuing the next batch of shutdowns",
-							Default:     "1m0s",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
+					"batchEvictionSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchEvictionSize Represents the number of VMIs that can be forced updated per the BatchShutdownInterval interval",
+							Default:     10,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"batchEvictionInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchEvictionInterval Represents the interval to wait before issuing the next batch of shutdowns",
+							Default:     "1m0s",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maintenanceWindows": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindows restricts automated workload updates, and the live migrations triggered by HCO upgrades, to the listed time windows. Outside all the windows, HCO configures KubeVirt with no workload update methods, and reports the HyperConverged Operator as not upgradeable, until the next window opens. When empty, workload updates and upgrades are allowed at any time.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"workloadUpdateMethods"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MaintenanceWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -937,6 +1784,12 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LiveMigrationCo
 							Format:      "",
 						},
 					},
+					"dedicatedNetwork": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DedicatedNetwork makes HCO create and own a NetworkAttachmentDefinition for the live migrations, and use it as the migration network. It can't be set together with the network field.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationNetwork"),
+						},
+					},
 					"allowAutoConverge": {
 						SchemaProps: spec.SchemaProps{
 							Description: "AllowAutoConverge allows the platform to compromise performance/availability of VMIs to guarantee successful VMI live migrations. Defaults to false",
@@ -956,6 +1809,148 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LiveMigrationCo
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationNetwork"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LiveMigrationNetwork(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LiveMigrationNetwork is a dedicated network for the live migrations, that is provisioned by HCO",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interface": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interface is the name of the node network interface, that the migration network is attached to. It must exist on all the nodes that run virtual machines.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vlan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VLAN is the VLAN ID of the migration network. If set, the migration traffic is tagged with this VLAN ID on the node interface.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ipRange": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPRange is the CIDR of the IP addresses to allocate to the migration network interfaces of virt-handler; e.g. \"10.200.5.0/24\". The addresses are allocated by the whereabouts IPAM plugin.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"excludeRanges": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ExcludeRanges is a list of CIDRs within the IP range, that must not be allocated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"interface", "ipRange"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LiveMigrationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LiveMigrationPolicy is a named live migration policy, for the VMIs selected by its namespace and VMI selectors",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the policy. The name of the KubeVirt MigrationPolicy is \"hco-policy-<name>\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces of the VMIs, by their labels. All the labels must match.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"virtualMachineInstanceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineInstanceSelector selects the VMIs, by their labels. All the labels must match.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"allowAutoConverge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowAutoConverge allows the platform to compromise performance/availability of VMIs to guarantee successful VMI live migrations.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"allowPostCopy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPostCopy enables post-copy live migrations. Such migrations allow even the busiest VMIs to successfully live-migrate. However, events like a network failure can cause a VMI crash.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bandwidthPerMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BandwidthPerMigration limits the amount of network bandwidth live migrations are allowed to use.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"completionTimeoutPerGiB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTimeoutPerGiB is the time, in seconds, per GiB of memory, a live migration is allowed to take before it is cancelled.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -987,6 +1982,36 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LogVerbosityCon
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow is a recurring time window, in which disruptive workload updates are allowed",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a cron expression, in the standard five-field format (minute, hour, day of month, month and day of week), that defines when the window opens. The schedule is evaluated in UTC.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the window stays open, after each time it opens.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"schedule", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedDevicesConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1329,6 +2354,132 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PermittedHostDe
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ProfileLiveMigrationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProfileLiveMigrationPolicy is the live migration policy of the VMIs in a single namespace",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allowAutoConverge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowAutoConverge allows the platform to compromise performance/availability of VMIs to guarantee successful VMI live migrations.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"allowPostCopy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPostCopy enables post-copy live migrations. Such migrations allow even the busiest VMIs to successfully live-migrate. However, events like a network failure can cause a VMI crash.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bandwidthPerMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BandwidthPerMigration limits the amount of network bandwidth live migrations are allowed to use.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"completionTimeoutPerGiB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTimeoutPerGiB is the time, in seconds, per GiB of memory, a live migration is allowed to take before it is cancelled.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_RegistryMirror(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryMirror maps a registry prefix to a mirror registry",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source is a registry, or a registry and a repository prefix, to be replaced; e.g. \"quay.io\" or \"quay.io/containerdisks\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mirror": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mirror is the registry, or the registry and the repository prefix, that replaces the source; e.g. \"mirror.example.com:5000/containerdisks\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"insecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Insecure adds the host of the mirror to the insecure registries of CDI. The host of a mirror of a registry that is listed in storageImport.insecureRegistries is added anyway.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"source", "mirror"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ServiceLevelObjective(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceLevelObjective is the target ratio of the good events of a service level indicator, over a time window",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name identifies the objective. It is set as the slo label of the recording rules and the alerts of the objective.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"indicator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicator is the measured aspect of the service; one of VMAvailability, LiveMigrationSuccess or BootImageFreshness.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"objective": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Objective is the target percentage of the good events; e.g. \"99.9\". Must be greater than 0 and less than 100.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is the time window of the objective. The error budget, and the burn rates of the alerts, are computed for this window.",
+							Default:     "720h0m0s",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"name", "indicator", "objective"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_StorageImportConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -49,6 +49,7 @@ import (
 
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	sspv1beta3 "kubevirt.io/ssp-operator/api/v1beta3"
@@ -58,12 +59,16 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/cmd/cmdcommon"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/crd"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/descheduler"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/dictcatalog"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/passt"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/hyperconverged"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/ingresscluster"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/nodes"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/observability"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/profile"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/archresolver"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/authorization"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
@@ -107,6 +112,7 @@ var (
 		deschedulerv1.AddToScheme,
 		netattdefv1.AddToScheme,
 		networkingv1.AddToScheme,
+		migrationsv1alpha1.AddToScheme,
 	}
 )
 
@@ -199,8 +205,14 @@ func main() {
 	nodeEventChannel := make(chan event.GenericEvent, 10)
 	defer close(nodeEventChannel)
 
+	dictCatalogEventChannel := make(chan event.GenericEvent, 10)
+	defer close(dictCatalogEventChannel)
+
+	dictArchEventChannel := make(chan event.GenericEvent, 10)
+	defer close(dictArchEventChannel)
+
 	// Create a new reconciler
-	if err = hyperconverged.RegisterReconciler(mgr, ci, upgradeableCondition, ingressEventCh, nodeEventChannel); err != nil {
+	if err = hyperconverged.RegisterReconciler(mgr, ci, upgradeableCondition, ingressEventCh, nodeEventChannel, dictCatalogEventChannel, dictArchEventChannel); err != nil {
 		logger.Error(err, "failed to register the HyperConverged controller")
 		eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, "InitError", "Unable to register HyperConverged controller; "+err.Error())
 		os.Exit(1)
@@ -218,8 +230,9 @@ func main() {
 		os.Exit(1)
 	}
 
-	if ci.IsOpenshift() {
-		if err = observability.SetupWithManager(mgr, ci.GetDeployment()); err != nil {
+	// on other platforms, the observability controller requires a self-hosted alertmanager
+	if ci.IsOpenshift() || observability.IsAlertmanagerURLSet() {
+		if err = observability.SetupWithManager(mgr, ci); err != nil {
 			logger.Error(err, "unable to create controller", "controller", "Observability")
 			os.Exit(1)
 		}
@@ -234,6 +247,15 @@ func main() {
 		}
 	}
 
+	if ci.IsMigrationPolicyAvailable() {
+		// Create a new reconciler for HyperConvergedProfiles
+		if err = profile.RegisterReconciler(mgr); err != nil {
+			logger.Error(err, "failed to register the HyperConvergedProfile controller")
+			eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, "InitError", "Unable to register HyperConvergedProfile controller; "+err.Error())
+			os.Exit(1)
+		}
+	}
+
 	// Create a new Nodes reconciler
 	if err = nodes.RegisterReconciler(mgr, nodeEventChannel); err != nil {
 		logger.Error(err, "failed to register the Nodes controller")
@@ -247,6 +269,16 @@ func main() {
 			eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, "InitError", "Unable to register Ingress controller; "+err.Error())
 			os.Exit(1)
 		}
+
+		// Create a new reconciler for the DataImportCronTemplate catalogs
+		if err = dictcatalog.RegisterReconciler(mgr, dictCatalogEventChannel); err != nil {
+			logger.Error(err, "failed to register the DataImportCronTemplate catalog controller")
+			eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, "InitError", "Unable to register DataImportCronTemplate catalog controller; "+err.Error())
+			os.Exit(1)
+		}
+
+		// Resolve the supported architectures of the user-supplied DataImportCronTemplates from their image registries
+		goldenimages.SetArchitectureResolver(archresolver.NewResolver(archresolver.NewRegistryClient(mgr.GetAPIReader()), dictArchEventChannel))
 	}
 
 	err = createPriorityClass(ctx, mgr)
@@ -295,6 +327,7 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo) cache.Opti
 	cacheOptions := cache.Options{
 		ByObject: map[client.Object]cache.ByObject{
 			&hcov1beta1.HyperConverged{}:           {},
+			&hcov1beta1.HyperConvergedProfile{}:    {},
 			&kubevirtcorev1.KubeVirt{}:             {},
 			&cdiv1beta1.CDI{}:                      {},
 			&networkaddonsv1.NetworkAddonsConfig{}: {},
@@ -370,6 +403,12 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo) cache.Opti
 		&deschedulerv1.KubeDescheduler{}: {},
 	}
 
+	cacheOptionsByObjectForMigrationPolicy := map[client.Object]cache.ByObject{
+		&migrationsv1alpha1.MigrationPolicy{}: {
+			Label: labelSelector,
+		},
+	}
+
 	cacheOptionsByObjectForOpenshift := map[client.Object]cache.ByObject{
 		&openshiftroutev1.Route{}: {
 			Namespaces: map[string]cache.Config{
@@ -393,6 +432,10 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo) cache.Opti
 		&securityv1.SecurityContextConstraints{}: {
 			Label: labelSelector,
 		},
+		// the golden images import status is read from the DataImportCrons and the DataSources that are created
+		// for the DataImportCronTemplates, in any namespace
+		&cdiv1beta1.DataImportCron{}: {},
+		&cdiv1beta1.DataSource{}:     {},
 	}
 
 	cacheOptionsByObjectForNetwork := map[client.Object]cache.ByObject{
@@ -407,6 +450,9 @@ func getCacheOption(operatorNamespace string, ci hcoutil.ClusterInfo) cache.Opti
 	if ci.IsDeschedulerAvailable() {
 		maps.Copy(cacheOptions.ByObject, cacheOptionsByObjectForDescheduler)
 	}
+	if ci.IsMigrationPolicyAvailable() {
+		maps.Copy(cacheOptions.ByObject, cacheOptionsByObjectForMigrationPolicy)
+	}
 	if ci.IsOpenshift() {
 		maps.Copy(cacheOptions.ByObject, cacheOptionsByObjectForOpenshift)
 	}
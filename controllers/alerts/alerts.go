@@ -2,6 +2,8 @@ package alerts
 
 import (
 	"context"
+	"errors"
+	"maps"
 	"reflect"
 
 	"github.com/go-logr/logr"
@@ -9,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -20,11 +23,13 @@ const (
 )
 
 type AlertRuleReconciler struct {
-	theRule *promv1.PrometheusRule
+	// the PrometheusRule with no overrides
+	baseRule *promv1.PrometheusRule
+	theRule  *promv1.PrometheusRule
 }
 
 // newAlertRuleReconciler creates new AlertRuleReconciler instance and returns a pointer to it.
-func newAlertRuleReconciler(namespace string, owner metav1.OwnerReference) (*AlertRuleReconciler, error) {
+func newAlertRuleReconciler(namespace string, owner metav1.OwnerReference, backend MonitoringBackend) (*AlertRuleReconciler, error) {
 	err := rules.SetupRules()
 	if err != nil {
 		return nil, err
@@ -35,11 +40,37 @@ func newAlertRuleReconciler(namespace string, owner metav1.OwnerReference) (*Ale
 		return nil, err
 	}
 
+	maps.Copy(rule.Labels, backend.SelectorLabels())
+
 	return &AlertRuleReconciler{
-		theRule: rule,
+		baseRule: rule,
+		theRule:  rule.DeepCopy(),
 	}, nil
 }
 
+// SetHyperConverged adds the rules of the service level objectives of the HyperConverged CR to the PrometheusRule,
+// and merges the alert rule overrides into it. The objectives, or the overrides, that are not valid are ignored.
+func (r *AlertRuleReconciler) SetHyperConverged(hc *hcov1beta1.HyperConverged) error {
+	rule := r.baseRule.DeepCopy()
+	r.theRule = rule
+
+	if hc == nil {
+		return nil
+	}
+
+	// an invalid objective or override is skipped; the valid ones are still applied
+	var errs []error
+	if err := rules.AddServiceLevelObjectives(rule, hc.Spec.ServiceLevelObjectives); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := rules.ApplyOverrides(rule, hc.Spec.AlertRuleOverrides); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
 func (r *AlertRuleReconciler) Kind() string {
 	return promv1.PrometheusRuleKind
 }
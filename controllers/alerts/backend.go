@@ -0,0 +1,183 @@
+package alerts
+
+import (
+	"context"
+	"maps"
+	"os"
+
+	"github.com/go-logr/logr"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// OpenshiftBackendName is the OpenShift cluster monitoring stack
+	OpenshiftBackendName = "openshift"
+	// KubePrometheusStackBackendName is a Prometheus deployed by the kube-prometheus-stack helm chart, or a similar
+	// prometheus-operator based stack
+	KubePrometheusStackBackendName = "kube-prometheus-stack"
+
+	openshiftPrometheusServiceAccount = "prometheus-k8s"
+
+	defaultKubePrometheusStackServiceAccount = "kube-prometheus-stack-prometheus"
+	defaultKubePrometheusStackSelectorLabels = "release=kube-prometheus-stack"
+)
+
+// MonitoringBackend is the Prometheus stack that scrapes the HCO metrics and evaluates the HCO alerts
+type MonitoringBackend interface {
+	// Name returns the name of the backend, as set in the MONITORING_BACKEND environment variable
+	Name() string
+	// Namespace returns the namespace of the Prometheus server
+	Namespace() string
+	// ServiceAccount returns the service account of the Prometheus server
+	ServiceAccount() string
+	// SelectorLabels returns the labels that the Prometheus server uses to select the ServiceMonitor and the
+	// PrometheusRule
+	SelectorLabels() map[string]string
+	// RoleRules returns the rules that allow the Prometheus server to discover the HCO metrics endpoint
+	RoleRules() []rbacv1.PolicyRule
+	// ReconcileNamespace prepares the HCO namespace to be monitored by the Prometheus server
+	ReconcileNamespace(ctx context.Context, cl client.Client, namespace string, logger logr.Logger) error
+}
+
+// GetMonitoringBackend returns the backend that is set in the MONITORING_BACKEND environment variable. If not set,
+// the backend is detected by the cluster type: the kube-prometheus-stack backend is used on a non-OpenShift cluster
+// with the prometheus-operator CRDs, and the openshift backend is used otherwise.
+func GetMonitoringBackend(ci hcoutil.ClusterInfo) MonitoringBackend {
+	switch name := os.Getenv(hcoutil.MonitoringBackendEnvV); name {
+	case KubePrometheusStackBackendName:
+		return newKubePrometheusStackBackend()
+	case OpenshiftBackendName:
+		return newOpenshiftBackend(ci)
+	case "":
+	default:
+		logger.Info("unknown monitoring backend; detecting the backend by the cluster type", "backend", name)
+	}
+
+	if !ci.IsOpenshift() && ci.IsMonitoringAvailable() {
+		return newKubePrometheusStackBackend()
+	}
+
+	return newOpenshiftBackend(ci)
+}
+
+func getPrometheusRoleRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{""},
+			Resources: []string{"services", "endpoints", "pods"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+	}
+}
+
+// openshiftBackend is the OpenShift cluster monitoring stack. It monitors the namespaces with the
+// openshift.io/cluster-monitoring label, and selects all their ServiceMonitors and PrometheusRules. On a non-OpenShift
+// cluster, the prometheus-k8s service account of the "monitoring" namespace is used, as in a kube-prometheus
+// deployment.
+type openshiftBackend struct {
+	namespace string
+}
+
+func newOpenshiftBackend(ci hcoutil.ClusterInfo) openshiftBackend {
+	if ci.IsOpenshift() {
+		return openshiftBackend{namespace: openshiftMonitoringNamespace}
+	}
+
+	return openshiftBackend{namespace: defaultMonitoringNamespace}
+}
+
+func (openshiftBackend) Name() string {
+	return OpenshiftBackendName
+}
+
+func (b openshiftBackend) Namespace() string {
+	return b.namespace
+}
+
+func (openshiftBackend) ServiceAccount() string {
+	return openshiftPrometheusServiceAccount
+}
+
+func (openshiftBackend) SelectorLabels() map[string]string {
+	return nil
+}
+
+func (openshiftBackend) RoleRules() []rbacv1.PolicyRule {
+	return getPrometheusRoleRules()
+}
+
+func (openshiftBackend) ReconcileNamespace(ctx context.Context, cl client.Client, namespace string, logger logr.Logger) error {
+	return reconcileNamespace(ctx, cl, namespace, logger)
+}
+
+// kubePrometheusStackBackend is a prometheus-operator based stack, that only selects the ServiceMonitors and the
+// PrometheusRules with its selector labels. Its namespace, service account and selector labels are set by the
+// MONITORING_NAMESPACE, PROMETHEUS_SERVICE_ACCOUNT and PROMETHEUS_SELECTOR_LABELS environment variables.
+type kubePrometheusStackBackend struct {
+	namespace      string
+	serviceAccount string
+	selectorLabels map[string]string
+}
+
+func newKubePrometheusStackBackend() *kubePrometheusStackBackend {
+	selector, found := os.LookupEnv(hcoutil.PrometheusSelectorLabelsEnvV)
+	if !found {
+		selector = defaultKubePrometheusStackSelectorLabels
+	}
+
+	selectorLabels, err := labels.ConvertSelectorToLabelsMap(selector)
+	if err != nil {
+		logger.Error(err, "failed to parse the Prometheus selector labels; using the default labels", "labels", selector)
+		selectorLabels, _ = labels.ConvertSelectorToLabelsMap(defaultKubePrometheusStackSelectorLabels)
+	}
+
+	return &kubePrometheusStackBackend{
+		namespace:      getEnvOrDefault(hcoutil.MonitoringNamespaceEnvV, defaultMonitoringNamespace),
+		serviceAccount: getEnvOrDefault(hcoutil.PrometheusServiceAccountEnvV, defaultKubePrometheusStackServiceAccount),
+		selectorLabels: selectorLabels,
+	}
+}
+
+func (*kubePrometheusStackBackend) Name() string {
+	return KubePrometheusStackBackendName
+}
+
+func (b *kubePrometheusStackBackend) Namespace() string {
+	return b.namespace
+}
+
+func (b *kubePrometheusStackBackend) ServiceAccount() string {
+	return b.serviceAccount
+}
+
+func (b *kubePrometheusStackBackend) SelectorLabels() map[string]string {
+	return maps.Clone(b.selectorLabels)
+}
+
+// RoleRules also allows the Prometheus server to discover the metrics endpoint using EndpointSlices, that are used by
+// the recent prometheus-operator versions
+func (*kubePrometheusStackBackend) RoleRules() []rbacv1.PolicyRule {
+	return append(getPrometheusRoleRules(), rbacv1.PolicyRule{
+		APIGroups: []string{"discovery.k8s.io"},
+		Resources: []string{"endpointslices"},
+		Verbs:     []string{"get", "list", "watch"},
+	})
+}
+
+// ReconcileNamespace does nothing; the kube-prometheus-stack Prometheus selects the ServiceMonitors of all the
+// namespaces, by default
+func (*kubePrometheusStackBackend) ReconcileNamespace(_ context.Context, _ client.Client, _ string, _ logr.Logger) error {
+	return nil
+}
+
+func getEnvOrDefault(name, defaultValue string) string {
+	if value, found := os.LookupEnv(name); found && value != "" {
+		return value
+	}
+
+	return defaultValue
+}
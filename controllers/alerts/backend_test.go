@@ -0,0 +1,203 @@
+package alerts
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// kubernetesClusterInfoMock mocks a non-OpenShift cluster
+type kubernetesClusterInfoMock struct {
+	commontestutils.ClusterInfoMock
+}
+
+func (kubernetesClusterInfoMock) IsOpenshift() bool {
+	return false
+}
+
+// noMonitoringClusterInfoMock mocks a non-OpenShift cluster without the prometheus-operator CRDs
+type noMonitoringClusterInfoMock struct {
+	kubernetesClusterInfoMock
+}
+
+func (noMonitoringClusterInfoMock) IsMonitoringAvailable() bool {
+	return false
+}
+
+var _ = Describe("monitoring backend tests", func() {
+	var (
+		ns  *corev1.Namespace
+		ee  = commontestutils.NewEventEmitterMock()
+		env = []string{
+			hcoutil.MonitoringBackendEnvV,
+			hcoutil.MonitoringNamespaceEnvV,
+			hcoutil.PrometheusServiceAccountEnvV,
+			hcoutil.PrometheusSelectorLabelsEnvV,
+		}
+	)
+
+	BeforeEach(func() {
+		ee.Reset()
+		ns = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: commontestutils.Namespace,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		for _, name := range env {
+			Expect(os.Unsetenv(name)).To(Succeed())
+		}
+	})
+
+	Context("backend selection", func() {
+		It("should select the openshift backend on OpenShift", func() {
+			backend := GetMonitoringBackend(commontestutils.ClusterInfoMock{})
+			Expect(backend.Name()).To(Equal(OpenshiftBackendName))
+			Expect(backend.Namespace()).To(Equal(openshiftMonitoringNamespace))
+		})
+
+		It("should select the kube-prometheus-stack backend on Kubernetes with the prometheus-operator CRDs", func() {
+			backend := GetMonitoringBackend(kubernetesClusterInfoMock{})
+			Expect(backend.Name()).To(Equal(KubePrometheusStackBackendName))
+			Expect(backend.Namespace()).To(Equal(defaultMonitoringNamespace))
+			Expect(backend.ServiceAccount()).To(Equal(defaultKubePrometheusStackServiceAccount))
+		})
+
+		It("should select the openshift backend on Kubernetes without the prometheus-operator CRDs", func() {
+			backend := GetMonitoringBackend(noMonitoringClusterInfoMock{})
+			Expect(backend.Name()).To(Equal(OpenshiftBackendName))
+			Expect(backend.Namespace()).To(Equal(defaultMonitoringNamespace))
+			Expect(backend.ServiceAccount()).To(Equal(openshiftPrometheusServiceAccount))
+			Expect(backend.SelectorLabels()).To(BeEmpty())
+		})
+
+		It("should select the backend of the MONITORING_BACKEND environment variable", func() {
+			Expect(os.Setenv(hcoutil.MonitoringBackendEnvV, KubePrometheusStackBackendName)).To(Succeed())
+			Expect(GetMonitoringBackend(commontestutils.ClusterInfoMock{}).Name()).To(Equal(KubePrometheusStackBackendName))
+			Expect(GetMonitoringBackend(kubernetesClusterInfoMock{}).Name()).To(Equal(KubePrometheusStackBackendName))
+
+			Expect(os.Setenv(hcoutil.MonitoringBackendEnvV, OpenshiftBackendName)).To(Succeed())
+			Expect(GetMonitoringBackend(kubernetesClusterInfoMock{}).Name()).To(Equal(OpenshiftBackendName))
+		})
+
+		It("should detect the backend if the MONITORING_BACKEND environment variable is unknown", func() {
+			Expect(os.Setenv(hcoutil.MonitoringBackendEnvV, "unknown")).To(Succeed())
+			Expect(GetMonitoringBackend(commontestutils.ClusterInfoMock{}).Name()).To(Equal(OpenshiftBackendName))
+			Expect(GetMonitoringBackend(kubernetesClusterInfoMock{}).Name()).To(Equal(KubePrometheusStackBackendName))
+		})
+	})
+
+	Context("openshift backend on Kubernetes", func() {
+		It("should create the resources for the prometheus-k8s service account of the monitoring namespace", func() {
+			Expect(os.Setenv(hcoutil.MonitoringBackendEnvV, OpenshiftBackendName)).To(Succeed())
+
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(kubernetesClusterInfoMock{}, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(commontestutils.NewReq(nil), false)).To(Succeed())
+
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+			Expect(pr.Labels).ToNot(HaveKey("release"))
+
+			sm := &monitoringv1.ServiceMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).To(Succeed())
+			Expect(sm.Labels).ToNot(HaveKey("release"))
+
+			role := &rbacv1.Role{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: roleName}, role)).To(Succeed())
+			Expect(role.Rules).ToNot(ContainElement(HaveField("Resources", ContainElement("endpointslices"))))
+
+			rb := &rbacv1.RoleBinding{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: roleName}, rb)).To(Succeed())
+			Expect(rb.Subjects).To(HaveLen(1))
+			Expect(rb.Subjects[0].Name).To(Equal("prometheus-k8s"))
+			Expect(rb.Subjects[0].Namespace).To(Equal(defaultMonitoringNamespace))
+
+			foundNS := &corev1.Namespace{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: r.namespace}, foundNS)).To(Succeed())
+			Expect(foundNS.Labels).To(HaveKeyWithValue(hcoutil.PrometheusNSLabel, "true"))
+		})
+	})
+
+	Context("kube-prometheus-stack backend", func() {
+		BeforeEach(func() {
+			Expect(os.Setenv(hcoutil.MonitoringBackendEnvV, KubePrometheusStackBackendName)).To(Succeed())
+		})
+
+		It("should use the default configuration", func() {
+			backend := GetMonitoringBackend(kubernetesClusterInfoMock{})
+
+			Expect(backend.Namespace()).To(Equal(defaultMonitoringNamespace))
+			Expect(backend.ServiceAccount()).To(Equal(defaultKubePrometheusStackServiceAccount))
+			Expect(backend.SelectorLabels()).To(Equal(map[string]string{"release": "kube-prometheus-stack"}))
+		})
+
+		It("should use the configuration of the environment variables", func() {
+			Expect(os.Setenv(hcoutil.MonitoringNamespaceEnvV, "prometheus")).To(Succeed())
+			Expect(os.Setenv(hcoutil.PrometheusServiceAccountEnvV, "my-prometheus")).To(Succeed())
+			Expect(os.Setenv(hcoutil.PrometheusSelectorLabelsEnvV, "release=my-release,team=virt")).To(Succeed())
+
+			backend := GetMonitoringBackend(kubernetesClusterInfoMock{})
+
+			Expect(backend.Namespace()).To(Equal("prometheus"))
+			Expect(backend.ServiceAccount()).To(Equal("my-prometheus"))
+			Expect(backend.SelectorLabels()).To(Equal(map[string]string{"release": "my-release", "team": "virt"}))
+		})
+
+		It("should use the default selector labels if the environment variable is malformed", func() {
+			Expect(os.Setenv(hcoutil.PrometheusSelectorLabelsEnvV, "release")).To(Succeed())
+
+			backend := GetMonitoringBackend(kubernetesClusterInfoMock{})
+			Expect(backend.SelectorLabels()).To(Equal(map[string]string{"release": "kube-prometheus-stack"}))
+		})
+
+		It("should create the resources for the kube-prometheus-stack Prometheus", func() {
+			Expect(os.Setenv(hcoutil.MonitoringNamespaceEnvV, "prometheus")).To(Succeed())
+			Expect(os.Setenv(hcoutil.PrometheusServiceAccountEnvV, "my-prometheus")).To(Succeed())
+			Expect(os.Setenv(hcoutil.PrometheusSelectorLabelsEnvV, "release=my-release")).To(Succeed())
+
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(kubernetesClusterInfoMock{}, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(commontestutils.NewReq(nil), false)).To(Succeed())
+
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+			Expect(pr.Labels).To(HaveKeyWithValue("release", "my-release"))
+
+			sm := &monitoringv1.ServiceMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).To(Succeed())
+			Expect(sm.Labels).To(HaveKeyWithValue("release", "my-release"))
+			Expect(sm.Spec.Selector.MatchLabels).ToNot(HaveKey("release"))
+
+			role := &rbacv1.Role{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: roleName}, role)).To(Succeed())
+			Expect(role.Rules).To(ContainElement(HaveField("Resources", ContainElement("endpointslices"))))
+
+			rb := &rbacv1.RoleBinding{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: roleName}, rb)).To(Succeed())
+			Expect(rb.Subjects).To(HaveLen(1))
+			Expect(rb.Subjects[0].Name).To(Equal("my-prometheus"))
+			Expect(rb.Subjects[0].Namespace).To(Equal("prometheus"))
+
+			foundNS := &corev1.Namespace{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: r.namespace}, foundNS)).To(Succeed())
+			Expect(foundNS.Labels).ToNot(HaveKey(hcoutil.PrometheusNSLabel))
+			Expect(foundNS.Annotations).ToNot(HaveKey(hcoutil.OpenshiftNodeSelectorAnn))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -20,6 +21,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
@@ -35,6 +37,7 @@ func TestAlerts(t *testing.T) {
 var _ = Describe("alert tests", func() {
 	var (
 		ci            = commontestutils.ClusterInfoMock{}
+		backend       = GetMonitoringBackend(ci)
 		ee            = commontestutils.NewEventEmitterMock()
 		ns            *corev1.Namespace
 		req           *common.HcoRequest
@@ -327,6 +330,199 @@ var _ = Describe("alert tests", func() {
 			Expect(metrics.GetOverwrittenModificationsCount(monitoringv1.PrometheusRuleKind, ruleName)).To(BeEquivalentTo(currentMetric))
 		})
 
+		It("should merge the alert rule overrides of the HyperConverged CR", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.AlertRuleOverrides = []hcov1beta1.AlertRuleOverride{
+				{
+					Alert:    "HCOInstallationIncomplete",
+					For:      &metav1.Duration{Duration: 2 * time.Hour},
+					Severity: ptr.To("warning"),
+					Labels:   map[string]string{"team": "virt"},
+				},
+				{
+					Alert:    "KubeVirtCRModified",
+					Disabled: true,
+				},
+			}
+			req = commontestutils.NewReq(hco)
+
+			cl := commontestutils.InitClient([]client.Object{ns, hco})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+
+			alerts := getAlerts(pr)
+			Expect(alerts).ToNot(HaveKey("KubeVirtCRModified"))
+			Expect(alerts).To(HaveKey("HCOInstallationIncomplete"))
+
+			alert := alerts["HCOInstallationIncomplete"]
+			Expect(alert.For).To(HaveValue(Equal(monitoringv1.Duration("2h"))))
+			Expect(alert.Labels).To(HaveKeyWithValue("severity", "warning"))
+			Expect(alert.Labels).To(HaveKeyWithValue("team", "virt"))
+		})
+
+		It("should read the alert rule overrides from the HyperConverged CR, before the main reconciliation reads it", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.AlertRuleOverrides = []hcov1beta1.AlertRuleOverride{
+				{
+					Alert:    "KubeVirtCRModified",
+					Disabled: true,
+				},
+			}
+			req = commontestutils.NewReq(nil)
+
+			cl := commontestutils.InitClient([]client.Object{ns, hco})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+			Expect(getAlerts(pr)).ToNot(HaveKey("KubeVirtCRModified"))
+		})
+
+		It("should skip invalid alert rule overrides, and apply the valid ones", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.AlertRuleOverrides = []hcov1beta1.AlertRuleOverride{
+				{
+					Alert:    "KubeVirtCRModified",
+					Disabled: true,
+				},
+				{
+					Alert:     "HCOInstallationIncomplete",
+					Threshold: ptr.To("1"),
+				},
+			}
+			req = commontestutils.NewReq(hco)
+
+			cl := commontestutils.InitClient([]client.Object{ns, hco})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+
+			newRule, err := rules.BuildPrometheusRule(commontestutils.Namespace, getDeploymentReference(ci.GetDeployment()))
+			Expect(err).ToNot(HaveOccurred())
+
+			alerts := getAlerts(pr)
+			Expect(alerts).ToNot(HaveKey("KubeVirtCRModified"))
+			Expect(alerts).To(HaveKeyWithValue("HCOInstallationIncomplete", getAlerts(newRule)["HCOInstallationIncomplete"]))
+
+			Expect(ee.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "InvalidConfiguration",
+					Msg:       "invalid configuration of the " + ruleName + " PrometheusRule: invalid override of the HCOInstallationIncomplete alert: the alert has no configurable threshold",
+				},
+			})).To(BeTrue())
+		})
+
+		It("should emit the InvalidConfiguration event only when the configuration error changes", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.AlertRuleOverrides = []hcov1beta1.AlertRuleOverride{
+				{
+					Alert:     "HCOInstallationIncomplete",
+					Threshold: ptr.To("1"),
+				},
+			}
+			req = commontestutils.NewReq(hco)
+
+			cl := commontestutils.InitClient([]client.Object{ns, hco})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			invalidConfigEvent := []commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "InvalidConfiguration",
+					Msg:       "invalid configuration of the " + ruleName + " PrometheusRule: invalid override of the HCOInstallationIncomplete alert: the alert has no configurable threshold",
+				},
+			}
+
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			Expect(ee.CheckEvents(invalidConfigEvent)).To(BeTrue())
+
+			ee.Reset()
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			Expect(ee.CheckEvents(invalidConfigEvent)).To(BeFalse())
+
+			hco.Spec.AlertRuleOverrides[0].Alert = "KubeVirtCRModified"
+			Expect(cl.Update(context.Background(), hco)).To(Succeed())
+
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			Expect(ee.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "InvalidConfiguration",
+					Msg:       "invalid configuration of the " + ruleName + " PrometheusRule: invalid override of the KubeVirtCRModified alert: the alert has no configurable threshold",
+				},
+			})).To(BeTrue())
+		})
+
+		It("should add the rules of the service level objectives of the HyperConverged CR", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.ServiceLevelObjectives = []hcov1beta1.ServiceLevelObjective{
+				{
+					Name:      "vm-availability",
+					Indicator: hcov1beta1.VMAvailabilityIndicator,
+					Objective: "99.9",
+				},
+			}
+			hco.Spec.AlertRuleOverrides = []hcov1beta1.AlertRuleOverride{
+				{
+					Alert:  "VMAvailabilityErrorBudgetBurn",
+					Labels: map[string]string{"team": "virt"},
+				},
+			}
+			req = commontestutils.NewReq(hco)
+
+			cl := commontestutils.InitClient([]client.Object{ns, hco})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+
+			Expect(pr.Spec.Groups).To(ContainElement(HaveField("Name", "kubevirt-hco-slo-vm-availability.rules")))
+
+			alerts := getAlerts(pr)
+			Expect(alerts).To(HaveKey("VMAvailabilityErrorBudgetBurn"))
+			Expect(alerts["VMAvailabilityErrorBudgetBurn"].Labels).To(HaveKeyWithValue("team", "virt"))
+		})
+
+		It("should ignore invalid service level objectives", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.ServiceLevelObjectives = []hcov1beta1.ServiceLevelObjective{
+				{
+					Name:      "vm-availability",
+					Indicator: hcov1beta1.VMAvailabilityIndicator,
+					Objective: "99.9",
+					Window:    &metav1.Duration{Duration: time.Hour},
+				},
+			}
+			req = commontestutils.NewReq(hco)
+
+			cl := commontestutils.InitClient([]client.Object{ns, hco})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+
+			newRule, err := rules.BuildPrometheusRule(commontestutils.Namespace, getDeploymentReference(ci.GetDeployment()))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pr.Spec).To(Equal(newRule.Spec))
+
+			Expect(ee.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "InvalidConfiguration",
+					Msg:       "invalid configuration of the " + ruleName + " PrometheusRule: invalid service level objective vm-availability: window must be at least 24h; got 1h0m0s",
+				},
+			})).To(BeTrue())
+		})
+
 		It("should use the default runbook URL template when no ENV Variable is set", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
 			promRule, err := rules.BuildPrometheusRule(commontestutils.Namespace, owner)
@@ -415,7 +611,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the labels if modified", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existRole := newRole(owner, commontestutils.Namespace)
+			existRole := newRole(owner, commontestutils.Namespace, backend)
 			existRole.Labels = map[string]string{
 				"wrongKey1": "wrongValue1",
 				"wrongKey2": "wrongValue2",
@@ -436,7 +632,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the labels if it's missing", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existRole := newRole(owner, commontestutils.Namespace)
+			existRole := newRole(owner, commontestutils.Namespace, backend)
 			existRole.Labels = nil
 
 			cl := commontestutils.InitClient([]client.Object{ns, existRole})
@@ -460,7 +656,7 @@ var _ = Describe("alert tests", func() {
 				BlockOwnerDeletion: ptr.To(true),
 				UID:                "0987654321",
 			}
-			existRole := newRole(owner, commontestutils.Namespace)
+			existRole := newRole(owner, commontestutils.Namespace, backend)
 			cl := commontestutils.InitClient([]client.Object{ns, existRole})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
 
@@ -491,7 +687,7 @@ var _ = Describe("alert tests", func() {
 				BlockOwnerDeletion: ptr.To(true),
 				UID:                "0987654321",
 			}
-			existRole := newRole(owner, commontestutils.Namespace)
+			existRole := newRole(owner, commontestutils.Namespace, backend)
 			cl := commontestutils.InitClient([]client.Object{ns, existRole})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
 
@@ -521,7 +717,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the referenceOwner if missing", func() {
 			owner := metav1.OwnerReference{}
-			existRole := newRole(owner, commontestutils.Namespace)
+			existRole := newRole(owner, commontestutils.Namespace, backend)
 			existRole.OwnerReferences = nil
 			cl := commontestutils.InitClient([]client.Object{ns, existRole})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
@@ -544,7 +740,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the Rules if modified", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existRole := newRole(owner, commontestutils.Namespace)
+			existRole := newRole(owner, commontestutils.Namespace, backend)
 
 			existRole.Rules = []rbacv1.PolicyRule{
 				{
@@ -575,7 +771,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the Rules if it's missing", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existRole := newRole(owner, commontestutils.Namespace)
+			existRole := newRole(owner, commontestutils.Namespace, backend)
 
 			existRole.Rules = nil
 
@@ -610,7 +806,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the labels if modified", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existRB := newRoleBinding(owner, commontestutils.Namespace, ci)
+			existRB := newRoleBinding(owner, commontestutils.Namespace, backend)
 			existRB.Labels = map[string]string{
 				"wrongKey1": "wrongValue1",
 				"wrongKey2": "wrongValue2",
@@ -631,7 +827,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the labels if it's missing", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existRB := newRoleBinding(owner, commontestutils.Namespace, ci)
+			existRB := newRoleBinding(owner, commontestutils.Namespace, backend)
 			existRB.Labels = nil
 
 			cl := commontestutils.InitClient([]client.Object{ns, existRB})
@@ -655,7 +851,7 @@ var _ = Describe("alert tests", func() {
 				BlockOwnerDeletion: ptr.To(true),
 				UID:                "0987654321",
 			}
-			existRB := newRoleBinding(owner, commontestutils.Namespace, ci)
+			existRB := newRoleBinding(owner, commontestutils.Namespace, backend)
 			cl := commontestutils.InitClient([]client.Object{ns, existRB})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
 
@@ -686,7 +882,7 @@ var _ = Describe("alert tests", func() {
 				BlockOwnerDeletion: ptr.To(true),
 				UID:                "0987654321",
 			}
-			existRB := newRoleBinding(owner, commontestutils.Namespace, ci)
+			existRB := newRoleBinding(owner, commontestutils.Namespace, backend)
 			cl := commontestutils.InitClient([]client.Object{ns, existRB})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
 
@@ -716,7 +912,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the referenceOwner if missing", func() {
 			owner := metav1.OwnerReference{}
-			existRB := newRoleBinding(owner, commontestutils.Namespace, ci)
+			existRB := newRoleBinding(owner, commontestutils.Namespace, backend)
 			existRB.OwnerReferences = nil
 			cl := commontestutils.InitClient([]client.Object{ns, existRB})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
@@ -739,7 +935,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the RoleRef if modified", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existRB := newRoleBinding(owner, commontestutils.Namespace, ci)
+			existRB := newRoleBinding(owner, commontestutils.Namespace, backend)
 
 			existRB.RoleRef = rbacv1.RoleRef{
 				APIGroup: "wrongAPIGroup",
@@ -763,7 +959,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the RoleRef if it's missing", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existRB := newRoleBinding(owner, commontestutils.Namespace, ci)
+			existRB := newRoleBinding(owner, commontestutils.Namespace, backend)
 
 			existRB.RoleRef = rbacv1.RoleRef{}
 
@@ -783,7 +979,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the Subjects if modified", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existRB := newRoleBinding(owner, commontestutils.Namespace, ci)
+			existRB := newRoleBinding(owner, commontestutils.Namespace, backend)
 
 			existRB.Subjects = []rbacv1.Subject{
 				{
@@ -807,7 +1003,7 @@ var _ = Describe("alert tests", func() {
 			Expect(rb.Subjects).To(HaveLen(1))
 			Expect(rb.Subjects[0].Kind).To(Equal(rbacv1.ServiceAccountKind))
 			Expect(rb.Subjects[0].Name).To(Equal("prometheus-k8s"))
-			Expect(rb.Subjects[0].Namespace).To(Equal(getMonitoringNamespace(ci)))
+			Expect(rb.Subjects[0].Namespace).To(Equal(backend.Namespace()))
 
 			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
 			Expect(metrics.GetOverwrittenModificationsCount("RoleBinding", roleName)).To(BeEquivalentTo(currentMetric))
@@ -815,7 +1011,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the Subjects if it's missing", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existRB := newRoleBinding(owner, commontestutils.Namespace, ci)
+			existRB := newRoleBinding(owner, commontestutils.Namespace, backend)
 
 			existRB.Subjects = nil
 
@@ -829,7 +1025,7 @@ var _ = Describe("alert tests", func() {
 			Expect(rb.Subjects).To(HaveLen(1))
 			Expect(rb.Subjects[0].Kind).To(Equal(rbacv1.ServiceAccountKind))
 			Expect(rb.Subjects[0].Name).To(Equal("prometheus-k8s"))
-			Expect(rb.Subjects[0].Namespace).To(Equal(getMonitoringNamespace(ci)))
+			Expect(rb.Subjects[0].Namespace).To(Equal(backend.Namespace()))
 
 			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
 			Expect(metrics.GetOverwrittenModificationsCount("RoleBinding", roleName)).To(BeEquivalentTo(currentMetric))
@@ -1333,3 +1529,16 @@ var _ = Describe("alert tests", func() {
 		})
 	})
 })
+
+func getAlerts(pr *monitoringv1.PrometheusRule) map[string]monitoringv1.Rule {
+	alerts := make(map[string]monitoringv1.Rule)
+	for _, group := range pr.Spec.Groups {
+		for _, rule := range group.Rules {
+			if rule.Alert != "" {
+				alerts[rule.Alert] = rule
+			}
+		}
+	}
+
+	return alerts
+}
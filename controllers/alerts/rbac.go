@@ -21,9 +21,9 @@ type RoleReconciler struct {
 	theRole *rbacv1.Role
 }
 
-func newRoleReconciler(namespace string, owner metav1.OwnerReference) *RoleReconciler {
+func newRoleReconciler(namespace string, owner metav1.OwnerReference, backend MonitoringBackend) *RoleReconciler {
 	return &RoleReconciler{
-		theRole: newRole(owner, namespace),
+		theRole: newRole(owner, namespace, backend),
 	}
 }
 
@@ -75,7 +75,7 @@ func (r *RoleReconciler) UpdateExistingResource(ctx context.Context, cl client.C
 
 }
 
-func newRole(owner metav1.OwnerReference, namespace string) *rbacv1.Role {
+func newRole(owner metav1.OwnerReference, namespace string, backend MonitoringBackend) *rbacv1.Role {
 	return &rbacv1.Role{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: rbacv1.SchemeGroupVersion.String(),
@@ -87,13 +87,7 @@ func newRole(owner metav1.OwnerReference, namespace string) *rbacv1.Role {
 			Labels:          hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring),
 			OwnerReferences: []metav1.OwnerReference{owner},
 		},
-		Rules: []rbacv1.PolicyRule{
-			{
-				APIGroups: []string{""},
-				Resources: []string{"services", "endpoints", "pods"},
-				Verbs:     []string{"get", "list", "watch"},
-			},
-		},
+		Rules: backend.RoleRules(),
 	}
 }
 
@@ -102,9 +96,9 @@ type RoleBindingReconciler struct {
 	theRoleBinding *rbacv1.RoleBinding
 }
 
-func newRoleBindingReconciler(namespace string, owner metav1.OwnerReference, ci hcoutil.ClusterInfo) *RoleBindingReconciler {
+func newRoleBindingReconciler(namespace string, owner metav1.OwnerReference, backend MonitoringBackend) *RoleBindingReconciler {
 	return &RoleBindingReconciler{
-		theRoleBinding: newRoleBinding(owner, namespace, ci),
+		theRoleBinding: newRoleBinding(owner, namespace, backend),
 	}
 }
 
@@ -160,7 +154,7 @@ func (r *RoleBindingReconciler) UpdateExistingResource(ctx context.Context, cl c
 	return existing, needUpdate, nil
 }
 
-func newRoleBinding(owner metav1.OwnerReference, namespace string, ci hcoutil.ClusterInfo) *rbacv1.RoleBinding {
+func newRoleBinding(owner metav1.OwnerReference, namespace string, backend MonitoringBackend) *rbacv1.RoleBinding {
 	return &rbacv1.RoleBinding{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: rbacv1.SchemeGroupVersion.String(),
@@ -180,8 +174,8 @@ func newRoleBinding(owner metav1.OwnerReference, namespace string, ci hcoutil.Cl
 		Subjects: []rbacv1.Subject{
 			{
 				Kind:      rbacv1.ServiceAccountKind,
-				Name:      "prometheus-k8s",
-				Namespace: getMonitoringNamespace(ci),
+				Name:      backend.ServiceAccount(),
+				Namespace: backend.Namespace(),
 			},
 		},
 	}
@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -28,13 +29,22 @@ type MetricReconciler interface {
 	UpdateExistingResource(context.Context, client.Client, client.Object, logr.Logger) (client.Object, bool, error)
 }
 
+// hcoAwareReconciler is a MetricReconciler that its resource depends on the HyperConverged CR
+type hcoAwareReconciler interface {
+	SetHyperConverged(hc *hcov1beta1.HyperConverged) error
+}
+
 type MonitoringReconciler struct {
 	reconcilers   []MetricReconciler
 	scheme        *runtime.Scheme
 	latestObjects []client.Object
 	client        client.Client
 	namespace     string
+	backend       MonitoringBackend
 	eventEmitter  hcoutil.EventEmitter
+	// configErrors holds the latest configuration error of each HyperConverged aware reconciler, by its kind, so the
+	// InvalidConfiguration event is only emitted when the error changes
+	configErrors map[string]string
 }
 
 var logger = logf.Log.WithName("hyperconverged-operator-monitoring-reconciler")
@@ -58,28 +68,33 @@ func NewMonitoringReconciler(ci hcoutil.ClusterInfo, cl client.Client, ee hcouti
 		owner = getDeploymentReference(deployment)
 	}
 
+	backend := GetMonitoringBackend(ci)
+	logger.Info("using the monitoring backend", "backend", backend.Name(), "namespace", backend.Namespace())
+
 	return &MonitoringReconciler{
-		reconcilers:  getReconcilers(ci, namespace, owner),
+		reconcilers:  getReconcilers(backend, namespace, owner),
 		scheme:       scheme,
 		client:       cl,
 		namespace:    namespace,
+		backend:      backend,
 		eventEmitter: ee,
+		configErrors: make(map[string]string),
 	}
 }
 
-func getReconcilers(ci hcoutil.ClusterInfo, namespace string, owner metav1.OwnerReference) []MetricReconciler {
-	alertRuleReconciler, err := newAlertRuleReconciler(namespace, owner)
+func getReconcilers(backend MonitoringBackend, namespace string, owner metav1.OwnerReference) []MetricReconciler {
+	alertRuleReconciler, err := newAlertRuleReconciler(namespace, owner, backend)
 	if err != nil {
 		logger.Error(err, "failed to create the 'PrometheusRule' reconciler")
 	}
 
 	reconcilers := []MetricReconciler{
 		alertRuleReconciler,
-		newRoleReconciler(namespace, owner),
-		newRoleBindingReconciler(namespace, owner, ci),
+		newRoleReconciler(namespace, owner, backend),
+		newRoleBindingReconciler(namespace, owner, backend),
 		newMetricServiceReconciler(namespace, owner),
 		newSecretReconciler(namespace, owner),
-		newServiceMonitorReconciler(namespace, owner),
+		newServiceMonitorReconciler(namespace, owner, backend),
 	}
 
 	return reconcilers
@@ -90,13 +105,22 @@ func (r *MonitoringReconciler) Reconcile(req *common.HcoRequest, firstLoop bool)
 		return nil
 	}
 
-	if err := reconcileNamespace(req.Ctx, r.client, r.namespace, req.Logger); err != nil {
+	if err := r.backend.ReconcileNamespace(req.Ctx, r.client, r.namespace, req.Logger); err != nil {
+		return err
+	}
+
+	hc, err := r.getHyperConverged(req)
+	if err != nil {
 		return err
 	}
 
 	objects := make([]client.Object, 0, len(r.reconcilers))
 
 	for _, rc := range r.reconcilers {
+		if hcoAware, ok := rc.(hcoAwareReconciler); ok {
+			r.setHyperConverged(req, rc, hcoAware, hc)
+		}
+
 		obj, err := r.ReconcileOneResource(req, rc, firstLoop)
 		if err != nil {
 			return err
@@ -111,6 +135,37 @@ func (r *MonitoringReconciler) Reconcile(req *common.HcoRequest, firstLoop bool)
 	return nil
 }
 
+func (r *MonitoringReconciler) setHyperConverged(req *common.HcoRequest, rc MetricReconciler, hcoAware hcoAwareReconciler, hc *hcov1beta1.HyperConverged) {
+	err := hcoAware.SetHyperConverged(hc)
+	if err == nil {
+		delete(r.configErrors, rc.Kind())
+		return
+	}
+
+	if r.configErrors[rc.Kind()] != err.Error() {
+		r.configErrors[rc.Kind()] = err.Error()
+		req.Logger.Error(err, fmt.Sprintf("invalid configuration of the %s; ignoring the invalid entries", rc.Kind()))
+		r.eventEmitter.EmitEvent(hc, corev1.EventTypeWarning, "InvalidConfiguration", fmt.Sprintf("invalid configuration of the %s %s: %v", rc.ResourceName(), rc.Kind(), err))
+	}
+}
+
+// getHyperConverged reads the HyperConverged CR, for the resources that depend on it. The monitoring resources are
+// reconciled before the HyperConverged CR is read by the main reconciliation, and even if it is missing, to fire the
+// HCOInstallationIncomplete alert.
+func (r *MonitoringReconciler) getHyperConverged(req *common.HcoRequest) (*hcov1beta1.HyperConverged, error) {
+	hc := &hcov1beta1.HyperConverged{}
+	if err := r.client.Get(req.Ctx, req.NamespacedName, hc); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		req.Logger.Error(err, "unexpected error while reading the HyperConverged CR")
+		return nil, err
+	}
+
+	return hc, nil
+}
+
 func (r *MonitoringReconciler) ReconcileOneResource(req *common.HcoRequest, reconciler MetricReconciler, firstLoop bool) (client.Object, error) {
 	if r == nil {
 		return nil, nil // not initialized (not running on openshift). do nothing
@@ -2,6 +2,7 @@ package alerts
 
 import (
 	"context"
+	"maps"
 	"reflect"
 
 	"github.com/go-logr/logr"
@@ -18,8 +19,14 @@ type serviceMonitorReconciler struct {
 	theServiceMonitor *monitoringv1.ServiceMonitor
 }
 
-func newServiceMonitorReconciler(namespace string, owner metav1.OwnerReference) *serviceMonitorReconciler {
-	return &serviceMonitorReconciler{theServiceMonitor: NewServiceMonitor(namespace, owner)}
+func newServiceMonitorReconciler(namespace string, owner metav1.OwnerReference, backend MonitoringBackend) *serviceMonitorReconciler {
+	serviceMonitor := NewServiceMonitor(namespace, owner)
+
+	// the selector labels are only added to the ServiceMonitor labels; not to its service selector
+	serviceMonitor.Labels = maps.Clone(serviceMonitor.Labels)
+	maps.Copy(serviceMonitor.Labels, backend.SelectorLabels())
+
+	return &serviceMonitorReconciler{theServiceMonitor: serviceMonitor}
 }
 
 func (r serviceMonitorReconciler) Kind() string {
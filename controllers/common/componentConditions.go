@@ -0,0 +1,97 @@
+package common
+
+import (
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+const componentReasonNotReported = "NotReported"
+
+var (
+	// ComponentConditionTypes are the condition types that are reported for each component, in the componentsHealth
+	// status field
+	ComponentConditionTypes = []string{
+		hcov1beta1.ConditionAvailable,
+		hcov1beta1.ConditionProgressing,
+		hcov1beta1.ConditionDegraded,
+	}
+)
+
+// ComponentConditions holds the in-memory conditions of each component, by the component name
+type ComponentConditions map[string]HcoConditions
+
+func NewComponentConditions() ComponentConditions {
+	return ComponentConditions{}
+}
+
+// SetComponentConditions records the conditions of a resource of the component. A component may be built of several
+// resources, e.g. two deployments; in this case, the worst status of each condition type is kept.
+func (cc ComponentConditions) SetComponentConditions(component string, conditions []metav1.Condition) {
+	conds, exists := cc[component]
+	if !exists {
+		conds = NewHcoConditions()
+		cc[component] = conds
+	}
+
+	if len(conditions) == 0 {
+		reason := fmt.Sprintf("%sConditions", component)
+		message := fmt.Sprintf("%s resource has no conditions", component)
+		setWorseCondition(conds, metav1.Condition{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionFalse, Reason: reason, Message: message})
+		setWorseCondition(conds, metav1.Condition{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionTrue, Reason: reason, Message: message})
+		return
+	}
+
+	foundAvailableCond := false
+	for _, cond := range conditions {
+		if !slices.Contains(ComponentConditionTypes, cond.Type) {
+			continue
+		}
+
+		if cond.Type == hcov1beta1.ConditionAvailable {
+			foundAvailableCond = true
+		}
+
+		if cond.Reason == "" {
+			cond.Reason = componentReasonNotReported
+		}
+
+		setWorseCondition(conds, metav1.Condition{Type: cond.Type, Status: cond.Status, Reason: cond.Reason, Message: cond.Message})
+	}
+
+	if !foundAvailableCond {
+		setWorseCondition(conds, metav1.Condition{
+			Type:    hcov1beta1.ConditionAvailable,
+			Status:  metav1.ConditionFalse,
+			Reason:  fmt.Sprintf("%sNotAvailable", component),
+			Message: `missing "Available" condition`,
+		})
+	}
+}
+
+// setWorseCondition sets the condition, unless the existing condition of the same type already reports a worse state
+func setWorseCondition(conds HcoConditions, cond metav1.Condition) {
+	existing, exists := conds.GetCondition(cond.Type)
+	if !exists || conditionSeverity(cond) > conditionSeverity(existing) {
+		conds.SetStatusCondition(cond)
+	}
+}
+
+func conditionSeverity(cond metav1.Condition) int {
+	unhealthyStatus := metav1.ConditionTrue
+	if cond.Type == hcov1beta1.ConditionAvailable {
+		unhealthyStatus = metav1.ConditionFalse
+	}
+
+	switch cond.Status {
+	case unhealthyStatus:
+		return 2
+	case metav1.ConditionUnknown:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,72 @@
+package common
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+var _ = Describe("Component Conditions Tests", func() {
+	var conds ComponentConditions
+
+	BeforeEach(func() {
+		conds = NewComponentConditions()
+	})
+
+	It("should report a component with no conditions as not available and progressing", func() {
+		conds.SetComponentConditions("KubeVirt", nil)
+
+		Expect(conds).To(HaveKey("KubeVirt"))
+		Expect(conds["KubeVirt"]).To(HaveLen(2))
+		Expect(conds["KubeVirt"][hcov1beta1.ConditionAvailable].Status).To(Equal(metav1.ConditionFalse))
+		Expect(conds["KubeVirt"][hcov1beta1.ConditionAvailable].Reason).To(Equal("KubeVirtConditions"))
+		Expect(conds["KubeVirt"][hcov1beta1.ConditionProgressing].Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should keep only the component condition types, and set a reason if missing", func() {
+		conds.SetComponentConditions("CDI", []metav1.Condition{
+			{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue},
+			{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionFalse, Reason: "Done"},
+			{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionFalse, Reason: "Done"},
+			{Type: hcov1beta1.ConditionUpgradeable, Status: metav1.ConditionTrue, Reason: "Done"},
+		})
+
+		Expect(conds["CDI"]).To(HaveLen(3))
+		Expect(conds["CDI"]).ToNot(HaveKey(hcov1beta1.ConditionUpgradeable))
+		Expect(conds["CDI"][hcov1beta1.ConditionAvailable].Reason).To(Equal("NotReported"))
+		Expect(conds["CDI"][hcov1beta1.ConditionProgressing].Reason).To(Equal("Done"))
+	})
+
+	It("should report a component with a missing Available condition as not available", func() {
+		conds.SetComponentConditions("SSP", []metav1.Condition{
+			{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionFalse, Reason: "Done"},
+		})
+
+		Expect(conds["SSP"][hcov1beta1.ConditionAvailable].Status).To(Equal(metav1.ConditionFalse))
+		Expect(conds["SSP"][hcov1beta1.ConditionAvailable].Reason).To(Equal("SSPNotAvailable"))
+	})
+
+	It("should keep the worst status, if the component is reported several times", func() {
+		healthy := []metav1.Condition{
+			{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue, Reason: "Ready"},
+			{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionFalse, Reason: "Ready"},
+			{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionFalse, Reason: "Ready"},
+		}
+		unhealthy := []metav1.Condition{
+			{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionFalse, Reason: "NotReady"},
+			{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionUnknown, Reason: "NotReady"},
+			{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionTrue, Reason: "NotReady"},
+		}
+
+		conds.SetComponentConditions("ConsolePlugin", healthy)
+		conds.SetComponentConditions("ConsolePlugin", unhealthy)
+		conds.SetComponentConditions("ConsolePlugin", healthy)
+
+		Expect(conds["ConsolePlugin"][hcov1beta1.ConditionAvailable].Status).To(Equal(metav1.ConditionFalse))
+		Expect(conds["ConsolePlugin"][hcov1beta1.ConditionProgressing].Status).To(Equal(metav1.ConditionUnknown))
+		Expect(conds["ConsolePlugin"][hcov1beta1.ConditionDegraded].Status).To(Equal(metav1.ConditionTrue))
+		Expect(conds["ConsolePlugin"][hcov1beta1.ConditionDegraded].Reason).To(Equal("NotReady"))
+	})
+})
@@ -13,4 +13,10 @@ const (
 	JSONPatchSSPAnnotationName  = "ssp.kubevirt.io/jsonpatch"
 	// Tuning Policy annotation name
 	TuningPolicyAnnotationName = util.HCOAnnotationPrefix + "tuningPolicy"
+
+	// The names of the components in the componentsHealth status field, that are not reported by their CR kind
+	ComponentCNAO          = "CNAO"
+	ComponentConsolePlugin = "ConsolePlugin"
+	ComponentWaspAgent     = "WaspAgent"
+	ComponentPasst         = "Passt"
 )
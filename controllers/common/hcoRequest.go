@@ -14,6 +14,7 @@ type HcoRequest struct {
 	reconcile.Request                                     // inheritance of operator request
 	Logger                     logr.Logger                // request logger
 	Conditions                 HcoConditions              // in-memory conditions
+	ComponentConditions        ComponentConditions        // in-memory conditions of each component
 	Ctx                        context.Context            // context of this request, to be use for any other call
 	Instance                   *hcov1beta1.HyperConverged // the current state of the CR, as read from K8s
 	UpgradeMode                bool                       // copy of the reconciler upgrade mode
@@ -22,6 +23,7 @@ type HcoRequest struct {
 	StatusDirty                bool                       // is something was changed in the CR's Status
 	HCOTriggered               bool                       // if the request got triggered by a direct modification on HCO CR
 	Upgradeable                bool                       // if all the operands are upgradeable
+	RequeueReason              string                     // why the request is requeued, if it is; for the metrics
 }
 
 func NewHcoRequest(ctx context.Context, request reconcile.Request, log logr.Logger, upgradeMode, hcoTriggered bool) *HcoRequest {
@@ -29,6 +31,7 @@ func NewHcoRequest(ctx context.Context, request reconcile.Request, log logr.Logg
 		Request:                    request,
 		Logger:                     log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name),
 		Conditions:                 NewHcoConditions(),
+		ComponentConditions:        NewComponentConditions(),
 		Ctx:                        ctx,
 		UpgradeMode:                upgradeMode,
 		ComponentUpgradeInProgress: upgradeMode,
@@ -23,6 +23,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	nodev1 "k8s.io/api/node/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -38,6 +39,7 @@ import (
 
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
@@ -82,12 +84,13 @@ func NewHcoNamespace() *corev1.Namespace {
 
 func NewReq(inst *hcov1beta1.HyperConverged) *common.HcoRequest {
 	return &common.HcoRequest{
-		Request:      TestRequest,
-		Logger:       TestLogger,
-		Conditions:   common.NewHcoConditions(),
-		Ctx:          context.TODO(),
-		Instance:     inst,
-		HCOTriggered: true,
+		Request:             TestRequest,
+		Logger:              TestLogger,
+		Conditions:          common.NewHcoConditions(),
+		ComponentConditions: common.NewComponentConditions(),
+		Ctx:                 context.TODO(),
+		Instance:            inst,
+		HCOTriggered:        true,
 	}
 }
 
@@ -179,6 +182,8 @@ func GetScheme() *runtime.Scheme {
 			corev1.AddToScheme,
 			schedulingv1.AddToScheme,
 			admissionregistrationv1.AddToScheme,
+			migrationsv1alpha1.AddToScheme,
+			nodev1.AddToScheme,
 		} {
 			if err := f(testScheme); err != nil {
 				panic(fmt.Sprintf("failed to add scheme: %T, %v", f, err))
@@ -322,9 +327,15 @@ func (c ClusterInfoMock) IsDeschedulerAvailable() bool {
 func (c ClusterInfoMock) IsNADAvailable() bool {
 	return true
 }
+func (c ClusterInfoMock) IsMigrationPolicyAvailable() bool {
+	return true
+}
 func (c ClusterInfoMock) IsDeschedulerCRDDeployed(_ context.Context, _ client.Client) bool {
 	return true
 }
+func (c ClusterInfoMock) IsMigrationPolicyCRDDeployed(_ context.Context, _ client.Client) bool {
+	return true
+}
 func (c ClusterInfoMock) IsSingleStackIPv6() bool {
 	return true
 }
@@ -48,14 +48,14 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	}
 
 	// Watch for changes to selected (by name) CRDs
-	// look only at descheduler CRD for now
+	// look only at the descheduler and the MigrationPolicy CRDs for now
 	err = c.Watch(
 		source.Kind(
 			mgr.GetCache(), client.Object(&apiextensionsv1.CustomResourceDefinition{}),
 			&operatorhandler.InstrumentedEnqueueRequestForObject[client.Object]{},
 			predicate.NewPredicateFuncs(func(object client.Object) bool {
 				switch object.GetName() {
-				case hcoutil.DeschedulerCRDName:
+				case hcoutil.DeschedulerCRDName, hcoutil.MigrationPolicyCRDName:
 					return true
 				}
 				return false
@@ -96,6 +96,15 @@ func (r *ReconcileCRD) Reconcile(ctx context.Context, req reconcile.Request) (re
 			log.Info("KubeDescheduler CRD got deployed, restarting the operator to reconfigure the operator for the new kind")
 			r.eventEmitter.EmitEvent(nil, corev1.EventTypeNormal, "KubeDescheduler CRD got deployed, restarting the operator to reconfigure the operator for the new kind", "Restarting the operator to be able to read KubeDescheduler CRs ")
 			r.operatorRestart()
+			return reconcile.Result{}, nil
+		}
+	}
+
+	if !hcoutil.GetClusterInfo().IsMigrationPolicyAvailable() {
+		if hcoutil.GetClusterInfo().IsMigrationPolicyCRDDeployed(ctx, r.client) {
+			log.Info("MigrationPolicy CRD got deployed, restarting the operator to reconfigure the operator for the new kind")
+			r.eventEmitter.EmitEvent(nil, corev1.EventTypeNormal, "MigrationPolicy CRD got deployed, restarting the operator to reconfigure the operator for the new kind", "Restarting the operator to be able to read MigrationPolicy CRs ")
+			r.operatorRestart()
 		}
 	}
 
@@ -25,6 +25,11 @@ var (
 			Name: hcoutil.DeschedulerCRDName,
 		},
 	}
+	migrationPolicyRequest = reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name: hcoutil.MigrationPolicyCRDName,
+		},
+	}
 	otherRequest = reconcile.Request{
 		NamespacedName: types.NamespacedName{
 			Name: "other",
@@ -239,6 +244,65 @@ var _ = Describe("CRDController", func() {
 
 			})
 
+			It("Should trigger a restart of the operator if MigrationPolicy was not there and it appeared", func() {
+
+				cl := commontestutils.InitClient(clusterObjects)
+				Expect(hcoutil.GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+
+				Expect(hcoutil.GetClusterInfo().IsMigrationPolicyAvailable()).To(BeFalse(), "MigrationPolicy is not installed")
+
+				testCh := make(chan struct{}, 1)
+
+				r := &ReconcileCRD{
+					client:       cl,
+					restartCh:    testCh,
+					eventEmitter: commontestutils.NewEventEmitterMock(),
+				}
+
+				migrationPolicyCRD := &apiextensionsv1.CustomResourceDefinition{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: hcoutil.MigrationPolicyCRDName,
+					},
+				}
+
+				Expect(cl.Create(context.TODO(), migrationPolicyCRD)).To(Succeed())
+				Expect(hcoutil.GetClusterInfo().IsMigrationPolicyAvailable()).To(BeFalse(), "When the operator started the MigrationPolicy wasn't available")
+				Expect(hcoutil.GetClusterInfo().IsMigrationPolicyCRDDeployed(context.TODO(), cl)).To(BeTrue(), "MigrationPolicy is now installed")
+
+				res, err := r.Reconcile(context.Background(), migrationPolicyRequest)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal(reconcile.Result{}))
+				Eventually(testCh).Should(Receive())
+			})
+
+			It("Should not trigger a restart of the operator if MigrationPolicy was already there and its CRD got updated", func() {
+
+				migrationPolicyCRD := &apiextensionsv1.CustomResourceDefinition{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: hcoutil.MigrationPolicyCRDName,
+					},
+				}
+				clusterObjects := append(clusterObjects, migrationPolicyCRD)
+
+				cl := commontestutils.InitClient(clusterObjects)
+				Expect(hcoutil.GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+
+				Expect(hcoutil.GetClusterInfo().IsMigrationPolicyAvailable()).To(BeTrue(), "MigrationPolicy is already installed")
+
+				testCh := make(chan struct{}, 1)
+
+				r := &ReconcileCRD{
+					client:       cl,
+					restartCh:    testCh,
+					eventEmitter: commontestutils.NewEventEmitterMock(),
+				}
+
+				res, err := r.Reconcile(context.Background(), migrationPolicyRequest)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal(reconcile.Result{}))
+				Consistently(testCh).Should(Not(Receive()))
+			})
+
 		})
 
 	})
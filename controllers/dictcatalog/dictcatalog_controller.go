@@ -0,0 +1,267 @@
+package dictcatalog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// CatalogLabel marks a ConfigMap in the HCO namespace as a DataImportCronTemplate catalog, if its value is "true".
+	// Each data entry of the ConfigMap is a YAML list of DataImportCronTemplates.
+	CatalogLabel = hcoutil.HCOAnnotationPrefix + "dataimportcrontemplate-catalog"
+
+	invalidCatalogReason  = "InvalidDataImportCronTemplateCatalog"
+	catalogConflictReason = "DataImportCronTemplateCatalogConflict"
+)
+
+var (
+	log = logf.Log.WithName("controller_dictcatalog")
+
+	catalogReq = reconcile.Request{
+		NamespacedName: k8stypes.NamespacedName{
+			Name:      "dict-catalog",
+			Namespace: hcoutil.GetOperatorNamespaceFromEnv(),
+		},
+	}
+)
+
+// RegisterReconciler creates a new DataImportCronTemplate catalog Reconciler and registers it into manager.
+func RegisterReconciler(mgr manager.Manager, catalogEvents chan<- event.GenericEvent) error {
+	// The manager cache only watches ConfigMaps with the HCO app label. Use a dedicated cache for the catalog
+	// ConfigMaps, that are created by the cluster admin.
+	catalogCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme:               mgr.GetScheme(),
+		Mapper:               mgr.GetRESTMapper(),
+		DefaultNamespaces:    map[string]cache.Config{hcoutil.GetOperatorNamespaceFromEnv(): {}},
+		DefaultLabelSelector: labels.SelectorFromSet(labels.Set{CatalogLabel: "true"}),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = mgr.Add(catalogCache); err != nil {
+		return err
+	}
+
+	r := newReconciler(catalogCache, hcoutil.GetEventEmitter(), catalogEvents)
+	return add(mgr, r, catalogCache)
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(reader client.Reader, eventEmitter hcoutil.EventEmitter, catalogEvents chan<- event.GenericEvent) *ReconcileDictCatalog {
+	return &ReconcileDictCatalog{
+		reader:        reader,
+		eventEmitter:  eventEmitter,
+		catalogEvents: catalogEvents,
+		ociLayoutDir:  os.Getenv(hcoutil.DictCatalogOCILayoutDirEnvV),
+		lastValid:     make(map[string][]hcov1beta1.DataImportCronTemplate),
+	}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r *ReconcileDictCatalog, catalogCache cache.Cache) error {
+	// Create a new controller
+	c, err := controller.New("dict-catalog-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// all the catalog sources are read together, to merge them in a consistent order
+	toCatalogReq := func(_ context.Context, _ client.Object) []reconcile.Request {
+		return []reconcile.Request{catalogReq}
+	}
+
+	// Watch for changes to the catalog ConfigMaps
+	err = c.Watch(
+		source.Kind(
+			catalogCache, client.Object(&corev1.ConfigMap{}),
+			handler.EnqueueRequestsFromMapFunc(toCatalogReq),
+		))
+	if err != nil {
+		return err
+	}
+
+	if r.ociLayoutDir == "" {
+		return nil
+	}
+
+	// Watch for changes in the OCI image layout directory
+	layoutEvents := make(chan event.GenericEvent, 1)
+	if err = mgr.Add(&layoutWatcher{dir: r.ociLayoutDir, events: layoutEvents}); err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Channel(
+			layoutEvents,
+			handler.EnqueueRequestsFromMapFunc(toCatalogReq),
+		))
+}
+
+// ReconcileDictCatalog reads the external DataImportCronTemplate catalogs, and merges them with the built-in list
+type ReconcileDictCatalog struct {
+	reader        client.Reader
+	eventEmitter  hcoutil.EventEmitter
+	catalogEvents chan<- event.GenericEvent
+	ociLayoutDir  string
+
+	// lastValid holds the last valid templates of each source, to keep using them if the source becomes invalid
+	lastValid map[string][]hcov1beta1.DataImportCronTemplate
+}
+
+// sourceResult is the content of a single catalog source
+type sourceResult struct {
+	name string
+	// object is the Kubernetes object of the source, if any; used to report errors
+	object    client.Object
+	templates []hcov1beta1.DataImportCronTemplate
+	err       error
+}
+
+// Reconcile reads all the catalog sources, validates them, and updates the list of the common DataImportCronTemplates.
+// If the list was changed, it triggers the HyperConverged controller, to update the SSP CR.
+func (r *ReconcileDictCatalog) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	logger, err := logr.FromContext(ctx)
+	if err != nil {
+		logger = log
+	}
+	logger.Info("Reconciling the DataImportCronTemplate catalogs")
+
+	results, err := r.readSources(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	lastValid := make(map[string][]hcov1beta1.DataImportCronTemplate, len(results))
+	sources := make([]goldenimages.CatalogSource, 0, len(results))
+
+	for _, res := range results {
+		templates, errs := r.validateSource(res)
+		if len(errs) > 0 {
+			msg := fmt.Sprintf("invalid DataImportCronTemplate catalog %s; %v", res.name, errors.Join(errs...))
+			logger.Error(nil, msg)
+			r.eventEmitter.EmitEvent(res.object, corev1.EventTypeWarning, invalidCatalogReason, msg)
+		}
+
+		if templates == nil {
+			continue
+		}
+
+		lastValid[res.name] = templates
+		sources = append(sources, goldenimages.CatalogSource{Name: res.name, Templates: templates})
+	}
+	r.lastValid = lastValid
+
+	changed, err := goldenimages.SetExternalCatalogs(sources)
+	if err != nil {
+		logger.Error(err, "conflicting DataImportCronTemplate catalogs")
+		r.eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, catalogConflictReason, err.Error())
+	}
+
+	if changed {
+		logger.Info("the DataImportCronTemplate catalogs were changed")
+		r.catalogEvents <- event.GenericEvent{}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// validateSource returns the valid templates of the source. If the source can't be read, the last valid templates of
+// the source are returned.
+func (r *ReconcileDictCatalog) validateSource(res sourceResult) ([]hcov1beta1.DataImportCronTemplate, []error) {
+	if res.err != nil {
+		return r.lastValid[res.name], []error{res.err}
+	}
+
+	return goldenimages.ValidateCatalogTemplates(res.templates)
+}
+
+// readSources reads the catalog sources, by their order of precedence: the images in the OCI image layout directory,
+// and then the catalog ConfigMaps, sorted by name.
+func (r *ReconcileDictCatalog) readSources(ctx context.Context) ([]sourceResult, error) {
+	var results []sourceResult
+
+	if r.ociLayoutDir != "" {
+		ociResults, err := readOCILayout(r.ociLayoutDir)
+		if err != nil {
+			// report the error on the whole directory as the error of a single source, to keep its last valid content
+			ociResults = r.getLastOCISources(err)
+		}
+
+		slices.SortFunc(ociResults, func(a, b sourceResult) int { return strings.Compare(a.name, b.name) })
+		results = append(results, ociResults...)
+	}
+
+	cmList := &corev1.ConfigMapList{}
+	if err := r.reader.List(ctx, cmList, client.InNamespace(catalogReq.Namespace), client.MatchingLabels{CatalogLabel: "true"}); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(cmList.Items, func(a, b corev1.ConfigMap) int { return strings.Compare(a.Name, b.Name) })
+	for i := range cmList.Items {
+		results = append(results, readConfigMap(&cmList.Items[i]))
+	}
+
+	return results, nil
+}
+
+// getLastOCISources returns a failed result for each OCI source that was valid before, so their last valid content
+// is kept, or a single failed result, if there was no such source.
+func (r *ReconcileDictCatalog) getLastOCISources(err error) []sourceResult {
+	var results []sourceResult
+	for name := range r.lastValid {
+		if strings.HasPrefix(name, goldenimages.OCICatalogSourcePrefix) {
+			results = append(results, sourceResult{name: name, err: err})
+		}
+	}
+
+	if len(results) == 0 {
+		results = append(results, sourceResult{name: goldenimages.OCICatalogSourcePrefix + r.ociLayoutDir, err: err})
+	}
+
+	return results
+}
+
+func readConfigMap(cm *corev1.ConfigMap) sourceResult {
+	res := sourceResult{
+		name:   goldenimages.ConfigMapCatalogSourcePrefix + cm.Name,
+		object: cm,
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(cm.Data)) {
+		var templates []hcov1beta1.DataImportCronTemplate
+		if err := hcoutil.UnmarshalYamlFileToObject(bytes.NewBufferString(cm.Data[key]), &templates); err != nil {
+			res.templates = nil
+			res.err = fmt.Errorf("can't parse the %s entry; %w", key, err)
+			return res
+		}
+
+		res.templates = append(res.templates, templates...)
+	}
+
+	return res
+}
@@ -0,0 +1,200 @@
+package dictcatalog
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+)
+
+var _ = Describe("DataImportCronTemplate catalog controller", func() {
+	var (
+		catalogEvents chan event.GenericEvent
+		eventEmitter  *commontestutils.EventEmitterMock
+	)
+
+	BeforeEach(func() {
+		catalogEvents = make(chan event.GenericEvent, 1)
+		eventEmitter = commontestutils.NewEventEmitterMock()
+
+		DeferCleanup(func() {
+			close(catalogEvents)
+			_, _ = goldenimages.SetExternalCatalogs(nil)
+		})
+	})
+
+	newTestReconciler := func(objects ...client.Object) *ReconcileDictCatalog {
+		return newReconciler(commontestutils.InitClient(objects), eventEmitter, catalogEvents)
+	}
+
+	It("should do nothing if there are no catalogs", func() {
+		r := newTestReconciler()
+
+		res, err := r.Reconcile(context.Background(), catalogReq)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.IsZero()).To(BeTrue())
+
+		Expect(catalogEvents).ToNot(Receive())
+		Expect(eventEmitter.CheckNoEventEmitted()).To(BeTrue())
+	})
+
+	It("should read the catalog ConfigMaps, and trigger the HyperConverged controller only on a change", func() {
+		cm := newCatalogConfigMap("catalog", catalogYAML)
+		r := newTestReconciler(cm, newConfigMap("not-a-catalog", catalogYAML))
+
+		_, err := r.Reconcile(context.Background(), catalogReq)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(catalogEvents).To(Receive())
+		Expect(eventEmitter.CheckNoEventEmitted()).To(BeTrue())
+		Expect(r.lastValid).To(HaveLen(1))
+		Expect(r.lastValid).To(HaveKeyWithValue("configmap/catalog", HaveLen(1)))
+
+		_, err = r.Reconcile(context.Background(), catalogReq)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(catalogEvents).ToNot(Receive())
+	})
+
+	It("should emit an event for an invalid template, and use the valid ones", func() {
+		cm := newCatalogConfigMap("catalog", catalogYAML+`- metadata:
+    name: no-spec
+`)
+		r := newTestReconciler(cm)
+
+		_, err := r.Reconcile(context.Background(), catalogReq)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(catalogEvents).To(Receive())
+		Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{{
+			EventType: corev1.EventTypeWarning,
+			Reason:    invalidCatalogReason,
+			Msg:       `invalid DataImportCronTemplate catalog configmap/catalog; DataImportCronTemplate #1 ("no-spec"): missing spec`,
+		}})).To(BeTrue())
+		Expect(r.lastValid["configmap/catalog"]).To(HaveLen(1))
+	})
+
+	It("should keep the last valid templates, if the catalog can't be parsed", func() {
+		cm := newCatalogConfigMap("catalog", catalogYAML)
+		cl := commontestutils.InitClient([]client.Object{cm})
+		r := newReconciler(cl, eventEmitter, catalogEvents)
+
+		_, err := r.Reconcile(context.Background(), catalogReq)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(catalogEvents).To(Receive())
+
+		cm.Data["templates.yaml"] = "not a list"
+		Expect(cl.Update(context.Background(), cm)).To(Succeed())
+
+		_, err = r.Reconcile(context.Background(), catalogReq)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(catalogEvents).ToNot(Receive())
+		Expect(eventEmitter.CheckNoEventEmitted()).To(BeFalse())
+		Expect(r.lastValid["configmap/catalog"]).To(HaveLen(1))
+
+		Expect(cl.Delete(context.Background(), cm)).To(Succeed())
+
+		_, err = r.Reconcile(context.Background(), catalogReq)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(catalogEvents).To(Receive())
+		Expect(r.lastValid).To(BeEmpty())
+	})
+
+	It("should emit an event if the same template is defined in two catalogs", func() {
+		r := newTestReconciler(
+			newCatalogConfigMap("catalog-b", catalogYAML),
+			newCatalogConfigMap("catalog-a", catalogYAML),
+		)
+
+		_, err := r.Reconcile(context.Background(), catalogReq)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(catalogEvents).To(Receive())
+		Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{{
+			EventType: corev1.EventTypeWarning,
+			Reason:    catalogConflictReason,
+			Msg:       "configmap/catalog-b: the centos-stream10-image-cron DataImportCronTemplate is already defined in configmap/catalog-a",
+		}})).To(BeTrue())
+	})
+
+	Context("with an OCI image layout directory", func() {
+		It("should give the OCI images precedence over the catalog ConfigMaps", func() {
+			r := newTestReconciler(newCatalogConfigMap("catalog", catalogYAML))
+			r.ociLayoutDir = GinkgoT().TempDir()
+			writeOCILayout(r.ociLayoutDir, map[string][]byte{"catalog:v1": []byte(catalogYAML)})
+
+			_, err := r.Reconcile(context.Background(), catalogReq)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(catalogEvents).To(Receive())
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{{
+				EventType: corev1.EventTypeWarning,
+				Reason:    catalogConflictReason,
+				Msg:       "configmap/catalog: the centos-stream10-image-cron DataImportCronTemplate is already defined in oci/catalog:v1",
+			}})).To(BeTrue())
+		})
+
+		It("should keep the last valid OCI templates, if the layout can't be read", func() {
+			r := newTestReconciler()
+			r.ociLayoutDir = GinkgoT().TempDir()
+			writeOCILayout(r.ociLayoutDir, map[string][]byte{"catalog:v1": []byte(catalogYAML)})
+
+			_, err := r.Reconcile(context.Background(), catalogReq)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(catalogEvents).To(Receive())
+
+			r.ociLayoutDir = GinkgoT().TempDir()
+
+			_, err = r.Reconcile(context.Background(), catalogReq)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(catalogEvents).ToNot(Receive())
+			Expect(r.lastValid).To(HaveKeyWithValue("oci/catalog:v1", HaveLen(1)))
+		})
+
+		It("should report an unreadable layout directory", func() {
+			r := newTestReconciler()
+			r.ociLayoutDir = GinkgoT().TempDir()
+
+			_, err := r.Reconcile(context.Background(), catalogReq)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(catalogEvents).ToNot(Receive())
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{{
+				EventType: corev1.EventTypeWarning,
+				Reason:    invalidCatalogReason,
+				Msg: fmt.Sprintf("invalid DataImportCronTemplate catalog oci/%s; can't read the OCI image layout file; open %s: no such file or directory",
+					r.ociLayoutDir, filepath.Join(r.ociLayoutDir, "oci-layout")),
+			}})).To(BeTrue())
+			Expect(r.lastValid).To(BeEmpty())
+		})
+	})
+})
+
+func newConfigMap(name, content string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: commontestutils.Namespace,
+		},
+		Data: map[string]string{
+			"templates.yaml": content,
+		},
+	}
+}
+
+func newCatalogConfigMap(name, content string) *corev1.ConfigMap {
+	cm := newConfigMap(name, content)
+	cm.Labels = map[string]string{CatalogLabel: "true"}
+	return cm
+}
@@ -0,0 +1,28 @@
+package dictcatalog
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+func TestDictCatalog(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DataImportCronTemplate Catalog Controller Suite")
+}
+
+var origOperatorNamespaceEnv = os.Getenv(hcoutil.OperatorNamespaceEnv)
+
+var _ = BeforeSuite(func() {
+	Expect(os.Setenv(hcoutil.OperatorNamespaceEnv, commontestutils.Namespace)).To(Succeed())
+	catalogReq.Namespace = commontestutils.Namespace
+})
+
+var _ = AfterSuite(func() {
+	Expect(os.Setenv(hcoutil.OperatorNamespaceEnv, origOperatorNamespaceEnv)).To(Succeed())
+})
@@ -0,0 +1,126 @@
+package dictcatalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// CatalogLayerMediaType is the media type of an OCI image layer, that contains a YAML list of DataImportCronTemplates
+const CatalogLayerMediaType = "application/vnd.kubevirt.hco.dataimportcrontemplates.v1+yaml"
+
+// readOCILayout reads the DataImportCronTemplates from an OCI image layout directory. Each image in the layout index
+// is a separate catalog source. An error in an image is reported in its result, and does not affect the other images.
+func readOCILayout(dir string) ([]sourceResult, error) {
+	layoutBytes, err := os.ReadFile(filepath.Join(dir, ocispec.ImageLayoutFile))
+	if err != nil {
+		return nil, fmt.Errorf("can't read the OCI image layout file; %w", err)
+	}
+
+	layout := ocispec.ImageLayout{}
+	if err = json.Unmarshal(layoutBytes, &layout); err != nil {
+		return nil, fmt.Errorf("can't parse the OCI image layout file; %w", err)
+	}
+
+	if layout.Version != ocispec.ImageLayoutVersion {
+		return nil, fmt.Errorf("unsupported OCI image layout version %q", layout.Version)
+	}
+
+	indexBytes, err := os.ReadFile(filepath.Join(dir, ocispec.ImageIndexFile))
+	if err != nil {
+		return nil, fmt.Errorf("can't read the OCI image index; %w", err)
+	}
+
+	index := ocispec.Index{}
+	if err = json.Unmarshal(indexBytes, &index); err != nil {
+		return nil, fmt.Errorf("can't parse the OCI image index; %w", err)
+	}
+
+	results := make([]sourceResult, 0, len(index.Manifests))
+	for _, desc := range index.Manifests {
+		name := desc.Annotations[ocispec.AnnotationRefName]
+		if name == "" {
+			name = desc.Digest.String()
+		}
+
+		templates, err := readOCIImage(dir, desc)
+		results = append(results, sourceResult{
+			name:      goldenimages.OCICatalogSourcePrefix + name,
+			templates: templates,
+			err:       err,
+		})
+	}
+
+	return results, nil
+}
+
+func readOCIImage(dir string, desc ocispec.Descriptor) ([]hcov1beta1.DataImportCronTemplate, error) {
+	if desc.MediaType != ocispec.MediaTypeImageManifest {
+		return nil, fmt.Errorf("unsupported media type %q; only image manifests are supported", desc.MediaType)
+	}
+
+	manifestBytes, err := readBlob(dir, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := ocispec.Manifest{}
+	if err = json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("can't parse the image manifest; %w", err)
+	}
+
+	var templates []hcov1beta1.DataImportCronTemplate
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != CatalogLayerMediaType {
+			continue
+		}
+
+		layerBytes, err := readBlob(dir, layer)
+		if err != nil {
+			return nil, err
+		}
+
+		var layerTemplates []hcov1beta1.DataImportCronTemplate
+		if err = hcoutil.UnmarshalYamlFileToObject(bytes.NewReader(layerBytes), &layerTemplates); err != nil {
+			return nil, fmt.Errorf("can't parse the %s layer; %w", layer.Digest, err)
+		}
+
+		templates = append(templates, layerTemplates...)
+	}
+
+	if templates == nil {
+		return nil, fmt.Errorf("the image has no layer of the %s media type", CatalogLayerMediaType)
+	}
+
+	return templates, nil
+}
+
+// readBlob reads a blob from the OCI image layout directory, and verifies its size and digest
+func readBlob(dir string, desc ocispec.Descriptor) ([]byte, error) {
+	if err := desc.Digest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid digest %q; %w", desc.Digest, err)
+	}
+
+	blob, err := os.ReadFile(filepath.Join(dir, ocispec.ImageBlobsDir, desc.Digest.Algorithm().String(), desc.Digest.Encoded()))
+	if err != nil {
+		return nil, fmt.Errorf("can't read the %s blob; %w", desc.Digest, err)
+	}
+
+	if int64(len(blob)) != desc.Size {
+		return nil, fmt.Errorf("the size of the %s blob is %d; expected %d", desc.Digest, len(blob), desc.Size)
+	}
+
+	if desc.Digest.Algorithm().FromBytes(blob) != desc.Digest {
+		return nil, fmt.Errorf("digest mismatch for the %s blob", desc.Digest)
+	}
+
+	return blob, nil
+}
@@ -0,0 +1,149 @@
+package dictcatalog
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const catalogYAML = `- metadata:
+    name: centos-stream10-image-cron
+  spec:
+    schedule: "0 */12 * * *"
+    template:
+      spec:
+        source:
+          registry:
+            url: docker://quay.io/containerdisks/centos-stream:10
+        storage:
+          resources:
+            requests:
+              storage: 30Gi
+    garbageCollect: Outdated
+    managedDataSource: centos-stream10
+`
+
+var _ = Describe("OCI image layout", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	It("should read the templates of each image in the layout", func() {
+		writeOCILayout(dir, map[string][]byte{"catalog:v1": []byte(catalogYAML)})
+
+		results, err := readOCILayout(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].name).To(Equal("oci/catalog:v1"))
+		Expect(results[0].err).ToNot(HaveOccurred())
+		Expect(results[0].templates).To(HaveLen(1))
+		Expect(results[0].templates[0].Name).To(Equal("centos-stream10-image-cron"))
+		Expect(results[0].templates[0].Spec.ManagedDataSource).To(Equal("centos-stream10"))
+	})
+
+	It("should fail if the layout file is missing", func() {
+		_, err := readOCILayout(dir)
+		Expect(err).To(MatchError(ContainSubstring("can't read the OCI image layout file")))
+	})
+
+	It("should fail for an unsupported layout version", func() {
+		Expect(os.WriteFile(filepath.Join(dir, ocispec.ImageLayoutFile), []byte(`{"imageLayoutVersion":"2.0.0"}`), 0o644)).To(Succeed())
+
+		_, err := readOCILayout(dir)
+		Expect(err).To(MatchError(ContainSubstring(`unsupported OCI image layout version "2.0.0"`)))
+	})
+
+	It("should report a modified blob only in the result of its image", func() {
+		writeOCILayout(dir, map[string][]byte{
+			"catalog:v1": []byte(catalogYAML),
+			"catalog:v2": []byte(catalogYAML + "# v2\n"),
+		})
+
+		layerDigest := digest.FromBytes([]byte(catalogYAML + "# v2\n"))
+		Expect(os.WriteFile(filepath.Join(dir, ocispec.ImageBlobsDir, "sha256", layerDigest.Encoded()), []byte(catalogYAML+"# v3\n"), 0o644)).To(Succeed())
+
+		results, err := readOCILayout(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(2))
+
+		for _, res := range results {
+			if res.name == "oci/catalog:v1" {
+				Expect(res.err).ToNot(HaveOccurred())
+				Expect(res.templates).To(HaveLen(1))
+			} else {
+				Expect(res.name).To(Equal("oci/catalog:v2"))
+				Expect(res.err).To(MatchError(ContainSubstring("digest mismatch")))
+				Expect(res.templates).To(BeEmpty())
+			}
+		}
+	})
+
+	It("should report an image without a catalog layer", func() {
+		writeOCILayout(dir, map[string][]byte{"catalog:v1": nil})
+
+		results, err := readOCILayout(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].err).To(MatchError(ContainSubstring("the image has no layer of the " + CatalogLayerMediaType + " media type")))
+	})
+})
+
+// writeOCILayout writes an OCI image layout with an image for each ref name. The image has a catalog layer with the
+// content, unless the content is nil.
+func writeOCILayout(dir string, images map[string][]byte) {
+	GinkgoHelper()
+
+	Expect(os.MkdirAll(filepath.Join(dir, ocispec.ImageBlobsDir, "sha256"), 0o755)).To(Succeed())
+
+	layout, err := json.Marshal(ocispec.ImageLayout{Version: ocispec.ImageLayoutVersion})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(os.WriteFile(filepath.Join(dir, ocispec.ImageLayoutFile), layout, 0o644)).To(Succeed())
+
+	index := ocispec.Index{MediaType: ocispec.MediaTypeImageIndex}
+	index.SchemaVersion = 2
+
+	for refName, content := range images {
+		manifest := ocispec.Manifest{
+			MediaType: ocispec.MediaTypeImageManifest,
+			Config:    writeBlob(dir, ocispec.MediaTypeEmptyJSON, []byte("{}")),
+		}
+		manifest.SchemaVersion = 2
+
+		if content != nil {
+			manifest.Layers = append(manifest.Layers, writeBlob(dir, CatalogLayerMediaType, content))
+		} else {
+			manifest.Layers = append(manifest.Layers, writeBlob(dir, ocispec.MediaTypeImageLayer, []byte("not a catalog")))
+		}
+
+		manifestBytes, err := json.Marshal(manifest)
+		Expect(err).ToNot(HaveOccurred())
+
+		desc := writeBlob(dir, ocispec.MediaTypeImageManifest, manifestBytes)
+		desc.Annotations = map[string]string{ocispec.AnnotationRefName: refName}
+		index.Manifests = append(index.Manifests, desc)
+	}
+
+	indexBytes, err := json.Marshal(index)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(os.WriteFile(filepath.Join(dir, ocispec.ImageIndexFile), indexBytes, 0o644)).To(Succeed())
+}
+
+func writeBlob(dir, mediaType string, content []byte) ocispec.Descriptor {
+	GinkgoHelper()
+
+	dgst := digest.FromBytes(content)
+	Expect(os.WriteFile(filepath.Join(dir, ocispec.ImageBlobsDir, "sha256", dgst.Encoded()), content, 0o644)).To(Succeed())
+
+	return ocispec.Descriptor{
+		MediaType: mediaType,
+		Digest:    dgst,
+		Size:      int64(len(content)),
+	}
+}
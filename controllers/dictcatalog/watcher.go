@@ -0,0 +1,62 @@
+package dictcatalog
+
+import (
+	"context"
+
+	"github.com/fsnotify/fsnotify"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// layoutWatcher is a manager runnable, that triggers the catalog reconciliation when the OCI image layout directory
+// is modified
+type layoutWatcher struct {
+	dir    string
+	events chan<- event.GenericEvent
+}
+
+func (w *layoutWatcher) Start(ctx context.Context) error {
+	logger := log.WithValues("directory", w.dir)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	// read the layout for the first time, even if it can't be watched, to report the error, if any
+	w.notify()
+
+	if err = watcher.Add(w.dir); err != nil {
+		logger.Error(err, "can't watch the DataImportCronTemplate catalog directory; changes in the directory will not be detected")
+		return nil
+	}
+
+	logger.Info("watching the DataImportCronTemplate catalog directory")
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case ev, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			logger.V(1).Info("the DataImportCronTemplate catalog directory was modified", "file", ev.Name, "operation", ev.Op.String())
+			w.notify()
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logger.Error(err, "error while watching the DataImportCronTemplate catalog directory")
+		}
+	}
+}
+
+// notify triggers the reconciliation, unless it is already pending
+func (w *layoutWatcher) notify() {
+	select {
+	case w.events <- event.GenericEvent{}:
+	default:
+	}
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"errors"
 	"reflect"
+	"slices"
 	"sync"
 
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
@@ -16,6 +17,7 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/reformatobj"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/registrymirror"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -137,12 +139,7 @@ func NewCDI(hc *hcov1beta1.HyperConverged, opts ...string) (*cdiv1beta1.CDI, err
 		spec.Config.FilesystemOverhead = hc.Spec.FilesystemOverhead.DeepCopy()
 	}
 
-	if hc.Spec.StorageImport != nil {
-		if length := len(hc.Spec.StorageImport.InsecureRegistries); length > 0 {
-			spec.Config.InsecureRegistries = make([]string, length)
-			copy(spec.Config.InsecureRegistries, hc.Spec.StorageImport.InsecureRegistries)
-		}
-	}
+	spec.Config.InsecureRegistries = getInsecureRegistries(hc)
 
 	if hc.Spec.Infra.NodePlacement != nil {
 		hc.Spec.Infra.NodePlacement.DeepCopyInto(&spec.Infra.NodePlacement)
@@ -196,3 +193,29 @@ func openshift2CdiSecProfile(hcProfile *openshiftconfigv1.TLSSecurityProfile) *c
 		Custom:       custom,
 	}
 }
+
+// getInsecureRegistries returns the storageImport.insecureRegistries list, with the host of each golden images
+// registry mirror that is insecure, or that mirrors an insecure registry, because the golden images are pulled from
+// the mirror instead of the original registry
+func getInsecureRegistries(hc *hcov1beta1.HyperConverged) []string {
+	var insecureRegistries []string
+	if hc.Spec.StorageImport != nil {
+		insecureRegistries = slices.Clone(hc.Spec.StorageImport.InsecureRegistries)
+	}
+
+	for _, mirror := range hc.Spec.BootImageRegistryMirrors {
+		if !mirror.Insecure && (hc.Spec.StorageImport == nil || !slices.Contains(hc.Spec.StorageImport.InsecureRegistries, registrymirror.Host(mirror.Source))) {
+			continue
+		}
+
+		if host := registrymirror.Host(mirror.Mirror); !slices.Contains(insecureRegistries, host) {
+			insecureRegistries = append(insecureRegistries, host)
+		}
+	}
+
+	if len(insecureRegistries) == 0 {
+		return nil
+	}
+
+	return insecureRegistries
+}
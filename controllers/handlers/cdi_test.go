@@ -770,6 +770,48 @@ var _ = Describe("CDI Operand", func() {
 				Expect(foundCDI.Spec.Config.InsecureRegistries).To(HaveLen(2))
 				Expect(foundCDI.Spec.Config.InsecureRegistries).To(ContainElements("other1:5000", "other2:5000"))
 			})
+			It("should add the mirrors of the insecure registries", func() {
+				hco.Spec.StorageImport = &hcov1beta1.StorageImportConfig{
+					InsecureRegistries: []string{"first:5000", "second:5000", "mirror:5000"},
+				}
+				hco.Spec.BootImageRegistryMirrors = []hcov1beta1.RegistryMirror{
+					{Source: "first:5000", Mirror: "mirror:5000"},
+					{Source: "second:5000", Mirror: "mirror2:5000"},
+					{Source: "third:5000", Mirror: "mirror3:5000"},
+				}
+
+				cdi, err := NewCDI(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(cdi.Spec.Config.InsecureRegistries).To(Equal([]string{"first:5000", "second:5000", "mirror:5000", "mirror2:5000"}))
+				Expect(hco.Spec.StorageImport.InsecureRegistries).To(HaveLen(3))
+			})
+			It("should add only the host of a repository mirror", func() {
+				hco.Spec.StorageImport = &hcov1beta1.StorageImportConfig{
+					InsecureRegistries: []string{"registry.example.com:5000"},
+				}
+				hco.Spec.BootImageRegistryMirrors = []hcov1beta1.RegistryMirror{
+					{Source: "registry.example.com:5000/containerdisks", Mirror: "mirror.example.com:5000/containerdisks"},
+					{Source: "registry.example.com:5000/other", Mirror: "mirror.example.com:5000/other"},
+				}
+
+				cdi, err := NewCDI(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(cdi.Spec.Config.InsecureRegistries).To(Equal([]string{"registry.example.com:5000", "mirror.example.com:5000"}))
+			})
+			It("should add the insecure mirrors, even if there are no insecure registries", func() {
+				hco.Spec.StorageImport = nil
+				hco.Spec.BootImageRegistryMirrors = []hcov1beta1.RegistryMirror{
+					{Source: "quay.io/containerdisks", Mirror: "mirror.example.com:5000/containerdisks", Insecure: true},
+					{Source: "registry.example.com", Mirror: "secure-mirror.example.com"},
+				}
+
+				cdi, err := NewCDI(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(cdi.Spec.Config.InsecureRegistries).To(Equal([]string{"mirror.example.com:5000"}))
+			})
 		})
 
 		Context("Test UninstallStrategy", func() {
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// The KubeVirt defaults, for fields that are not set in the HyperConverged CR
+const (
+	kvDefaultCPUAllocationRatio                = 10
+	kvDefaultMemoryOvercommitPercentage        = 100
+	kvDefaultParallelMigrationsPerCluster      = 5
+	kvDefaultParallelOutboundMigrationsPerNode = 2
+)
+
+// UpdateEffectiveConfigMetrics refreshes the metrics of the effective operand configuration, as derived from the
+// HyperConverged CR. It should be called after the operands were successfully reconciled.
+func UpdateEffectiveConfigMetrics(hc *hcov1beta1.HyperConverged) {
+	metrics.SetEffectiveConfig(getEffectiveConfig(hc))
+}
+
+func getEffectiveConfig(hc *hcov1beta1.HyperConverged) metrics.EffectiveConfig {
+	cfg := metrics.EffectiveConfig{
+		KubeVirtFeatureGates:              getKvFeatureGateList(&hc.Spec.FeatureGates, hc.Annotations),
+		VMICPUAllocationRatio:             kvDefaultCPUAllocationRatio,
+		MemoryOvercommitPercentage:        kvDefaultMemoryOvercommitPercentage,
+		ParallelMigrationsPerCluster:      kvDefaultParallelMigrationsPerCluster,
+		ParallelOutboundMigrationsPerNode: kvDefaultParallelOutboundMigrationsPerNode,
+	}
+
+	if hc.Spec.ResourceRequirements != nil && hc.Spec.ResourceRequirements.VmiCPUAllocationRatio != nil {
+		cfg.VMICPUAllocationRatio = *hc.Spec.ResourceRequirements.VmiCPUAllocationRatio
+	}
+
+	if hc.Spec.HigherWorkloadDensity != nil && hc.Spec.HigherWorkloadDensity.MemoryOvercommitPercentage != 0 {
+		cfg.MemoryOvercommitPercentage = hc.Spec.HigherWorkloadDensity.MemoryOvercommitPercentage
+	}
+
+	if lm := hc.Spec.LiveMigrationConfig; lm.ParallelMigrationsPerCluster != nil {
+		cfg.ParallelMigrationsPerCluster = *lm.ParallelMigrationsPerCluster
+	}
+
+	if lm := hc.Spec.LiveMigrationConfig; lm.ParallelOutboundMigrationsPerNode != nil {
+		cfg.ParallelOutboundMigrationsPerNode = *lm.ParallelOutboundMigrationsPerNode
+	}
+
+	profile := hcoutil.GetClusterInfo().GetTLSSecurityProfile(hc.Spec.TLSSecurityProfile)
+	cfg.TLSProfileType = string(profile.Type)
+	cfg.TLSMinVersion = string(getTLSMinVersion(profile))
+
+	return cfg
+}
+
+func getTLSMinVersion(profile *openshiftconfigv1.TLSSecurityProfile) openshiftconfigv1.TLSProtocolVersion {
+	if profile.Custom != nil {
+		return profile.Custom.MinTLSVersion
+	}
+
+	if spec, found := openshiftconfigv1.TLSProfiles[profile.Type]; found {
+		return spec.MinTLSVersion
+	}
+
+	return openshiftconfigv1.TLSProfiles[openshiftconfigv1.TLSProfileIntermediateType].MinTLSVersion
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Effective configuration metrics", func() {
+	var hco *hcov1beta1.HyperConverged
+
+	BeforeEach(func() {
+		getClusterInfo := hcoutil.GetClusterInfo
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo { return &commontestutils.ClusterInfoMock{} }
+		DeferCleanup(func() {
+			hcoutil.GetClusterInfo = getClusterInfo
+		})
+
+		hco = commontestutils.NewHco()
+	})
+
+	It("should report the configuration that is set in the KubeVirt CR", func() {
+		hco.Spec.FeatureGates.DownwardMetrics = ptr.To(true)
+		hco.Spec.ResourceRequirements = &hcov1beta1.OperandResourceRequirements{VmiCPUAllocationRatio: ptr.To(8)}
+		hco.Spec.HigherWorkloadDensity = &hcov1beta1.HigherWorkloadDensityConfiguration{MemoryOvercommitPercentage: 150}
+		hco.Spec.LiveMigrationConfig.ParallelMigrationsPerCluster = ptr.To[uint32](10)
+		hco.Spec.LiveMigrationConfig.ParallelOutboundMigrationsPerNode = ptr.To[uint32](3)
+
+		kv, err := NewKubeVirt(hco)
+		Expect(err).ToNot(HaveOccurred())
+
+		cfg := getEffectiveConfig(hco)
+		Expect(cfg.KubeVirtFeatureGates).To(Equal(kv.Spec.Configuration.DeveloperConfiguration.FeatureGates))
+		Expect(cfg.KubeVirtFeatureGates).To(ContainElement("DownwardMetrics"))
+		Expect(cfg.VMICPUAllocationRatio).To(Equal(kv.Spec.Configuration.DeveloperConfiguration.CPUAllocationRatio))
+		Expect(cfg.MemoryOvercommitPercentage).To(Equal(kv.Spec.Configuration.DeveloperConfiguration.MemoryOvercommit))
+		Expect(cfg.ParallelMigrationsPerCluster).To(Equal(*kv.Spec.Configuration.MigrationConfiguration.ParallelMigrationsPerCluster))
+		Expect(cfg.ParallelOutboundMigrationsPerNode).To(Equal(*kv.Spec.Configuration.MigrationConfiguration.ParallelOutboundMigrationsPerNode))
+		Expect(cfg.TLSProfileType).To(Equal("Intermediate"))
+		Expect(cfg.TLSMinVersion).To(Equal("VersionTLS12"))
+	})
+
+	It("should report the KubeVirt defaults for fields that are not set", func() {
+		hco.Spec.ResourceRequirements = nil
+		hco.Spec.HigherWorkloadDensity = nil
+		hco.Spec.LiveMigrationConfig = hcov1beta1.LiveMigrationConfigurations{}
+
+		cfg := getEffectiveConfig(hco)
+		Expect(cfg.VMICPUAllocationRatio).To(Equal(kvDefaultCPUAllocationRatio))
+		Expect(cfg.MemoryOvercommitPercentage).To(Equal(kvDefaultMemoryOvercommitPercentage))
+		Expect(cfg.ParallelMigrationsPerCluster).To(BeEquivalentTo(kvDefaultParallelMigrationsPerCluster))
+		Expect(cfg.ParallelOutboundMigrationsPerNode).To(BeEquivalentTo(kvDefaultParallelOutboundMigrationsPerNode))
+	})
+
+	It("should update the metrics", func() {
+		hco.Spec.FeatureGates.DownwardMetrics = ptr.To(true)
+		UpdateEffectiveConfigMetrics(hco)
+		Expect(metrics.IsKubeVirtFeatureGateEnabled("DownwardMetrics")).To(BeTrue())
+
+		hco.Spec.FeatureGates.DownwardMetrics = ptr.To(false)
+		UpdateEffectiveConfigMetrics(hco)
+		Expect(metrics.IsKubeVirtFeatureGateEnabled("DownwardMetrics")).To(BeFalse())
+		Expect(metrics.IsTLSSecurityProfileInUse("Intermediate", "VersionTLS12")).To(BeTrue())
+	})
+
+	DescribeTable("should find the minimal TLS version of the profile", func(profile *openshiftconfigv1.TLSSecurityProfile, expected openshiftconfigv1.TLSProtocolVersion) {
+		Expect(getTLSMinVersion(profile)).To(Equal(expected))
+	},
+		Entry("old", &openshiftconfigv1.TLSSecurityProfile{Type: openshiftconfigv1.TLSProfileOldType, Old: &openshiftconfigv1.OldTLSProfile{}}, openshiftconfigv1.VersionTLS10),
+		Entry("modern", &openshiftconfigv1.TLSSecurityProfile{Type: openshiftconfigv1.TLSProfileModernType, Modern: &openshiftconfigv1.ModernTLSProfile{}}, openshiftconfigv1.VersionTLS13),
+		Entry("custom", &openshiftconfigv1.TLSSecurityProfile{
+			Type: openshiftconfigv1.TLSProfileCustomType,
+			Custom: &openshiftconfigv1.CustomTLSProfile{
+				TLSProfileSpec: openshiftconfigv1.TLSProfileSpec{MinTLSVersion: openshiftconfigv1.VersionTLS11},
+			},
+		}, openshiftconfigv1.VersionTLS11),
+	)
+})
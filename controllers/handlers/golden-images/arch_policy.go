@@ -0,0 +1,82 @@
+package golden_images
+
+import (
+	"fmt"
+	"slices"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+// getArchitecturePolicy returns the architecture policy of a DataImportCronTemplate: the fields of its override, if
+// exists, and the fields of the common policy for the rest
+func getArchitecturePolicy(policy *hcov1beta1.BootImageArchitecturePolicy, dictName string) hcov1beta1.ArchitecturePolicy {
+	if policy == nil {
+		return hcov1beta1.ArchitecturePolicy{}
+	}
+
+	result := policy.ArchitecturePolicy
+
+	idx := slices.IndexFunc(policy.Templates, func(tp hcov1beta1.DataImportCronTemplateArchitecturePolicy) bool {
+		return tp.Name == dictName
+	})
+	if idx < 0 {
+		return result
+	}
+
+	override := policy.Templates[idx].ArchitecturePolicy
+	if len(override.Architectures) > 0 {
+		result.Architectures = override.Architectures
+	}
+
+	if override.PreferredArchitecture != "" {
+		result.PreferredArchitecture = override.PreferredArchitecture
+	}
+
+	if override.MinArchitectures != nil {
+		result.MinArchitectures = override.MinArchitectures
+	}
+
+	return result
+}
+
+// ValidateArchitecturePolicy checks that the architecture policy, and the effective policy of each template override,
+// are consistent
+func ValidateArchitecturePolicy(policy *hcov1beta1.BootImageArchitecturePolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	if err := validateArchitecturePolicy(policy.ArchitecturePolicy); err != nil {
+		return err
+	}
+
+	names := make(map[string]bool, len(policy.Templates))
+	for i, tp := range policy.Templates {
+		if names[tp.Name] {
+			return fmt.Errorf("templates[%d]: the %q DataImportCronTemplate is listed more than once", i, tp.Name)
+		}
+		names[tp.Name] = true
+
+		if err := validateArchitecturePolicy(getArchitecturePolicy(policy, tp.Name)); err != nil {
+			return fmt.Errorf("templates[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateArchitecturePolicy(policy hcov1beta1.ArchitecturePolicy) error {
+	if len(policy.Architectures) == 0 {
+		return nil
+	}
+
+	if policy.PreferredArchitecture != "" && !slices.Contains(policy.Architectures, policy.PreferredArchitecture) {
+		return fmt.Errorf("the preferred architecture %q is not in the architecture list", policy.PreferredArchitecture)
+	}
+
+	if policy.MinArchitectures != nil && int(*policy.MinArchitectures) > len(policy.Architectures) {
+		return fmt.Errorf("minArchitectures is %d, but only %d architectures are listed", *policy.MinArchitectures, len(policy.Architectures))
+	}
+
+	return nil
+}
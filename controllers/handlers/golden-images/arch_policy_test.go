@@ -0,0 +1,247 @@
+package golden_images
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
+)
+
+var _ = Describe("Test the boot image architecture policy", func() {
+	Context("getArchitecturePolicy", func() {
+		policy := &hcov1beta1.BootImageArchitecturePolicy{
+			ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+				Architectures:         []string{"amd64", "arm64"},
+				PreferredArchitecture: "amd64",
+				MinArchitectures:      ptr.To[int32](2),
+			},
+			Templates: []hcov1beta1.DataImportCronTemplateArchitecturePolicy{
+				{
+					Name: "image1",
+					ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+						PreferredArchitecture: "arm64",
+					},
+				},
+				{
+					Name: "image2",
+					ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+						Architectures:    []string{"s390x"},
+						MinArchitectures: ptr.To[int32](1),
+					},
+				},
+			},
+		}
+
+		It("should return an empty policy if there is no policy", func() {
+			Expect(getArchitecturePolicy(nil, "image1")).To(Equal(hcov1beta1.ArchitecturePolicy{}))
+		})
+
+		It("should return the common policy if the template has no override", func() {
+			Expect(getArchitecturePolicy(policy, "image3")).To(Equal(policy.ArchitecturePolicy))
+		})
+
+		It("should override only the fields that are set in the template override", func() {
+			Expect(getArchitecturePolicy(policy, "image1")).To(Equal(hcov1beta1.ArchitecturePolicy{
+				Architectures:         []string{"amd64", "arm64"},
+				PreferredArchitecture: "arm64",
+				MinArchitectures:      ptr.To[int32](2),
+			}))
+
+			Expect(getArchitecturePolicy(policy, "image2")).To(Equal(hcov1beta1.ArchitecturePolicy{
+				Architectures:         []string{"s390x"},
+				PreferredArchitecture: "amd64",
+				MinArchitectures:      ptr.To[int32](1),
+			}))
+		})
+	})
+
+	Context("ValidateArchitecturePolicy", func() {
+		DescribeTable("should validate the policy", func(policy *hcov1beta1.BootImageArchitecturePolicy, errMatcher string) {
+			err := ValidateArchitecturePolicy(policy)
+			if errMatcher == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(errMatcher)))
+			}
+		},
+			Entry("no policy", nil, ""),
+			Entry("empty policy", &hcov1beta1.BootImageArchitecturePolicy{}, ""),
+			Entry("valid policy", &hcov1beta1.BootImageArchitecturePolicy{
+				ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+					Architectures:         []string{"amd64", "arm64"},
+					PreferredArchitecture: "arm64",
+					MinArchitectures:      ptr.To[int32](2),
+				},
+			}, ""),
+			Entry("preferred architecture with no architecture list", &hcov1beta1.BootImageArchitecturePolicy{
+				ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+					PreferredArchitecture: "arm64",
+				},
+			}, ""),
+			Entry("preferred architecture is not in the list", &hcov1beta1.BootImageArchitecturePolicy{
+				ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+					Architectures:         []string{"amd64", "arm64"},
+					PreferredArchitecture: "s390x",
+				},
+			}, `the preferred architecture "s390x" is not in the architecture list`),
+			Entry("too many minArchitectures", &hcov1beta1.BootImageArchitecturePolicy{
+				ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+					Architectures:    []string{"amd64", "arm64"},
+					MinArchitectures: ptr.To[int32](3),
+				},
+			}, "minArchitectures is 3, but only 2 architectures are listed"),
+			Entry("template override is not consistent with the common policy", &hcov1beta1.BootImageArchitecturePolicy{
+				ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+					Architectures:         []string{"amd64", "arm64"},
+					PreferredArchitecture: "arm64",
+				},
+				Templates: []hcov1beta1.DataImportCronTemplateArchitecturePolicy{
+					{
+						Name: "image1",
+						ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+							Architectures: []string{"amd64", "s390x"},
+						},
+					},
+				},
+			}, `templates[0]: the preferred architecture "arm64" is not in the architecture list`),
+			Entry("template is listed twice", &hcov1beta1.BootImageArchitecturePolicy{
+				Templates: []hcov1beta1.DataImportCronTemplateArchitecturePolicy{
+					{Name: "image1"},
+					{Name: "image2"},
+					{Name: "image1"},
+				},
+			}, `templates[2]: the "image1" DataImportCronTemplate is listed more than once`),
+		)
+	})
+
+	Context("selectArchitectures", func() {
+		workloadsArchs := []string{"amd64", "arm64", "s390x"}
+
+		DescribeTable("should select the architectures by the policy", func(annotation string, policy hcov1beta1.ArchitecturePolicy, expected []string) {
+			Expect(selectArchitectures(annotation, workloadsArchs, policy)).To(Equal(expected))
+		},
+			Entry("no policy", "amd64,arm64,s390x,ppc64le", hcov1beta1.ArchitecturePolicy{}, []string{"amd64", "arm64", "s390x"}),
+			Entry("architecture list", "amd64,arm64,s390x", hcov1beta1.ArchitecturePolicy{
+				Architectures: []string{"arm64", "s390x", "ppc64le"},
+			}, []string{"arm64", "s390x"}),
+			Entry("preferred architecture", "amd64,arm64,s390x", hcov1beta1.ArchitecturePolicy{
+				PreferredArchitecture: "s390x",
+			}, []string{"s390x", "amd64", "arm64"}),
+			Entry("preferred architecture is not supported by the cluster", "amd64,arm64,s390x,ppc64le", hcov1beta1.ArchitecturePolicy{
+				PreferredArchitecture: "ppc64le",
+			}, []string{"amd64", "arm64", "s390x"}),
+			Entry("no matching architectures", "ppc64le", hcov1beta1.ArchitecturePolicy{}, nil),
+		)
+	})
+
+	Context("GetDataImportCronTemplates with an architecture policy", func() {
+		var (
+			hco              *hcov1beta1.HyperConverged
+			origHardCodedMap map[string]hcov1beta1.DataImportCronTemplate
+		)
+
+		BeforeEach(func() {
+			origHardCodedMap = dataImportCronTemplateHardCodedMap
+			origGetWorkloadsArchitectures := nodeinfo.GetWorkloadsArchitectures
+
+			image1, _ := makeDICT(1, true)
+			image1.Annotations = map[string]string{MultiArchDICTAnnotation: "amd64,arm64,s390x"}
+			image2, _ := makeDICT(2, true)
+			image2.Annotations = map[string]string{MultiArchDICTAnnotation: "amd64,arm64,s390x"}
+
+			dataImportCronTemplateHardCodedMap = map[string]hcov1beta1.DataImportCronTemplate{
+				image1.Name: image1,
+				image2.Name: image2,
+			}
+
+			nodeinfo.GetWorkloadsArchitectures = func() []string {
+				return []string{"amd64", "arm64", "s390x"}
+			}
+
+			DeferCleanup(func() {
+				dataImportCronTemplateHardCodedMap = origHardCodedMap
+				nodeinfo.GetWorkloadsArchitectures = origGetWorkloadsArchitectures
+			})
+
+			hco = commontestutils.NewHco()
+			hco.Spec.EnableCommonBootImageImport = ptr.To(true)
+			hco.Spec.FeatureGates.EnableMultiArchBootImageImport = ptr.To(true)
+		})
+
+		It("should select the architectures and the preferred architecture by the policy", func() {
+			hco.Spec.BootImageArchitecturePolicy = &hcov1beta1.BootImageArchitecturePolicy{
+				ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+					Architectures:         []string{"amd64", "arm64"},
+					PreferredArchitecture: "arm64",
+				},
+				Templates: []hcov1beta1.DataImportCronTemplateArchitecturePolicy{
+					{
+						Name: "image2",
+						ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+							Architectures:         []string{"s390x", "amd64"},
+							PreferredArchitecture: "s390x",
+						},
+					},
+				},
+			}
+
+			dictsStatuses, err := GetDataImportCronTemplates(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dictsStatuses).To(HaveLen(2))
+
+			Expect(dictsStatuses[0].Annotations).To(HaveKeyWithValue(MultiArchDICTAnnotation, "arm64,amd64"))
+			Expect(dictsStatuses[0].Status.OriginalSupportedArchitectures).To(Equal("amd64,arm64,s390x"))
+			Expect(dictsStatuses[0].Status.Conditions).To(BeEmpty())
+
+			Expect(dictsStatuses[1].Annotations).To(HaveKeyWithValue(MultiArchDICTAnnotation, "s390x,amd64"))
+			Expect(dictsStatuses[1].Status.OriginalSupportedArchitectures).To(Equal("amd64,arm64,s390x"))
+			Expect(dictsStatuses[1].Status.Conditions).To(BeEmpty())
+
+			sspDicts := HCODictSliceToSSP(hco, dictsStatuses)
+			Expect(sspDicts).To(HaveLen(2))
+			Expect(sspDicts[0].Annotations).To(HaveKeyWithValue(MultiArchDICTAnnotation, "arm64,amd64"))
+			Expect(sspDicts[1].Annotations).To(HaveKeyWithValue(MultiArchDICTAnnotation, "s390x,amd64"))
+		})
+
+		It("should not deploy a DICT with less than the minimum number of architectures", func() {
+			nodeinfo.GetWorkloadsArchitectures = func() []string {
+				return []string{"amd64", "arm64"}
+			}
+
+			hco.Spec.BootImageArchitecturePolicy = &hcov1beta1.BootImageArchitecturePolicy{
+				ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+					MinArchitectures: ptr.To[int32](2),
+				},
+				Templates: []hcov1beta1.DataImportCronTemplateArchitecturePolicy{
+					{
+						Name: "image2",
+						ArchitecturePolicy: hcov1beta1.ArchitecturePolicy{
+							Architectures: []string{"amd64", "s390x"},
+						},
+					},
+				},
+			}
+
+			dictsStatuses, err := GetDataImportCronTemplates(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dictsStatuses).To(HaveLen(2))
+
+			Expect(dictsStatuses[0].Annotations).To(HaveKeyWithValue(MultiArchDICTAnnotation, "amd64,arm64"))
+			Expect(dictsStatuses[0].Status.Conditions).To(BeEmpty())
+
+			Expect(dictsStatuses[1].Annotations).To(HaveKeyWithValue(MultiArchDICTAnnotation, "amd64"))
+			cond := meta.FindStatusCondition(dictsStatuses[1].Status.Conditions, DictConditionDeployedType)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Reason).To(Equal(dictConditionNotEnoughArchsReason))
+			Expect(cond.Message).To(Equal("DataImportCronTemplate has 1 selected architectures for the current cluster; at least 2 are required"))
+
+			sspDicts := HCODictSliceToSSP(hco, dictsStatuses)
+			Expect(sspDicts).To(HaveLen(1))
+			Expect(sspDicts[0].Name).To(Equal("image1"))
+		})
+	})
+})
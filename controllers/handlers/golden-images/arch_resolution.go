@@ -0,0 +1,161 @@
+package golden_images
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/archresolver"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/registrymirror"
+)
+
+const (
+	DictConditionArchsResolvedType = "ArchitecturesResolved"
+
+	dictConditionArchsResolvedReason     = "Resolved"
+	dictConditionArchsResolvedMessageFmt = "the %s annotation was set from the image manifest list"
+	dictConditionArchsPendingReason      = "ResolutionInProgress"
+	dictConditionArchsPendingMessage     = "reading the supported architectures from the image manifest list"
+	dictConditionArchsFailedReason       = "ResolutionFailed"
+	dictConditionArchsFailedMessageFmt   = "can't resolve the supported architectures; set the %s annotation manually; %v"
+)
+
+// ArchitectureResolver resolves the supported architectures of a golden image from its registry
+type ArchitectureResolver interface {
+	Resolve(image archresolver.Image) archresolver.Result
+}
+
+var (
+	architectureResolver     ArchitectureResolver
+	architectureResolverLock sync.RWMutex
+)
+
+// SetArchitectureResolver sets the resolver of the supported architectures of the user-supplied golden images. If
+// not set, the architectures are not resolved.
+func SetArchitectureResolver(resolver ArchitectureResolver) {
+	architectureResolverLock.Lock()
+	defer architectureResolverLock.Unlock()
+
+	architectureResolver = resolver
+}
+
+func getArchitectureResolver() ArchitectureResolver {
+	architectureResolverLock.RLock()
+	defer architectureResolverLock.RUnlock()
+
+	return architectureResolver
+}
+
+// resolveDictArchitectures sets the architecture annotation of a user-supplied DataImportCronTemplate, that has no
+// such annotation, from the manifest list of its image, and reports the resolution state in its status conditions.
+func resolveDictArchitectures(hc *hcov1beta1.HyperConverged, dictStatus *hcov1beta1.DataImportCronTemplateStatus) {
+	resolver := getArchitectureResolver()
+	if resolver == nil {
+		return
+	}
+
+	if dictStatus.Status.CommonTemplate && !dictStatus.Status.Modified {
+		return
+	}
+
+	if _, annotated := dictStatus.Annotations[MultiArchDICTAnnotation]; annotated {
+		return
+	}
+
+	source := getRegistrySource(dictStatus)
+	if source == nil {
+		return
+	}
+
+	origURL := *source.URL
+	url, _ := registrymirror.RewriteURL(origURL, hc.Spec.BootImageRegistryMirrors)
+
+	namespace := dictStatus.Namespace
+	if namespace == "" {
+		namespace = getGoldenImagesNamespace(hc)
+	}
+
+	res := resolver.Resolve(archresolver.Image{
+		URL:           url,
+		Insecure:      isInsecureRegistry(hc, origURL, url),
+		Namespace:     namespace,
+		SecretRef:     ptr.Deref(source.SecretRef, ""),
+		CertConfigMap: ptr.Deref(source.CertConfigMap, ""),
+	})
+
+	cond := metav1.Condition{Type: DictConditionArchsResolvedType}
+	switch res.State {
+	case archresolver.StateResolved:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = dictConditionArchsResolvedReason
+		cond.Message = fmt.Sprintf(dictConditionArchsResolvedMessageFmt, MultiArchDICTAnnotation)
+
+		if dictStatus.Annotations == nil {
+			dictStatus.Annotations = make(map[string]string)
+		}
+		dictStatus.Annotations[MultiArchDICTAnnotation] = strings.Join(res.Architectures, ",")
+	case archresolver.StateFailed:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = dictConditionArchsFailedReason
+		cond.Message = fmt.Sprintf(dictConditionArchsFailedMessageFmt, MultiArchDICTAnnotation, res.Err)
+	default:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = dictConditionArchsPendingReason
+		cond.Message = dictConditionArchsPendingMessage
+	}
+
+	// keep the transition time of an unchanged condition, to avoid updating the HyperConverged status on each
+	// reconciliation
+	if prev := findPrevDictCondition(hc, dictStatus.Name, DictConditionArchsResolvedType); prev != nil &&
+		prev.Status == cond.Status && prev.Reason == cond.Reason && prev.Message == cond.Message {
+		cond.LastTransitionTime = prev.LastTransitionTime
+	}
+
+	meta.SetStatusCondition(&dictStatus.Status.Conditions, cond)
+}
+
+func getRegistrySource(dictStatus *hcov1beta1.DataImportCronTemplateStatus) *cdiv1beta1.DataVolumeSourceRegistry {
+	spec := dictStatus.Spec
+	if spec == nil || spec.Template.Spec.Source == nil || spec.Template.Spec.Source.Registry == nil ||
+		ptr.Deref(spec.Template.Spec.Source.Registry.URL, "") == "" {
+		return nil
+	}
+
+	return spec.Template.Spec.Source.Registry
+}
+
+// isInsecureRegistry checks if the registry of the original URL, or of the mirrored URL, is in the CDI insecure
+// registry list
+func isInsecureRegistry(hc *hcov1beta1.HyperConverged, origURL, url string) bool {
+	if hc.Spec.StorageImport == nil {
+		return false
+	}
+
+	insecureRegistries := hc.Spec.StorageImport.InsecureRegistries
+	return slices.Contains(insecureRegistries, getRegistryHost(origURL)) ||
+		slices.Contains(insecureRegistries, getRegistryHost(url))
+}
+
+func getRegistryHost(url string) string {
+	_, ref, _ := strings.Cut(url, "://")
+	host, _, _ := strings.Cut(ref, "/")
+	return host
+}
+
+func findPrevDictCondition(hc *hcov1beta1.HyperConverged, dictName, condType string) *metav1.Condition {
+	for i := range hc.Status.DataImportCronTemplates {
+		if hc.Status.DataImportCronTemplates[i].Name == dictName {
+			return meta.FindStatusCondition(hc.Status.DataImportCronTemplates[i].Status.Conditions, condType)
+		}
+	}
+
+	return nil
+}
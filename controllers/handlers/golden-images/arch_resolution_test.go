@@ -0,0 +1,209 @@
+package golden_images
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/archresolver"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
+)
+
+type fakeArchResolver struct {
+	results map[string]archresolver.Result
+	calls   []archresolver.Image
+}
+
+func (r *fakeArchResolver) Resolve(image archresolver.Image) archresolver.Result {
+	r.calls = append(r.calls, image)
+	if res, found := r.results[image.URL]; found {
+		return res
+	}
+	return archresolver.Result{State: archresolver.StatePending}
+}
+
+var _ = Describe("Test the DataImportCronTemplate architecture resolution", func() {
+	var (
+		hco      *hcov1beta1.HyperConverged
+		resolver *fakeArchResolver
+	)
+
+	BeforeEach(func() {
+		origHardCodedMap := dataImportCronTemplateHardCodedMap
+		origGetWorkloadsArchitectures := nodeinfo.GetWorkloadsArchitectures
+
+		image1, _ := makeDICT(1, true)
+		image1.Annotations = map[string]string{MultiArchDICTAnnotation: "amd64,arm64"}
+		dataImportCronTemplateHardCodedMap = map[string]hcov1beta1.DataImportCronTemplate{
+			image1.Name: image1,
+		}
+
+		nodeinfo.GetWorkloadsArchitectures = func() []string {
+			return []string{"amd64", "arm64"}
+		}
+
+		resolver = &fakeArchResolver{results: map[string]archresolver.Result{}}
+		SetArchitectureResolver(resolver)
+
+		DeferCleanup(func() {
+			dataImportCronTemplateHardCodedMap = origHardCodedMap
+			nodeinfo.GetWorkloadsArchitectures = origGetWorkloadsArchitectures
+			SetArchitectureResolver(nil)
+		})
+
+		image2, _ := makeDICT(2, false)
+		hco = commontestutils.NewHco()
+		hco.Spec.EnableCommonBootImageImport = ptr.To(true)
+		hco.Spec.FeatureGates.EnableMultiArchBootImageImport = ptr.To(true)
+		hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image2}
+	})
+
+	getDict := func(dicts []hcov1beta1.DataImportCronTemplateStatus, name string) hcov1beta1.DataImportCronTemplateStatus {
+		for _, dict := range dicts {
+			if dict.Name == name {
+				return dict
+			}
+		}
+		Fail("can't find the " + name + " DataImportCronTemplate")
+		return hcov1beta1.DataImportCronTemplateStatus{}
+	}
+
+	It("should report a pending resolution, and resolve only the custom DICT", func() {
+		dicts, err := GetDataImportCronTemplates(hco)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(resolver.calls).To(Equal([]archresolver.Image{{URL: "docker://someregistry/image2", Namespace: defaultGoldenImagesNamespace}}))
+
+		image1 := getDict(dicts, "image1")
+		Expect(image1.Status.Conditions).To(BeEmpty())
+
+		image2 := getDict(dicts, "image2")
+		Expect(image2.Annotations).ToNot(HaveKey(MultiArchDICTAnnotation))
+		cond := meta.FindStatusCondition(image2.Status.Conditions, DictConditionArchsResolvedType)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(dictConditionArchsPendingReason))
+	})
+
+	It("should set the architecture annotation when resolved", func() {
+		resolver.results["docker://someregistry/image2"] = archresolver.Result{
+			State:         archresolver.StateResolved,
+			Architectures: []string{"amd64", "arm64", "s390x"},
+		}
+
+		dicts, err := GetDataImportCronTemplates(hco)
+		Expect(err).ToNot(HaveOccurred())
+
+		image2 := getDict(dicts, "image2")
+		Expect(image2.Annotations).To(HaveKeyWithValue(MultiArchDICTAnnotation, "amd64,arm64"))
+		Expect(image2.Status.OriginalSupportedArchitectures).To(Equal("amd64,arm64,s390x"))
+		Expect(meta.IsStatusConditionTrue(image2.Status.Conditions, DictConditionArchsResolvedType)).To(BeTrue())
+		Expect(meta.FindStatusCondition(image2.Status.Conditions, DictConditionDeployedType)).To(BeNil())
+	})
+
+	It("should report a failed resolution", func() {
+		resolver.results["docker://someregistry/image2"] = archresolver.Result{
+			State: archresolver.StateFailed,
+			Err:   archresolver.ErrNotMultiArch,
+		}
+
+		dicts, err := GetDataImportCronTemplates(hco)
+		Expect(err).ToNot(HaveOccurred())
+
+		image2 := getDict(dicts, "image2")
+		Expect(image2.Annotations).ToNot(HaveKey(MultiArchDICTAnnotation))
+		cond := meta.FindStatusCondition(image2.Status.Conditions, DictConditionArchsResolvedType)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(dictConditionArchsFailedReason))
+		Expect(cond.Message).To(ContainSubstring("set the ssp.kubevirt.io/dict.architectures annotation manually"))
+		Expect(cond.Message).To(ContainSubstring(archresolver.ErrNotMultiArch.Error()))
+	})
+
+	It("should keep the transition time of an unchanged condition", func() {
+		resolver.results["docker://someregistry/image2"] = archresolver.Result{
+			State: archresolver.StateFailed,
+			Err:   errors.New("connection refused"),
+		}
+
+		dicts, err := GetDataImportCronTemplates(hco)
+		Expect(err).ToNot(HaveOccurred())
+
+		transitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+		for i := range dicts {
+			if dicts[i].Name == "image2" {
+				meta.FindStatusCondition(dicts[i].Status.Conditions, DictConditionArchsResolvedType).LastTransitionTime = transitionTime
+			}
+		}
+		hco.Status.DataImportCronTemplates = dicts
+
+		dicts, err = GetDataImportCronTemplates(hco)
+		Expect(err).ToNot(HaveOccurred())
+		cond := meta.FindStatusCondition(getDict(dicts, "image2").Status.Conditions, DictConditionArchsResolvedType)
+		Expect(cond.LastTransitionTime).To(Equal(transitionTime))
+	})
+
+	It("should not resolve an annotated custom DICT", func() {
+		hco.Spec.DataImportCronTemplates[0].Annotations = map[string]string{MultiArchDICTAnnotation: "amd64"}
+
+		dicts, err := GetDataImportCronTemplates(hco)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(resolver.calls).To(BeEmpty())
+		Expect(getDict(dicts, "image2").Status.Conditions).To(BeEmpty())
+	})
+
+	It("should not resolve if the EnableMultiArchBootImageImport FG is disabled", func() {
+		hco.Spec.FeatureGates.EnableMultiArchBootImageImport = ptr.To(false)
+
+		_, err := GetDataImportCronTemplates(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolver.calls).To(BeEmpty())
+	})
+
+	It("should resolve a modified common DICT with a different image, using the registry mirror", func() {
+		customized, _ := makeDICT(1, true)
+		customized.Spec.Template.Spec.Source.Registry.URL = ptr.To("docker://quay.io/containerdisks/custom:latest")
+		hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{customized}
+		hco.Spec.BootImageRegistryMirrors = []hcov1beta1.RegistryMirror{{Source: "quay.io", Mirror: "mirror.example.com:5000"}}
+		hco.Spec.StorageImport = &hcov1beta1.StorageImportConfig{InsecureRegistries: []string{"mirror.example.com:5000"}}
+
+		_, err := GetDataImportCronTemplates(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolver.calls).To(Equal([]archresolver.Image{{
+			URL:       "docker://mirror.example.com:5000/containerdisks/custom:latest",
+			Insecure:  true,
+			Namespace: defaultGoldenImagesNamespace,
+		}}))
+	})
+
+	It("should pass the secret and the CA ConfigMap of the registry source, from the DataImportCron namespace", func() {
+		hco.Spec.DataImportCronTemplates[0].Namespace = "custom-images"
+		hco.Spec.DataImportCronTemplates[0].Spec.Template.Spec.Source.Registry.SecretRef = ptr.To("registry-secret")
+		hco.Spec.DataImportCronTemplates[0].Spec.Template.Spec.Source.Registry.CertConfigMap = ptr.To("registry-certs")
+
+		_, err := GetDataImportCronTemplates(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolver.calls).To(Equal([]archresolver.Image{{
+			URL:           "docker://someregistry/image2",
+			Namespace:     "custom-images",
+			SecretRef:     "registry-secret",
+			CertConfigMap: "registry-certs",
+		}}))
+	})
+
+	It("should use the commonBootImageNamespace for a DICT with no namespace", func() {
+		hco.Spec.CommonBootImageNamespace = ptr.To("golden-images")
+
+		_, err := GetDataImportCronTemplates(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolver.calls).To(Equal([]archresolver.Image{{URL: "docker://someregistry/image2", Namespace: "golden-images"}}))
+	})
+})
@@ -0,0 +1,138 @@
+package golden_images
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+const (
+	// BuiltInCatalogSource is the source name of the DataImportCronTemplates that are shipped with HCO
+	BuiltInCatalogSource = "built-in"
+
+	// ConfigMapCatalogSourcePrefix is the prefix of the source name of the DataImportCronTemplates that are read from a
+	// catalog ConfigMap
+	ConfigMapCatalogSourcePrefix = "configmap/"
+
+	// OCICatalogSourcePrefix is the prefix of the source name of the DataImportCronTemplates that are read from an
+	// image in the OCI image layout directory
+	OCICatalogSourcePrefix = "oci/"
+)
+
+// CatalogSource is a list of DataImportCronTemplates, read from an external catalog
+type CatalogSource struct {
+	Name      string
+	Templates []hcov1beta1.DataImportCronTemplate
+}
+
+type catalogTemplate struct {
+	dict   hcov1beta1.DataImportCronTemplate
+	source string
+}
+
+var (
+	externalCatalogLock sync.RWMutex
+	// externalCatalogMap holds the DataImportCronTemplates from the external catalogs. They are added to the built-in
+	// ones, and take precedence over a built-in template with the same name.
+	externalCatalogMap map[string]catalogTemplate
+)
+
+// SetExternalCatalogs replaces the DataImportCronTemplates from the external catalogs, and returns true if they were
+// changed. The sources are processed by their order; if a template name is already defined by a previous source, the
+// template is ignored, and an error is returned for it. The rest of the templates are still applied.
+func SetExternalCatalogs(sources []CatalogSource) (bool, error) {
+	newMap := make(map[string]catalogTemplate)
+	var errs []error
+
+	for _, source := range sources {
+		for _, dict := range source.Templates {
+			if existing, found := newMap[dict.Name]; found {
+				errs = append(errs, fmt.Errorf("%s: the %s DataImportCronTemplate is already defined in %s", source.Name, dict.Name, existing.source))
+				continue
+			}
+
+			newMap[dict.Name] = catalogTemplate{dict: *dict.DeepCopy(), source: source.Name}
+		}
+	}
+
+	externalCatalogLock.Lock()
+	defer externalCatalogLock.Unlock()
+
+	changed := !reflect.DeepEqual(newMap, externalCatalogMap) && (len(newMap) > 0 || len(externalCatalogMap) > 0)
+	externalCatalogMap = newMap
+
+	return changed, errors.Join(errs...)
+}
+
+// ValidateCatalogTemplates validates the DataImportCronTemplates of an external catalog. It returns the valid
+// templates, and an error for each invalid one.
+func ValidateCatalogTemplates(templates []hcov1beta1.DataImportCronTemplate) ([]hcov1beta1.DataImportCronTemplate, []error) {
+	var (
+		valid []hcov1beta1.DataImportCronTemplate
+		errs  []error
+		names = make(map[string]bool)
+	)
+
+	for i, dict := range templates {
+		if err := validateCatalogTemplate(dict); err != nil {
+			errs = append(errs, fmt.Errorf("DataImportCronTemplate #%d (%q): %w", i, dict.Name, err))
+			continue
+		}
+
+		if names[dict.Name] {
+			errs = append(errs, fmt.Errorf("DataImportCronTemplate #%d: the name %q is used more than once", i, dict.Name))
+			continue
+		}
+
+		names[dict.Name] = true
+		valid = append(valid, dict)
+	}
+
+	return valid, errs
+}
+
+func validateCatalogTemplate(dict hcov1beta1.DataImportCronTemplate) error {
+	if msgs := validation.IsDNS1123Subdomain(dict.Name); len(msgs) > 0 {
+		return fmt.Errorf("invalid name; %v", msgs)
+	}
+
+	if dict.Spec == nil {
+		return errors.New("missing spec")
+	}
+
+	if dict.Spec.ManagedDataSource == "" {
+		return errors.New("missing spec.managedDataSource")
+	}
+
+	if source := dict.Spec.Template.Spec.Source; source == nil || source.Registry == nil {
+		return errors.New("missing spec.template.spec.source.registry")
+	}
+
+	return nil
+}
+
+// getCommonDictMap returns the built-in DataImportCronTemplates, merged with the ones from the external catalogs
+func getCommonDictMap() map[string]catalogTemplate {
+	externalCatalogLock.RLock()
+	defer externalCatalogLock.RUnlock()
+
+	commonDicts := make(map[string]catalogTemplate, len(dataImportCronTemplateHardCodedMap)+len(externalCatalogMap))
+	for name, dict := range dataImportCronTemplateHardCodedMap {
+		commonDicts[name] = catalogTemplate{dict: dict, source: BuiltInCatalogSource}
+	}
+
+	for name, template := range externalCatalogMap {
+		dict := *template.dict.DeepCopy()
+		if dict.Spec.Schedule == "" {
+			dict.Spec.Schedule = commonDataImportSchedule
+		}
+		commonDicts[name] = catalogTemplate{dict: dict, source: template.source}
+	}
+
+	return commonDicts
+}
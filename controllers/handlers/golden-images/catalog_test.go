@@ -0,0 +1,162 @@
+package golden_images
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Test the external DataImportCronTemplate catalogs", func() {
+	var origHardCodedMap map[string]hcov1beta1.DataImportCronTemplate
+
+	BeforeEach(func() {
+		origHardCodedMap = dataImportCronTemplateHardCodedMap
+		image1, _ := makeDICT(1, true)
+		image2, _ := makeDICT(2, true)
+		dataImportCronTemplateHardCodedMap = map[string]hcov1beta1.DataImportCronTemplate{
+			image1.Name: image1,
+			image2.Name: image2,
+		}
+
+		DeferCleanup(func() {
+			dataImportCronTemplateHardCodedMap = origHardCodedMap
+			_, _ = SetExternalCatalogs(nil)
+		})
+	})
+
+	Context("SetExternalCatalogs", func() {
+		It("should report a change only when the templates were changed", func() {
+			image3, _ := makeDICT(3, false)
+			sources := []CatalogSource{{Name: "configmap/a", Templates: []hcov1beta1.DataImportCronTemplate{image3}}}
+
+			changed, err := SetExternalCatalogs(sources)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+
+			changed, err = SetExternalCatalogs(sources)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeFalse())
+
+			sources[0].Name = "configmap/b"
+			changed, err = SetExternalCatalogs(sources)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+
+			changed, err = SetExternalCatalogs(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+
+			changed, err = SetExternalCatalogs(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeFalse())
+		})
+
+		It("should keep the template from the first source, on a conflict", func() {
+			image3, _ := makeDICT(3, false)
+			image3Other := *image3.DeepCopy()
+			image3Other.Spec.ManagedDataSource = "other"
+			image4, _ := makeDICT(4, false)
+
+			changed, err := SetExternalCatalogs([]CatalogSource{
+				{Name: "oci/first", Templates: []hcov1beta1.DataImportCronTemplate{image3}},
+				{Name: "configmap/second", Templates: []hcov1beta1.DataImportCronTemplate{image3Other, image4}},
+			})
+			Expect(err).To(MatchError(ContainSubstring("configmap/second: the image3 DataImportCronTemplate is already defined in oci/first")))
+			Expect(changed).To(BeTrue())
+
+			commonDicts := getCommonDictMap()
+			Expect(commonDicts).To(HaveKey("image3"))
+			Expect(commonDicts["image3"].source).To(Equal("oci/first"))
+			Expect(commonDicts["image3"].dict.Spec.ManagedDataSource).To(Equal("image3"))
+			Expect(commonDicts).To(HaveKey("image4"))
+			Expect(commonDicts["image4"].source).To(Equal("configmap/second"))
+		})
+	})
+
+	Context("ValidateCatalogTemplates", func() {
+		It("should return only the valid templates", func() {
+			valid1, _ := makeDICT(1, false)
+			valid2, _ := makeDICT(2, false)
+
+			noSpec := *valid1.DeepCopy()
+			noSpec.Name = "no-spec"
+			noSpec.Spec = nil
+
+			noDataSource := *valid1.DeepCopy()
+			noDataSource.Name = "no-data-source"
+			noDataSource.Spec.ManagedDataSource = ""
+
+			noRegistry := *valid1.DeepCopy()
+			noRegistry.Name = "no-registry"
+			noRegistry.Spec.Template.Spec.Source.Registry = nil
+
+			badName := *valid1.DeepCopy()
+			badName.Name = "Bad_Name"
+
+			valid, errs := ValidateCatalogTemplates([]hcov1beta1.DataImportCronTemplate{
+				valid1, noSpec, noDataSource, noRegistry, badName, valid1, valid2,
+			})
+
+			Expect(valid).To(HaveLen(2))
+			Expect(valid[0].Name).To(Equal("image1"))
+			Expect(valid[1].Name).To(Equal("image2"))
+
+			Expect(errs).To(HaveLen(5))
+			Expect(errs[0]).To(MatchError(ContainSubstring("missing spec")))
+			Expect(errs[1]).To(MatchError(ContainSubstring("missing spec.managedDataSource")))
+			Expect(errs[2]).To(MatchError(ContainSubstring("missing spec.template.spec.source.registry")))
+			Expect(errs[3]).To(MatchError(ContainSubstring("invalid name")))
+			Expect(errs[4]).To(MatchError(ContainSubstring(`the name "image1" is used more than once`)))
+		})
+	})
+
+	Context("GetDataImportCronTemplates", func() {
+		It("should override a built-in template with an external one", func() {
+			overriding, _ := makeDICT(1, false)
+			overriding.Spec.Template.Spec.Source.Registry.URL = ptr.To("docker://mirror/image1")
+
+			_, err := SetExternalCatalogs([]CatalogSource{{Name: "configmap/a", Templates: []hcov1beta1.DataImportCronTemplate{overriding}}})
+			Expect(err).ToNot(HaveOccurred())
+
+			dicts, err := GetDataImportCronTemplates(commontestutils.NewHco())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dicts).To(HaveLen(2))
+
+			Expect(dicts[0].Name).To(Equal("image1"))
+			Expect(dicts[0].Status.CommonTemplate).To(BeTrue())
+			Expect(dicts[0].Status.Source).To(Equal("configmap/a"))
+			Expect(dicts[0].Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal("docker://mirror/image1")))
+
+			Expect(dicts[1].Name).To(Equal("image2"))
+			Expect(dicts[1].Status.Source).To(Equal(BuiltInCatalogSource))
+		})
+
+		It("should set the common schedule to an external template without a schedule", func() {
+			origSchedule := commonDataImportSchedule
+			DeferCleanup(func() {
+				commonDataImportSchedule = origSchedule
+			})
+			commonDataImportSchedule = "1 2 * * *"
+
+			image3, _ := makeDICT(3, false)
+			image3.Spec.Schedule = ""
+
+			_, err := SetExternalCatalogs([]CatalogSource{{Name: "oci/catalog:v1", Templates: []hcov1beta1.DataImportCronTemplate{image3}}})
+			Expect(err).ToNot(HaveOccurred())
+
+			commonDicts := getCommonDictMap()
+			Expect(commonDicts).To(HaveLen(3))
+			Expect(commonDicts["image3"].dict.Spec.Schedule).To(Equal("1 2 * * *"))
+			Expect(commonDicts["image3"].source).To(Equal("oci/catalog:v1"))
+
+			// make sure the stored template was not modified
+			externalCatalogLock.RLock()
+			defer externalCatalogLock.RUnlock()
+			Expect(externalCatalogMap["image3"].dict.Spec.Schedule).To(BeEmpty())
+			Expect(dataImportCronTemplateHardCodedMap["image1"].Spec.Schedule).To(Equal("1 */12 * * *"))
+		})
+	})
+})
@@ -25,6 +25,7 @@ import (
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/registrymirror"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -38,6 +39,9 @@ const (
 	DictConditionDeployedType    = "Deployed"
 	dictConditionDeployedReason  = "UnsupportedArchitectures"
 	dictConditionDeployedMessage = "DataImportCronTemplate has no supported architectures for the current cluster"
+
+	dictConditionNotEnoughArchsReason     = "NotEnoughArchitectures"
+	dictConditionNotEnoughArchsMessageFmt = "DataImportCronTemplate has %d selected architectures for the current cluster; at least %d are required"
 )
 
 var (
@@ -45,6 +49,10 @@ var (
 	// of data import cron templates from a local file and updates SSP with the up-to-date list
 	dataImportCronTemplateHardCodedMap map[string]hcov1beta1.DataImportCronTemplate
 
+	// commonDataImportSchedule is the schedule of the common templates. It is also used for the templates from the
+	// external catalogs, that do not define their own schedule
+	commonDataImportSchedule string
+
 	getDataImportCronTemplatesFileLocation = func() string {
 		return dataImportCronTemplatesFileLocation
 	}
@@ -58,15 +66,18 @@ var GetDataImportCronTemplates = func(hc *hcov1beta1.HyperConverged) ([]hcov1bet
 		return nil, err
 	}
 
+	commonDicts := getCommonDictMap()
+
 	var dictList []hcov1beta1.DataImportCronTemplateStatus
 	if ptr.Deref(hc.Spec.EnableCommonBootImageImport, true) {
-		dictList = getCommonDicts(dictList, crDicts, hc)
+		dictList = getCommonDicts(dictList, commonDicts, crDicts, hc)
 	}
-	dictList = getCustomDicts(dictList, crDicts)
+	dictList = getCustomDicts(dictList, commonDicts, crDicts)
 
 	if hc.Spec.FeatureGates.EnableMultiArchBootImageImport != nil && *hc.Spec.FeatureGates.EnableMultiArchBootImageImport {
 		for i := range dictList {
-			setDataImportCronTemplateStatusMultiArch(&dictList[i], nodeinfo.GetWorkloadsArchitectures())
+			resolveDictArchitectures(hc, &dictList[i])
+			setDataImportCronTemplateStatusMultiArch(&dictList[i], nodeinfo.GetWorkloadsArchitectures(), getArchitecturePolicy(hc.Spec.BootImageArchitecturePolicy, dictList[i].Name))
 		}
 	}
 
@@ -76,6 +87,8 @@ var GetDataImportCronTemplates = func(hc *hcov1beta1.HyperConverged) ([]hcov1bet
 }
 
 func CheckDataImportCronTemplates(hc *hcov1beta1.HyperConverged) {
+	setImportStatusMetrics(hc.Status.DataImportCronTemplates)
+
 	multiArchEnabled := ptr.Deref(hc.Spec.FeatureGates.EnableMultiArchBootImageImport, false)
 
 	if multiArchEnabled {
@@ -93,7 +106,8 @@ func validateMultiArchDict(dict *hcov1beta1.DataImportCronTemplateStatus) bool {
 		metrics.SetDICTWithArchitectureAnnotation(dict.Name, dict.Spec.ManagedDataSource)
 	}
 
-	if meta.IsStatusConditionFalse(dict.Status.Conditions, DictConditionDeployedType) {
+	if cond := meta.FindStatusCondition(dict.Status.Conditions, DictConditionDeployedType); cond != nil &&
+		cond.Status == metav1.ConditionFalse && cond.Reason != dictConditionNotEnoughArchsReason {
 		metrics.SetDICTWithNoSupportedArchitectures(dict.Name, dict.Spec.ManagedDataSource)
 	} else {
 		metrics.SetDICTWithSupportedArchitectures(dict.Name, dict.Spec.ManagedDataSource)
@@ -155,13 +169,14 @@ func readDataImportCronTemplatesFromFile() error {
 	})
 }
 
-func getCommonDicts(list []hcov1beta1.DataImportCronTemplateStatus, crDicts map[string]hcov1beta1.DataImportCronTemplate, hc *hcov1beta1.HyperConverged) []hcov1beta1.DataImportCronTemplateStatus {
+func getCommonDicts(list []hcov1beta1.DataImportCronTemplateStatus, commonDicts map[string]catalogTemplate, crDicts map[string]hcov1beta1.DataImportCronTemplate, hc *hcov1beta1.HyperConverged) []hcov1beta1.DataImportCronTemplateStatus {
 	enableMultiArchBootImageImport := ptr.Deref(hc.Spec.FeatureGates.EnableMultiArchBootImageImport, false)
-	for dictName, commonDict := range dataImportCronTemplateHardCodedMap {
+	for dictName, commonDict := range commonDicts {
 		targetDict := hcov1beta1.DataImportCronTemplateStatus{
-			DataImportCronTemplate: *commonDict.DeepCopy(),
+			DataImportCronTemplate: *commonDict.dict.DeepCopy(),
 			Status: hcov1beta1.DataImportCronStatus{
 				CommonTemplate: true,
+				Source:         commonDict.source,
 			},
 		}
 
@@ -252,13 +267,13 @@ func isDataImportCronTemplateEnabled(dict hcov1beta1.DataImportCronTemplate) boo
 	return !found || strings.ToLower(annotationVal) == "true"
 }
 
-func getCustomDicts(list []hcov1beta1.DataImportCronTemplateStatus, crDicts map[string]hcov1beta1.DataImportCronTemplate) []hcov1beta1.DataImportCronTemplateStatus {
+func getCustomDicts(list []hcov1beta1.DataImportCronTemplateStatus, commonDicts map[string]catalogTemplate, crDicts map[string]hcov1beta1.DataImportCronTemplate) []hcov1beta1.DataImportCronTemplateStatus {
 	for dictName, crDict := range crDicts {
 		if !isDataImportCronTemplateEnabled(crDict) {
 			continue
 		}
 
-		if _, isCommon := dataImportCronTemplateHardCodedMap[dictName]; !isCommon {
+		if _, isCommon := commonDicts[dictName]; !isCommon {
 			list = append(list, hcov1beta1.DataImportCronTemplateStatus{
 				DataImportCronTemplate: *crDict.DeepCopy(),
 				Status: hcov1beta1.DataImportCronStatus{
@@ -284,6 +299,8 @@ func getDicMapFromCr(hc *hcov1beta1.HyperConverged) (map[string]hcov1beta1.DataI
 }
 
 func overrideDataImportSchedule(schedule string) {
+	commonDataImportSchedule = schedule
+
 	for dictName := range dataImportCronTemplateHardCodedMap {
 		dict := dataImportCronTemplateHardCodedMap[dictName]
 		dict.Spec.Schedule = schedule
@@ -298,31 +315,36 @@ func (d dataImportTemplateSlice) Len() int           { return len(d) }
 func (d dataImportTemplateSlice) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
 func (d dataImportTemplateSlice) Less(i, j int) bool { return d[i].Name < d[j].Name }
 
-func setDataImportCronTemplateStatusMultiArch(hcoDictStatus *hcov1beta1.DataImportCronTemplateStatus, workloadsArchs []string) {
+func setDataImportCronTemplateStatusMultiArch(hcoDictStatus *hcov1beta1.DataImportCronTemplateStatus, workloadsArchs []string, policy hcov1beta1.ArchitecturePolicy) {
 	hcoArchsAnnotation, hcoArchsAnnotationExists := hcoDictStatus.Annotations[MultiArchDICTAnnotation]
 	if !hcoArchsAnnotationExists {
 		return
 	}
 
-	sspArchsAnnotation := removeUnsupportedArchs(hcoArchsAnnotation, workloadsArchs)
-	if sspArchsAnnotation == "" {
+	sspArchs := selectArchitectures(hcoArchsAnnotation, workloadsArchs, policy)
+	switch {
+	case len(sspArchs) == 0:
 		meta.SetStatusCondition(&hcoDictStatus.Status.Conditions, metav1.Condition{
 			Type:    DictConditionDeployedType,
 			Status:  metav1.ConditionFalse,
 			Reason:  dictConditionDeployedReason,
 			Message: dictConditionDeployedMessage,
 		})
-	} else {
+	case policy.MinArchitectures != nil && len(sspArchs) < int(*policy.MinArchitectures):
+		meta.SetStatusCondition(&hcoDictStatus.Status.Conditions, metav1.Condition{
+			Type:    DictConditionDeployedType,
+			Status:  metav1.ConditionFalse,
+			Reason:  dictConditionNotEnoughArchsReason,
+			Message: fmt.Sprintf(dictConditionNotEnoughArchsMessageFmt, len(sspArchs), *policy.MinArchitectures),
+		})
+	default:
 		meta.RemoveStatusCondition(&hcoDictStatus.Status.Conditions, DictConditionDeployedType)
-		if hcoDictStatus.Annotations == nil {
-			hcoDictStatus.Annotations = make(map[string]string)
-		}
 	}
-	hcoDictStatus.Annotations[MultiArchDICTAnnotation] = sspArchsAnnotation
+	hcoDictStatus.Annotations[MultiArchDICTAnnotation] = strings.Join(sspArchs, ",")
 	hcoDictStatus.Status.OriginalSupportedArchitectures = hcoArchsAnnotation
 }
 
-func hcoDictToSSP(hcoDictStatus hcov1beta1.DataImportCronTemplateStatus, multiArchEnabled bool) (sspv1beta3.DataImportCronTemplate, bool) {
+func hcoDictToSSP(hcoDictStatus hcov1beta1.DataImportCronTemplateStatus, multiArchEnabled bool, mirrors []hcov1beta1.RegistryMirror) (sspv1beta3.DataImportCronTemplate, bool) {
 	hcoDict := hcoDictStatus.DataImportCronTemplate
 	if multiArchEnabled && meta.IsStatusConditionFalse(hcoDictStatus.Status.Conditions, DictConditionDeployedType) {
 		// if the condition is false, it means that the DataImportCronTemplate has no supported architectures
@@ -352,15 +374,22 @@ func hcoDictToSSP(hcoDictStatus hcov1beta1.DataImportCronTemplateStatus, multiAr
 		delete(dict.Annotations, MultiArchDICTAnnotation)
 	}
 
+	if source := dict.Spec.Template.Spec.Source; source != nil && source.Registry != nil && source.Registry.URL != nil {
+		if url, rewritten := registrymirror.RewriteURL(*source.Registry.URL, mirrors); rewritten {
+			source.Registry.URL = ptr.To(url)
+		}
+	}
+
 	return dict, true
 }
 
 func hcoDictToSSPSeq(hc *hcov1beta1.HyperConverged, hcoDicts iter.Seq[hcov1beta1.DataImportCronTemplateStatus]) iter.Seq[sspv1beta3.DataImportCronTemplate] {
 	multiArchEnabled := ptr.Deref(hc.Spec.FeatureGates.EnableMultiArchBootImageImport, false)
+	mirrors := hc.Spec.BootImageRegistryMirrors
 
 	return func(yield func(sspv1beta3.DataImportCronTemplate) bool) {
 		for hcoDict := range hcoDicts {
-			sspDict, valid := hcoDictToSSP(hcoDict, multiArchEnabled)
+			sspDict, valid := hcoDictToSSP(hcoDict, multiArchEnabled, mirrors)
 			if valid && !yield(sspDict) {
 				return
 			}
@@ -368,14 +397,26 @@ func hcoDictToSSPSeq(hc *hcov1beta1.HyperConverged, hcoDicts iter.Seq[hcov1beta1
 	}
 }
 
-func removeUnsupportedArchs(archAnnotation string, workloadsArchs []string) string {
-	var newArchList []string
+// selectArchitectures returns the architectures from the annotation, that have workload nodes in the cluster, and that
+// are allowed by the policy. The preferred architecture of the policy, if selected, is moved to the head of the list.
+func selectArchitectures(archAnnotation string, workloadsArchs []string, policy hcov1beta1.ArchitecturePolicy) []string {
+	var archs []string
 
 	for _, arch := range strings.Split(archAnnotation, ",") {
-		if slices.Contains(workloadsArchs, arch) {
-			newArchList = append(newArchList, arch)
+		if !slices.Contains(workloadsArchs, arch) {
+			continue
+		}
+
+		if len(policy.Architectures) > 0 && !slices.Contains(policy.Architectures, arch) {
+			continue
+		}
+
+		if arch == policy.PreferredArchitecture {
+			archs = slices.Insert(archs, 0, arch)
+		} else {
+			archs = append(archs, arch)
 		}
 	}
 
-	return strings.Join(newArchList, ",")
+	return archs
 }
@@ -411,6 +411,46 @@ var _ = Describe("Test data import cron template", func() {
 			}
 		})
 
+		It("Should rewrite the registry URLs using the boot image registry mirrors", func() {
+			image3.Spec.Template.Spec.Source.Registry.URL = ptr.To("docker://quay.io/containerdisks/image3:latest")
+			image4.Spec.Template.Spec.Source.Registry.URL = nil
+			image4.Spec.Template.Spec.Source.Registry.ImageStream = ptr.To("image4")
+
+			dataImportCronTemplateHardCodedMap = map[string]hcov1beta1.DataImportCronTemplate{
+				image1.Name: image1,
+				image2.Name: image2,
+			}
+
+			hco.Spec.EnableCommonBootImageImport = ptr.To(true)
+			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image3, image4}
+			hco.Spec.BootImageRegistryMirrors = []hcov1beta1.RegistryMirror{
+				{Source: "someregistry", Mirror: "mirror.example.com:5000/golden-images"},
+				{Source: "quay.io/containerdisks", Mirror: "mirror.example.com:5000/containerdisks"},
+			}
+
+			goldenImageStatuses, err := GetDataImportCronTemplates(hco)
+			Expect(err).ToNot(HaveOccurred())
+			goldenImageList := HCODictSliceToSSP(hco, goldenImageStatuses)
+			Expect(goldenImageList).To(HaveLen(4))
+
+			urls := make(map[string]*string)
+			for _, dict := range goldenImageList {
+				urls[dict.Name] = dict.Spec.Template.Spec.Source.Registry.URL
+			}
+
+			Expect(urls).To(HaveKeyWithValue("image1", HaveValue(Equal("docker://mirror.example.com:5000/golden-images/image1"))))
+			Expect(urls).To(HaveKeyWithValue("image2", HaveValue(Equal("docker://mirror.example.com:5000/golden-images/image2"))))
+			Expect(urls).To(HaveKeyWithValue("image3", HaveValue(Equal("docker://mirror.example.com:5000/containerdisks/image3:latest"))))
+			Expect(urls).To(HaveKeyWithValue("image4", BeNil()))
+
+			By("keeping the original URLs in the status")
+			for _, dict := range goldenImageStatuses {
+				if dict.Name == "image1" {
+					Expect(dict.Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal("docker://someregistry/image1")))
+				}
+			}
+		})
+
 		It("Should add the cdi.kubevirt.io/storage.bind.immediate.requested annotation if missing, when customizing common dicts", func() {
 			image1.Annotations = map[string]string{
 				CDIImmediateBindAnnotation: "true",
@@ -1046,13 +1086,19 @@ func makeDICT(num int, CommonTemplate bool) (hcov1beta1.DataImportCronTemplate,
 		},
 	}
 
-	return dict, hcov1beta1.DataImportCronTemplateStatus{
+	status := hcov1beta1.DataImportCronTemplateStatus{
 		DataImportCronTemplate: *dict.DeepCopy(),
 		Status: hcov1beta1.DataImportCronStatus{
 			CommonTemplate: CommonTemplate,
 			Modified:       false,
 		},
 	}
+
+	if CommonTemplate {
+		status.Status.Source = BuiltInCatalogSource
+	}
+
+	return dict, status
 }
 
 const (
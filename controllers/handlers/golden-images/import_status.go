@@ -0,0 +1,145 @@
+package golden_images
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+const (
+	// defaultGoldenImagesNamespace is the namespace that SSP creates the DataImportCrons in, if neither the
+	// DataImportCronTemplate nor the HyperConverged CR set a namespace
+	defaultGoldenImagesNamespace = "kubevirt-os-images"
+
+	// annSourceDesiredDigest is the DataImportCron annotation, that CDI sets with the digest of the last imported image
+	annSourceDesiredDigest = "cdi.kubevirt.io/storage.import.sourceDesiredDigest"
+
+	sourceKindPVC        = "PersistentVolumeClaim"
+	sourceKindSnapshot   = "VolumeSnapshot"
+	sourceKindDataSource = "DataSource"
+)
+
+// UpdateImportStatus reads the DataImportCron and the DataSource of each DataImportCronTemplate, and sets the import
+// status of the DataImportCronTemplate accordingly. The statuses are modified in place.
+func UpdateImportStatus(ctx context.Context, cl client.Reader, hc *hcov1beta1.HyperConverged, dictStatuses []hcov1beta1.DataImportCronTemplateStatus) error {
+	defaultNamespace := getGoldenImagesNamespace(hc)
+	for i := range dictStatuses {
+		importStatus, err := getImportStatus(ctx, cl, dictStatuses[i], defaultNamespace)
+		if err != nil {
+			return err
+		}
+		dictStatuses[i].Status.Import = importStatus
+	}
+
+	return nil
+}
+
+// getGoldenImagesNamespace returns the namespace of the DataImportCrons of the DataImportCronTemplates with no
+// namespace
+func getGoldenImagesNamespace(hc *hcov1beta1.HyperConverged) string {
+	if ns := hc.Spec.CommonBootImageNamespace; ns != nil && len(*ns) > 0 {
+		return *ns
+	}
+	return defaultGoldenImagesNamespace
+}
+
+func getImportStatus(ctx context.Context, cl client.Reader, dict hcov1beta1.DataImportCronTemplateStatus, defaultNamespace string) (*hcov1beta1.DataImportCronImportStatus, error) {
+	namespace := dict.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	dic := &cdiv1beta1.DataImportCron{}
+	if err := cl.Get(ctx, k8stypes.NamespacedName{Name: dict.Name, Namespace: namespace}, dic); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("can't read the %s/%s DataImportCron; %w", namespace, dict.Name, err)
+	}
+
+	importStatus := &hcov1beta1.DataImportCronImportStatus{
+		LastImportTimestamp: dic.Status.LastImportTimestamp,
+		CurrentDigest:       dic.Annotations[annSourceDesiredDigest],
+	}
+
+	if len(dic.Status.CurrentImports) > 0 && dic.Status.CurrentImports[0].Digest != "" {
+		importStatus.CurrentDigest = dic.Status.CurrentImports[0].Digest
+	}
+
+	upToDate := getDataImportCronCondition(dic, cdiv1beta1.DataImportCronUpToDate)
+	progressing := getDataImportCronCondition(dic, cdiv1beta1.DataImportCronProgressing)
+
+	importStatus.UpToDate = upToDate != nil && upToDate.Status == corev1.ConditionTrue
+	if !importStatus.UpToDate && (progressing == nil || progressing.Status != corev1.ConditionTrue) {
+		switch {
+		case progressing != nil && progressing.Reason != "":
+			importStatus.FailureReason = progressing.Reason
+		case upToDate != nil:
+			importStatus.FailureReason = upToDate.Reason
+		}
+	}
+
+	dsName := dict.Spec.ManagedDataSource
+	ds := &cdiv1beta1.DataSource{}
+	if err := cl.Get(ctx, k8stypes.NamespacedName{Name: dsName, Namespace: namespace}, ds); err != nil {
+		if apierrors.IsNotFound(err) {
+			return importStatus, nil
+		}
+		return nil, fmt.Errorf("can't read the %s/%s DataSource; %w", namespace, dsName, err)
+	}
+
+	switch {
+	case ds.Status.Source.PVC != nil:
+		importStatus.SourceKind = sourceKindPVC
+	case ds.Status.Source.Snapshot != nil:
+		importStatus.SourceKind = sourceKindSnapshot
+	case ds.Status.Source.DataSource != nil:
+		importStatus.SourceKind = sourceKindDataSource
+	}
+
+	for _, cond := range ds.Status.Conditions {
+		if cond.Type == cdiv1beta1.DataSourceReady {
+			importStatus.SourceReady = cond.Status == corev1.ConditionTrue
+			break
+		}
+	}
+
+	return importStatus, nil
+}
+
+func getDataImportCronCondition(dic *cdiv1beta1.DataImportCron, condType cdiv1beta1.DataImportCronConditionType) *cdiv1beta1.DataImportCronCondition {
+	for i := range dic.Status.Conditions {
+		if dic.Status.Conditions[i].Type == condType {
+			return &dic.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func setImportStatusMetrics(dictStatuses []hcov1beta1.DataImportCronTemplateStatus) {
+	metrics.ResetDICTImportStatus()
+
+	for _, dict := range dictStatuses {
+		importStatus := dict.Status.Import
+		if importStatus == nil {
+			continue
+		}
+
+		var lastImport time.Time
+		if importStatus.LastImportTimestamp != nil {
+			lastImport = importStatus.LastImportTimestamp.Time
+		}
+
+		metrics.SetDICTImportStatus(dict.Name, dict.Spec.ManagedDataSource, lastImport, importStatus.UpToDate, importStatus.SourceReady)
+	}
+}
@@ -0,0 +1,184 @@
+package golden_images
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+var _ = Describe("Test the DataImportCronTemplate import status", func() {
+	var (
+		lastImport metav1.Time
+		dictStatus hcov1beta1.DataImportCronTemplateStatus
+		hc         *hcov1beta1.HyperConverged
+	)
+
+	BeforeEach(func() {
+		hc = commontestutils.NewHco()
+		lastImport = metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+		_, dictStatus = makeDICT(1, true)
+	})
+
+	newDataImportCron := func(namespace string, conditions ...cdiv1beta1.DataImportCronCondition) *cdiv1beta1.DataImportCron {
+		return &cdiv1beta1.DataImportCron{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "image1",
+				Namespace:   namespace,
+				Annotations: map[string]string{annSourceDesiredDigest: "sha256:1111"},
+			},
+			Status: cdiv1beta1.DataImportCronStatus{
+				LastImportTimestamp: &lastImport,
+				Conditions:          conditions,
+			},
+		}
+	}
+
+	newCondition := func(condType cdiv1beta1.DataImportCronConditionType, status corev1.ConditionStatus, reason string) cdiv1beta1.DataImportCronCondition {
+		return cdiv1beta1.DataImportCronCondition{
+			Type:           condType,
+			ConditionState: cdiv1beta1.ConditionState{Status: status, Reason: reason},
+		}
+	}
+
+	newDataSource := func(namespace string, ready corev1.ConditionStatus) *cdiv1beta1.DataSource {
+		return &cdiv1beta1.DataSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "image1",
+				Namespace: namespace,
+			},
+			Status: cdiv1beta1.DataSourceStatus{
+				Source: cdiv1beta1.DataSourceSource{
+					Snapshot: &cdiv1beta1.DataVolumeSourceSnapshot{Name: "image1-snapshot", Namespace: namespace},
+				},
+				Conditions: []cdiv1beta1.DataSourceCondition{
+					{Type: cdiv1beta1.DataSourceReady, ConditionState: cdiv1beta1.ConditionState{Status: ready}},
+				},
+			},
+		}
+	}
+
+	It("should not set the import status if the DataImportCron does not exist", func() {
+		statuses := []hcov1beta1.DataImportCronTemplateStatus{dictStatus}
+		cl := commontestutils.InitClient(nil)
+
+		Expect(UpdateImportStatus(context.Background(), cl, hc, statuses)).To(Succeed())
+		Expect(statuses[0].Status.Import).To(BeNil())
+	})
+
+	It("should read the DataImportCron and the DataSource from the default namespace", func() {
+		statuses := []hcov1beta1.DataImportCronTemplateStatus{dictStatus}
+		cl := commontestutils.InitClient([]client.Object{
+			newDataImportCron(defaultGoldenImagesNamespace,
+				newCondition(cdiv1beta1.DataImportCronUpToDate, corev1.ConditionTrue, "UpToDate"),
+				newCondition(cdiv1beta1.DataImportCronProgressing, corev1.ConditionFalse, "NoImport"),
+			),
+			newDataSource(defaultGoldenImagesNamespace, corev1.ConditionTrue),
+		})
+
+		Expect(UpdateImportStatus(context.Background(), cl, hc, statuses)).To(Succeed())
+
+		importStatus := statuses[0].Status.Import
+		Expect(importStatus).ToNot(BeNil())
+		Expect(importStatus.LastImportTimestamp).To(HaveValue(Equal(lastImport)))
+		Expect(importStatus.CurrentDigest).To(Equal("sha256:1111"))
+		Expect(importStatus.UpToDate).To(BeTrue())
+		Expect(importStatus.FailureReason).To(BeEmpty())
+		Expect(importStatus.SourceKind).To(Equal(sourceKindSnapshot))
+		Expect(importStatus.SourceReady).To(BeTrue())
+	})
+
+	It("should read the DataImportCron and the DataSource from the commonBootImageNamespace, if set", func() {
+		hc.Spec.CommonBootImageNamespace = ptr.To("custom-boot-images")
+		statuses := []hcov1beta1.DataImportCronTemplateStatus{dictStatus}
+		cl := commontestutils.InitClient([]client.Object{
+			newDataImportCron("custom-boot-images",
+				newCondition(cdiv1beta1.DataImportCronUpToDate, corev1.ConditionTrue, "UpToDate"),
+			),
+			newDataSource("custom-boot-images", corev1.ConditionTrue),
+		})
+
+		Expect(UpdateImportStatus(context.Background(), cl, hc, statuses)).To(Succeed())
+
+		importStatus := statuses[0].Status.Import
+		Expect(importStatus).ToNot(BeNil())
+		Expect(importStatus.UpToDate).To(BeTrue())
+		Expect(importStatus.SourceReady).To(BeTrue())
+	})
+
+	It("should use the namespace of the DataImportCronTemplate, and report an import in progress", func() {
+		dictStatus.Namespace = "custom-namespace"
+		statuses := []hcov1beta1.DataImportCronTemplateStatus{dictStatus}
+
+		dic := newDataImportCron("custom-namespace",
+			newCondition(cdiv1beta1.DataImportCronUpToDate, corev1.ConditionFalse, "ImportProgressing"),
+			newCondition(cdiv1beta1.DataImportCronProgressing, corev1.ConditionTrue, "ImportProgressing"),
+		)
+		dic.Status.CurrentImports = []cdiv1beta1.ImportStatus{{DataVolumeName: "image1-2222", Digest: "sha256:2222"}}
+
+		cl := commontestutils.InitClient([]client.Object{dic})
+
+		Expect(UpdateImportStatus(context.Background(), cl, hc, statuses)).To(Succeed())
+
+		importStatus := statuses[0].Status.Import
+		Expect(importStatus).ToNot(BeNil())
+		Expect(importStatus.CurrentDigest).To(Equal("sha256:2222"))
+		Expect(importStatus.UpToDate).To(BeFalse())
+		Expect(importStatus.FailureReason).To(BeEmpty())
+		Expect(importStatus.SourceKind).To(BeEmpty())
+		Expect(importStatus.SourceReady).To(BeFalse())
+	})
+
+	It("should report the failure reason, if the DataImportCron is not up-to-date, and there is no import in progress", func() {
+		statuses := []hcov1beta1.DataImportCronTemplateStatus{dictStatus}
+		cl := commontestutils.InitClient([]client.Object{
+			newDataImportCron(defaultGoldenImagesNamespace,
+				newCondition(cdiv1beta1.DataImportCronUpToDate, corev1.ConditionFalse, "NoPvc"),
+				newCondition(cdiv1beta1.DataImportCronProgressing, corev1.ConditionFalse, "NoDigest"),
+			),
+			newDataSource(defaultGoldenImagesNamespace, corev1.ConditionFalse),
+		})
+
+		Expect(UpdateImportStatus(context.Background(), cl, hc, statuses)).To(Succeed())
+
+		importStatus := statuses[0].Status.Import
+		Expect(importStatus).ToNot(BeNil())
+		Expect(importStatus.UpToDate).To(BeFalse())
+		Expect(importStatus.FailureReason).To(Equal("NoDigest"))
+		Expect(importStatus.SourceReady).To(BeFalse())
+	})
+
+	It("should set the import status metrics", func() {
+		_, dictStatus2 := makeDICT(2, false)
+
+		hc := commontestutils.NewHco()
+		hc.Status.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplateStatus{dictStatus, dictStatus2}
+		hc.Status.DataImportCronTemplates[0].Status.Import = &hcov1beta1.DataImportCronImportStatus{
+			LastImportTimestamp: &lastImport,
+			UpToDate:            true,
+			SourceReady:         true,
+		}
+		hc.Status.DataImportCronTemplates[1].Status.Import = &hcov1beta1.DataImportCronImportStatus{}
+
+		CheckDataImportCronTemplates(hc)
+
+		Expect(metrics.GetDICTLastImportTimestamp("image1", "image1")).To(Equal(float64(lastImport.Unix())))
+		Expect(metrics.IsDICTUpToDate("image1", "image1")).To(BeTrue())
+		Expect(metrics.IsDICTSourceReady("image1", "image1")).To(BeTrue())
+
+		Expect(metrics.GetDICTLastImportTimestamp("image2", "image2")).To(BeZero())
+		Expect(metrics.IsDICTUpToDate("image2", "image2")).To(BeFalse())
+		Expect(metrics.IsDICTSourceReady("image2", "image2")).To(BeFalse())
+	})
+})
@@ -376,14 +376,72 @@ func getHcoHighBurstProfileTuningValues(hc *hcov1beta1.HyperConverged) (*kubevir
 	}, nil
 }
 
-func hcoTuning2Kv(hc *hcov1beta1.HyperConverged) (*kubevirtcorev1.ReloadableComponentConfiguration, error) {
+func getHcoCustomTuning(hc *hcov1beta1.HyperConverged) (*kvRateLimiters, error) {
+	if _, ok := hc.Annotations[common.TuningPolicyAnnotationName]; ok {
+		return nil, fmt.Errorf("custom tuning policy is enabled and the annotation " + common.TuningPolicyAnnotationName + " is present")
+	}
+
+	custom := hc.Spec.CustomTuningPolicy
+	if custom == nil {
+		return nil, fmt.Errorf("custom tuning policy is enabled but the customTuningPolicy field is not set")
+	}
+
+	return &kvRateLimiters{
+		api:        hcoRateLimiterToKv(cmp.Or(custom.API, custom.Default)),
+		controller: hcoRateLimiterToKv(cmp.Or(custom.Controller, custom.Default)),
+		handler:    hcoRateLimiterToKv(cmp.Or(custom.Handler, custom.Default)),
+		webhook:    hcoRateLimiterToKv(cmp.Or(custom.Webhook, custom.Default)),
+	}, nil
+}
+
+func hcoRateLimiterToKv(rateLimiter *hcov1beta1.ComponentRateLimiter) *kubevirtcorev1.ReloadableComponentConfiguration {
+	if rateLimiter == nil {
+		return nil
+	}
+
+	return &kubevirtcorev1.ReloadableComponentConfiguration{
+		RestClient: &kubevirtcorev1.RESTClientConfiguration{
+			RateLimiter: &kubevirtcorev1.RateLimiter{
+				TokenBucketRateLimiter: &kubevirtcorev1.TokenBucketRateLimiter{
+					QPS:   float32(rateLimiter.QPS.AsApproximateFloat64()),
+					Burst: int(rateLimiter.Burst),
+				},
+			},
+		},
+	}
+}
+
+// kvRateLimiters holds the rate limiters of the kubevirt components
+type kvRateLimiters struct {
+	api        *kubevirtcorev1.ReloadableComponentConfiguration
+	controller *kubevirtcorev1.ReloadableComponentConfiguration
+	handler    *kubevirtcorev1.ReloadableComponentConfiguration
+	webhook    *kubevirtcorev1.ReloadableComponentConfiguration
+}
+
+func newKvRateLimiters(rateLimiter *kubevirtcorev1.ReloadableComponentConfiguration, err error) (*kvRateLimiters, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return &kvRateLimiters{
+		api:        rateLimiter,
+		controller: rateLimiter,
+		handler:    rateLimiter,
+		webhook:    rateLimiter,
+	}, nil
+}
+
+func hcoTuning2Kv(hc *hcov1beta1.HyperConverged) (*kvRateLimiters, error) {
 	switch hc.Spec.TuningPolicy {
 	case hcov1beta1.HyperConvergedAnnotationTuningPolicy:
-		return getHcoAnnotationTuning(hc)
+		return newKvRateLimiters(getHcoAnnotationTuning(hc))
 	case hcov1beta1.HyperConvergedHighBurstProfile:
-		return getHcoHighBurstProfileTuningValues(hc)
+		return newKvRateLimiters(getHcoHighBurstProfileTuningValues(hc))
+	case hcov1beta1.HyperConvergedCustomTuningPolicy:
+		return getHcoCustomTuning(hc)
 	}
-	return nil, nil
+	return &kvRateLimiters{}, nil
 }
 
 func hcWorkloadUpdateStrategyToKv(hcObject *hcov1beta1.HyperConvergedWorkloadUpdateStrategy) kubevirtcorev1.KubeVirtWorkloadUpdateStrategy {
@@ -420,7 +478,7 @@ func getKVConfig(hc *hcov1beta1.HyperConverged) (*kubevirtcorev1.KubeVirtConfigu
 
 	obsoleteCPUs, minCPUModel := getObsoleteCPUConfig(hc.Spec.ObsoleteCPUs)
 
-	rateLimiter, err := hcoTuning2Kv(hc)
+	rateLimiters, err := hcoTuning2Kv(hc)
 	if err != nil {
 		return nil, err
 	}
@@ -441,10 +499,10 @@ func getKVConfig(hc *hcov1beta1.HyperConverged) (*kubevirtcorev1.KubeVirtConfigu
 		ObsoleteCPUModels:            obsoleteCPUs,
 		MinCPUModel:                  minCPUModel,
 		TLSConfiguration:             hcTLSSecurityProfileToKv(hcoutil.GetClusterInfo().GetTLSSecurityProfile(hc.Spec.TLSSecurityProfile)),
-		APIConfiguration:             rateLimiter,
-		WebhookConfiguration:         rateLimiter,
-		ControllerConfiguration:      rateLimiter,
-		HandlerConfiguration:         rateLimiter,
+		APIConfiguration:             rateLimiters.api,
+		WebhookConfiguration:         rateLimiters.webhook,
+		ControllerConfiguration:      rateLimiters.controller,
+		HandlerConfiguration:         rateLimiters.handler,
 		SeccompConfiguration:         seccompConfig,
 		EvictionStrategy:             hc.Spec.EvictionStrategy,
 		KSMConfiguration:             hc.Spec.KSMConfiguration,
@@ -694,7 +752,7 @@ func hcLiveMigrationToKv(lm hcov1beta1.LiveMigrationConfigurations) (*kubevirtco
 		ParallelOutboundMigrationsPerNode: lm.ParallelOutboundMigrationsPerNode,
 		ParallelMigrationsPerCluster:      lm.ParallelMigrationsPerCluster,
 		ProgressTimeout:                   lm.ProgressTimeout,
-		Network:                           lm.Network,
+		Network:                           getMigrationNetwork(lm),
 		AllowAutoConverge:                 lm.AllowAutoConverge,
 		AllowPostCopy:                     lm.AllowPostCopy,
 	}, nil
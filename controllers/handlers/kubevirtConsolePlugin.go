@@ -63,12 +63,12 @@ const ( // for network policies
 
 // **** Kubevirt UI Plugin Deployment Handler ****
 func NewKvUIPluginDeploymentHandler(_ log.Logger, Client client.Client, Scheme *runtime.Scheme, hc *hcov1beta1.HyperConverged) (operands.Operand, error) {
-	return operands.NewDeploymentHandler(Client, Scheme, NewKvUIPluginDeployment, hc), nil
+	return operands.NewDeploymentHandler(Client, Scheme, NewKvUIPluginDeployment, hc).WithComponent(common.ComponentConsolePlugin), nil
 }
 
 // **** Kubevirt UI apiserver proxy Deployment Handler ****
 func NewKvUIProxyDeploymentHandler(_ log.Logger, Client client.Client, Scheme *runtime.Scheme, hc *hcov1beta1.HyperConverged) (operands.Operand, error) {
-	return operands.NewDeploymentHandler(Client, Scheme, NewKvUIProxyDeployment, hc), nil
+	return operands.NewDeploymentHandler(Client, Scheme, NewKvUIProxyDeployment, hc).WithComponent(common.ComponentConsolePlugin), nil
 }
 
 // **** nginx config map Handler ****
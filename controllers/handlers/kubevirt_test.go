@@ -3671,6 +3671,79 @@ Version: 1.2.3`)
 				})
 			})
 
+			Context("with custom tuning policy", func() {
+				It("Should return error if the customTuningPolicy field is missing", func() {
+					hco.Spec.TuningPolicy = hcov1beta1.HyperConvergedCustomTuningPolicy
+
+					kv, err := NewKubeVirt(hco)
+
+					Expect(err).To(MatchError("custom tuning policy is enabled but the customTuningPolicy field is not set"))
+					Expect(kv).To(BeNil())
+				})
+
+				It("Should return error if the json annotation tuningPolicy is present", func() {
+					hco.Spec.TuningPolicy = hcov1beta1.HyperConvergedCustomTuningPolicy
+					hco.Spec.CustomTuningPolicy = &hcov1beta1.CustomTuningPolicy{
+						Default: &hcov1beta1.ComponentRateLimiter{QPS: resource.MustParse("100"), Burst: 200},
+					}
+					hco.Annotations = map[string]string{common.TuningPolicyAnnotationName: `{"qps": 100, "burst": 200}`}
+
+					kv, err := NewKubeVirt(hco)
+
+					Expect(err).To(HaveOccurred())
+					Expect(kv).To(BeNil())
+				})
+
+				It("Should use the default rate limiter for the components that are not explicitly set", func() {
+					hco.Spec.TuningPolicy = hcov1beta1.HyperConvergedCustomTuningPolicy
+					hco.Spec.CustomTuningPolicy = &hcov1beta1.CustomTuningPolicy{
+						Default: &hcov1beta1.ComponentRateLimiter{QPS: resource.MustParse("100"), Burst: 200},
+						Handler: &hcov1beta1.ComponentRateLimiter{QPS: resource.MustParse("50"), Burst: 75},
+					}
+
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(kv).ToNot(BeNil())
+
+					Expect(kv.Spec.Configuration.APIConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.QPS).To(Equal(float32(100)))
+					Expect(kv.Spec.Configuration.APIConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.Burst).To(Equal(200))
+					Expect(kv.Spec.Configuration.ControllerConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.QPS).To(Equal(float32(100)))
+					Expect(kv.Spec.Configuration.ControllerConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.Burst).To(Equal(200))
+					Expect(kv.Spec.Configuration.WebhookConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.QPS).To(Equal(float32(100)))
+					Expect(kv.Spec.Configuration.WebhookConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.Burst).To(Equal(200))
+					Expect(kv.Spec.Configuration.HandlerConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.QPS).To(Equal(float32(50)))
+					Expect(kv.Spec.Configuration.HandlerConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.Burst).To(Equal(75))
+				})
+
+				It("Should support a fractional qps value", func() {
+					hco.Spec.TuningPolicy = hcov1beta1.HyperConvergedCustomTuningPolicy
+					hco.Spec.CustomTuningPolicy = &hcov1beta1.CustomTuningPolicy{
+						Handler: &hcov1beta1.ComponentRateLimiter{QPS: resource.MustParse("500m"), Burst: 5},
+					}
+
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(kv.Spec.Configuration.HandlerConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.QPS).To(Equal(float32(0.5)))
+				})
+
+				It("Should keep the kubevirt defaults for the components that are not set, if there is no default rate limiter", func() {
+					hco.Spec.TuningPolicy = hcov1beta1.HyperConvergedCustomTuningPolicy
+					hco.Spec.CustomTuningPolicy = &hcov1beta1.CustomTuningPolicy{
+						API: &hcov1beta1.ComponentRateLimiter{QPS: resource.MustParse("300"), Burst: 400},
+					}
+
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(kv).ToNot(BeNil())
+
+					Expect(kv.Spec.Configuration.APIConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.QPS).To(Equal(float32(300)))
+					Expect(kv.Spec.Configuration.APIConfiguration.RestClient.RateLimiter.TokenBucketRateLimiter.Burst).To(Equal(400))
+					Expect(kv.Spec.Configuration.ControllerConfiguration).To(BeNil())
+					Expect(kv.Spec.Configuration.WebhookConfiguration).To(BeNil())
+					Expect(kv.Spec.Configuration.HandlerConfiguration).To(BeNil())
+				})
+			})
+
 		})
 
 		Context("jsonpath Annotation", func() {
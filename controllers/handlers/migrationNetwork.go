@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	netattdefv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// MigrationNetworkNADName is the name of the NetworkAttachmentDefinition of the dedicated migration network
+	MigrationNetworkNADName = "kubevirt-migration-network"
+
+	migrationNetworkCNIVersion = "0.3.1"
+)
+
+// migrationNetworkConfig is the CNI configuration of the dedicated migration network
+type migrationNetworkConfig struct {
+	CNIVersion string                     `json:"cniVersion"`
+	Name       string                     `json:"name"`
+	Type       string                     `json:"type"`
+	Master     string                     `json:"master"`
+	Mode       string                     `json:"mode,omitempty"`
+	VLANID     int32                      `json:"vlanId,omitempty"`
+	IPAM       migrationNetworkIPAMConfig `json:"ipam"`
+}
+
+type migrationNetworkIPAMConfig struct {
+	Type    string   `json:"type"`
+	Range   string   `json:"range"`
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// NewMigrationNetworkHandler creates a conditional handler for the NetworkAttachmentDefinition of the dedicated
+// migration network
+func NewMigrationNetworkHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
+	return operands.NewConditionalHandler(
+		operands.NewNetworkAttachmentDefinitionHandler(Client, Scheme, NewMigrationNetworkNAD),
+		func(hc *hcov1beta1.HyperConverged) bool {
+			return hc.Spec.LiveMigrationConfig.DedicatedNetwork != nil
+		},
+		func(hc *hcov1beta1.HyperConverged) client.Object {
+			return NewMigrationNetworkNADWithNameOnly(hc)
+		},
+	)
+}
+
+// NewMigrationNetworkNADWithNameOnly returns the NetworkAttachmentDefinition of the dedicated migration network,
+// without its spec
+func NewMigrationNetworkNADWithNameOnly(hc *hcov1beta1.HyperConverged) *netattdefv1.NetworkAttachmentDefinition {
+	return &netattdefv1.NetworkAttachmentDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      MigrationNetworkNADName,
+			Namespace: hc.Namespace,
+			Labels:    hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentNetwork),
+		},
+	}
+}
+
+// NewMigrationNetworkNAD returns the NetworkAttachmentDefinition of the dedicated migration network. If a VLAN is
+// set, the vlan CNI plugin is used; otherwise, a macvlan interface in bridge mode is attached to the node interface.
+// The IP addresses are allocated by the whereabouts IPAM plugin.
+func NewMigrationNetworkNAD(hc *hcov1beta1.HyperConverged) *netattdefv1.NetworkAttachmentDefinition {
+	nad := NewMigrationNetworkNADWithNameOnly(hc)
+
+	dedicatedNetwork := hc.Spec.LiveMigrationConfig.DedicatedNetwork
+	if dedicatedNetwork == nil {
+		return nad
+	}
+
+	cfg := migrationNetworkConfig{
+		CNIVersion: migrationNetworkCNIVersion,
+		Name:       MigrationNetworkNADName,
+		Master:     dedicatedNetwork.Interface,
+		IPAM: migrationNetworkIPAMConfig{
+			Type:    "whereabouts",
+			Range:   dedicatedNetwork.IPRange,
+			Exclude: dedicatedNetwork.ExcludeRanges,
+		},
+	}
+
+	if dedicatedNetwork.VLAN != nil {
+		cfg.Type = "vlan"
+		cfg.VLANID = *dedicatedNetwork.VLAN
+	} else {
+		cfg.Type = "macvlan"
+		cfg.Mode = "bridge"
+	}
+
+	// can't fail; the config contains only strings and numbers
+	config, _ := json.Marshal(cfg)
+	nad.Spec.Config = string(config)
+
+	return nad
+}
+
+// getMigrationNetwork returns the name of the migration network NetworkAttachmentDefinition, for the KubeVirt
+// migration configuration
+func getMigrationNetwork(lm hcov1beta1.LiveMigrationConfigurations) *string {
+	if lm.DedicatedNetwork != nil {
+		return ptr.To(MigrationNetworkNADName)
+	}
+
+	return lm.Network
+}
+
+// ValidateMigrationNetwork checks the dedicated migration network configuration
+func ValidateMigrationNetwork(lm hcov1beta1.LiveMigrationConfigurations) error {
+	dedicatedNetwork := lm.DedicatedNetwork
+	if dedicatedNetwork == nil {
+		return nil
+	}
+
+	if lm.Network != nil {
+		return errors.New("the network and the dedicatedNetwork fields can't be set together")
+	}
+
+	_, ipRange, err := net.ParseCIDR(dedicatedNetwork.IPRange)
+	if err != nil {
+		return fmt.Errorf("dedicatedNetwork.ipRange: %q is not a valid CIDR", dedicatedNetwork.IPRange)
+	}
+
+	rangeSize, _ := ipRange.Mask.Size()
+	for i, exclude := range dedicatedNetwork.ExcludeRanges {
+		ip, excludeRange, err := net.ParseCIDR(exclude)
+		if err != nil {
+			return fmt.Errorf("dedicatedNetwork.excludeRanges[%d]: %q is not a valid CIDR", i, exclude)
+		}
+
+		excludeSize, _ := excludeRange.Mask.Size()
+		if !ipRange.Contains(ip) || excludeSize < rangeSize {
+			return fmt.Errorf("dedicatedNetwork.excludeRanges[%d]: %q is not within the %q IP range", i, exclude, dedicatedNetwork.IPRange)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+
+	netattdefv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Live migration dedicated network tests", func() {
+	var (
+		hco *v1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+	})
+
+	getConfig := func(nad *netattdefv1.NetworkAttachmentDefinition) map[string]any {
+		cfg := map[string]any{}
+		ExpectWithOffset(1, json.Unmarshal([]byte(nad.Spec.Config), &cfg)).To(Succeed())
+		return cfg
+	}
+
+	Context("test NewMigrationNetworkNAD", func() {
+		It("should create a macvlan network, if the VLAN is not set", func() {
+			hco.Spec.LiveMigrationConfig.DedicatedNetwork = &v1beta1.LiveMigrationNetwork{
+				Interface: "eth1",
+				IPRange:   "10.200.5.0/24",
+			}
+
+			nad := NewMigrationNetworkNAD(hco)
+			Expect(nad.Name).To(Equal(MigrationNetworkNADName))
+			Expect(nad.Namespace).To(Equal(hco.Namespace))
+			Expect(nad.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hcoutil.HyperConvergedName))
+			Expect(nad.Labels).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentNetwork)))
+
+			Expect(getConfig(nad)).To(Equal(map[string]any{
+				"cniVersion": "0.3.1",
+				"name":       MigrationNetworkNADName,
+				"type":       "macvlan",
+				"master":     "eth1",
+				"mode":       "bridge",
+				"ipam": map[string]any{
+					"type":  "whereabouts",
+					"range": "10.200.5.0/24",
+				},
+			}))
+		})
+
+		It("should create a vlan network, if the VLAN is set", func() {
+			hco.Spec.LiveMigrationConfig.DedicatedNetwork = &v1beta1.LiveMigrationNetwork{
+				Interface:     "bond0",
+				VLAN:          ptr.To[int32](100),
+				IPRange:       "10.200.5.0/24",
+				ExcludeRanges: []string{"10.200.5.0/28"},
+			}
+
+			Expect(getConfig(NewMigrationNetworkNAD(hco))).To(Equal(map[string]any{
+				"cniVersion": "0.3.1",
+				"name":       MigrationNetworkNADName,
+				"type":       "vlan",
+				"master":     "bond0",
+				"vlanId":     float64(100),
+				"ipam": map[string]any{
+					"type":    "whereabouts",
+					"range":   "10.200.5.0/24",
+					"exclude": []any{"10.200.5.0/28"},
+				},
+			}))
+		})
+	})
+
+	Context("NetworkAttachmentDefinition deployment", func() {
+		It("should not create the NetworkAttachmentDefinition if the dedicated network is not set", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := NewMigrationNetworkHandler(cl, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+
+			foundNADs := &netattdefv1.NetworkAttachmentDefinitionList{}
+			Expect(cl.List(context.Background(), foundNADs)).To(Succeed())
+			Expect(foundNADs.Items).To(BeEmpty())
+		})
+
+		It("should create the NetworkAttachmentDefinition if the dedicated network is set", func() {
+			hco.Spec.LiveMigrationConfig.DedicatedNetwork = &v1beta1.LiveMigrationNetwork{
+				Interface: "eth1",
+				IPRange:   "10.200.5.0/24",
+			}
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := NewMigrationNetworkHandler(cl, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+			Expect(res.Name).To(Equal(MigrationNetworkNADName))
+
+			foundNAD := &netattdefv1.NetworkAttachmentDefinition{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: MigrationNetworkNADName, Namespace: hco.Namespace}, foundNAD)).To(Succeed())
+			Expect(foundNAD.Spec.Config).To(Equal(NewMigrationNetworkNAD(hco).Spec.Config))
+		})
+
+		It("should update the NetworkAttachmentDefinition if the dedicated network was changed", func() {
+			hco.Spec.LiveMigrationConfig.DedicatedNetwork = &v1beta1.LiveMigrationNetwork{
+				Interface: "eth1",
+				IPRange:   "10.200.5.0/24",
+			}
+			nad := NewMigrationNetworkNAD(hco)
+
+			hco.Spec.LiveMigrationConfig.DedicatedNetwork.IPRange = "10.200.6.0/24"
+
+			cl := commontestutils.InitClient([]client.Object{hco, nad})
+			handler := NewMigrationNetworkHandler(cl, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			foundNAD := &netattdefv1.NetworkAttachmentDefinition{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: MigrationNetworkNADName, Namespace: hco.Namespace}, foundNAD)).To(Succeed())
+			Expect(foundNAD.Spec.Config).To(ContainSubstring(`"range":"10.200.6.0/24"`))
+		})
+
+		It("should delete the NetworkAttachmentDefinition if the dedicated network was removed", func() {
+			hco.Spec.LiveMigrationConfig.DedicatedNetwork = &v1beta1.LiveMigrationNetwork{
+				Interface: "eth1",
+				IPRange:   "10.200.5.0/24",
+			}
+			nad := NewMigrationNetworkNAD(hco)
+			hco.Spec.LiveMigrationConfig.DedicatedNetwork = nil
+
+			cl := commontestutils.InitClient([]client.Object{hco, nad})
+			handler := NewMigrationNetworkHandler(cl, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+
+			foundNADs := &netattdefv1.NetworkAttachmentDefinitionList{}
+			Expect(cl.List(context.Background(), foundNADs)).To(Succeed())
+			Expect(foundNADs.Items).To(BeEmpty())
+		})
+	})
+
+	Context("KubeVirt migration network", func() {
+		It("should use the dedicated network as the KubeVirt migration network", func() {
+			hco.Spec.LiveMigrationConfig.DedicatedNetwork = &v1beta1.LiveMigrationNetwork{
+				Interface: "eth1",
+				IPRange:   "10.200.5.0/24",
+			}
+
+			kv, err := NewKubeVirt(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kv.Spec.Configuration.MigrationConfiguration).ToNot(BeNil())
+			Expect(kv.Spec.Configuration.MigrationConfiguration.Network).To(HaveValue(Equal(MigrationNetworkNADName)))
+		})
+
+		It("should use the network field, if the dedicated network is not set", func() {
+			hco.Spec.LiveMigrationConfig.Network = ptr.To("my-network")
+
+			kv, err := NewKubeVirt(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kv.Spec.Configuration.MigrationConfiguration).To(HaveField("Network", HaveValue(Equal("my-network"))))
+		})
+	})
+
+	Context("ValidateMigrationNetwork", func() {
+		DescribeTable("should validate the dedicated network", func(lm v1beta1.LiveMigrationConfigurations, errMsg string) {
+			err := ValidateMigrationNetwork(lm)
+			if errMsg == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(errMsg)))
+			}
+		},
+			Entry("no dedicated network", v1beta1.LiveMigrationConfigurations{Network: ptr.To("my-network")}, ""),
+			Entry("valid IPv4 range", v1beta1.LiveMigrationConfigurations{
+				DedicatedNetwork: &v1beta1.LiveMigrationNetwork{Interface: "eth1", IPRange: "10.200.5.0/24", ExcludeRanges: []string{"10.200.5.0/28", "10.200.5.100/32"}},
+			}, ""),
+			Entry("valid IPv6 range", v1beta1.LiveMigrationConfigurations{
+				DedicatedNetwork: &v1beta1.LiveMigrationNetwork{Interface: "eth1", IPRange: "fd00:200::/64"},
+			}, ""),
+			Entry("both network and dedicated network", v1beta1.LiveMigrationConfigurations{
+				Network:          ptr.To("my-network"),
+				DedicatedNetwork: &v1beta1.LiveMigrationNetwork{Interface: "eth1", IPRange: "10.200.5.0/24"},
+			}, "the network and the dedicatedNetwork fields can't be set together"),
+			Entry("invalid IP range", v1beta1.LiveMigrationConfigurations{
+				DedicatedNetwork: &v1beta1.LiveMigrationNetwork{Interface: "eth1", IPRange: "10.200.5.0"},
+			}, `dedicatedNetwork.ipRange: "10.200.5.0" is not a valid CIDR`),
+			Entry("invalid exclude range", v1beta1.LiveMigrationConfigurations{
+				DedicatedNetwork: &v1beta1.LiveMigrationNetwork{Interface: "eth1", IPRange: "10.200.5.0/24", ExcludeRanges: []string{"10.200.5.300/32"}},
+			}, `dedicatedNetwork.excludeRanges[0]: "10.200.5.300/32" is not a valid CIDR`),
+			Entry("exclude range outside the IP range", v1beta1.LiveMigrationConfigurations{
+				DedicatedNetwork: &v1beta1.LiveMigrationNetwork{Interface: "eth1", IPRange: "10.200.5.0/24", ExcludeRanges: []string{"10.200.5.0/28", "10.200.6.0/28"}},
+			}, `dedicatedNetwork.excludeRanges[1]: "10.200.6.0/28" is not within the "10.200.5.0/24" IP range`),
+			Entry("exclude range larger than the IP range", v1beta1.LiveMigrationConfigurations{
+				DedicatedNetwork: &v1beta1.LiveMigrationNetwork{Interface: "eth1", IPRange: "10.200.5.0/24", ExcludeRanges: []string{"10.200.0.0/16"}},
+			}, `dedicatedNetwork.excludeRanges[0]: "10.200.0.0/16" is not within the "10.200.5.0/24" IP range`),
+		)
+	})
+})
@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	objectreferencesv1 "github.com/openshift/custom-resource-status/objectreferences/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/reference"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// MigrationPolicyLabel is the label of the MigrationPolicies that were created from the migrationPolicies field
+	// of the HyperConverged CR. Its value is the name of the policy in the HyperConverged CR.
+	MigrationPolicyLabel = "hco.kubevirt.io/migration-policy"
+
+	migrationPolicyNamePrefix = "hco-policy-"
+)
+
+// migrationPoliciesHandler reconciles the KubeVirt MigrationPolicies of the migrationPolicies field of the
+// HyperConverged CR. Each MigrationPolicy is reconciled by a generic operand, and the MigrationPolicies of policies
+// that were removed from the list, are deleted.
+//
+// The MigrationPolicy is a cluster-scoped resource, so it can't be owned by the namespaced HyperConverged CR. They are
+// identified by the HCO app label and their name prefix instead, and are deleted with the HyperConverged CR.
+type migrationPoliciesHandler struct {
+	client client.Client
+	scheme *runtime.Scheme
+}
+
+// NewMigrationPoliciesHandler creates the handler of the MigrationPolicies of the HyperConverged CR
+func NewMigrationPoliciesHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
+	return &migrationPoliciesHandler{
+		client: Client,
+		scheme: Scheme,
+	}
+}
+
+// Ensure reconciles all the MigrationPolicies. The results are merged into a single result, that holds the names of
+// all the modified MigrationPolicies.
+func (h *migrationPoliciesHandler) Ensure(req *common.HcoRequest) *operands.EnsureResult {
+	res := operands.NewEnsureResult(&migrationsv1alpha1.MigrationPolicy{}).SetUpgradeDone(req.ComponentUpgradeInProgress)
+
+	var names []string
+	for _, policy := range req.Instance.Spec.MigrationPolicies {
+		mp := NewMigrationPolicy(policy)
+		opRes := operands.NewGenericOperand(h.client, h.scheme, "MigrationPolicy", &migrationPolicyHooks{required: mp}, false).Ensure(req)
+		if opRes.Err != nil {
+			return res.SetName(opRes.Name).Error(opRes.Err)
+		}
+
+		if mergeMigrationPolicyResult(res, opRes) {
+			names = append(names, opRes.Name)
+		}
+	}
+
+	deleted, err := h.deleteRemovedPolicies(req)
+	if err != nil {
+		return res.Error(err)
+	}
+	if len(deleted) > 0 {
+		res.SetDeleted()
+		names = append(names, deleted...)
+	}
+
+	return res.SetName(strings.Join(names, ", "))
+}
+
+// mergeMigrationPolicyResult merges the result of a single MigrationPolicy into the handler result. Returns true if
+// the MigrationPolicy was modified.
+func mergeMigrationPolicyResult(res, opRes *operands.EnsureResult) bool {
+	res.UpgradeDone = res.UpgradeDone && opRes.UpgradeDone
+
+	switch {
+	case opRes.Created:
+		res.SetCreated()
+	case opRes.Updated:
+		res.SetUpdated()
+		if opRes.Overwritten {
+			res.SetOverwritten(true)
+			res.DriftedFields = append(res.DriftedFields, opRes.DriftedFields...)
+		}
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (h *migrationPoliciesHandler) deleteRemovedPolicies(req *common.HcoRequest) ([]string, error) {
+	policies, err := ListMigrationPolicies(req.Ctx, h.client)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []string
+	for i := range policies {
+		mp := &policies[i]
+		if slices.ContainsFunc(req.Instance.Spec.MigrationPolicies, func(policy hcov1beta1.LiveMigrationPolicy) bool {
+			return migrationPolicyName(policy.Name) == mp.Name
+		}) {
+			continue
+		}
+
+		removed, err := hcoutil.EnsureDeleted(req.Ctx, h.client, mp, req.Instance.Name, req.Logger, false, false, true)
+		if err != nil {
+			return nil, err
+		}
+
+		if removed {
+			deleted = append(deleted, mp.Name)
+			if err = h.removeRelatedObject(req, mp); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return deleted, nil
+}
+
+// ListMigrationPolicies returns the MigrationPolicies that were created from the migrationPolicies field of the
+// HyperConverged CR. The MigrationPolicies are listed by the HCO app label, and identified by their name prefix, so
+// orphaned MigrationPolicies with a missing MigrationPolicyLabel label are listed as well.
+func ListMigrationPolicies(ctx context.Context, cl client.Reader) ([]migrationsv1alpha1.MigrationPolicy, error) {
+	mpList := &migrationsv1alpha1.MigrationPolicyList{}
+	if err := cl.List(ctx, mpList, client.MatchingLabels{hcoutil.AppLabel: hcoutil.HyperConvergedName}); err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(mpList.Items, func(mp migrationsv1alpha1.MigrationPolicy) bool {
+		_, labeled := mp.Labels[MigrationPolicyLabel]
+		return !labeled && !strings.HasPrefix(mp.Name, migrationPolicyNamePrefix)
+	}), nil
+}
+
+func (h *migrationPoliciesHandler) removeRelatedObject(req *common.HcoRequest, mp *migrationsv1alpha1.MigrationPolicy) error {
+	objectRef, err := reference.GetReference(h.scheme, mp)
+	if err != nil {
+		return err
+	}
+
+	if err = objectreferencesv1.RemoveObjectReference(&req.Instance.Status.RelatedObjects, *objectRef); err != nil {
+		return err
+	}
+	req.StatusDirty = true
+
+	return nil
+}
+
+func (*migrationPoliciesHandler) Reset() { /* no implementation */ }
+
+type migrationPolicyHooks struct {
+	required *migrationsv1alpha1.MigrationPolicy
+}
+
+func (h migrationPolicyHooks) GetFullCr(_ *hcov1beta1.HyperConverged) (client.Object, error) {
+	return h.required.DeepCopy(), nil
+}
+
+func (migrationPolicyHooks) GetEmptyCr() client.Object {
+	return &migrationsv1alpha1.MigrationPolicy{}
+}
+
+func (migrationPolicyHooks) JustBeforeComplete(_ *common.HcoRequest) { /* no implementation */ }
+
+func (h migrationPolicyHooks) UpdateCR(req *common.HcoRequest, Client client.Client, exists runtime.Object, _ runtime.Object) (bool, bool, error) {
+	found, ok := exists.(*migrationsv1alpha1.MigrationPolicy)
+	if !ok {
+		return false, false, errors.New("can't convert to MigrationPolicy")
+	}
+
+	if !equality.Semantic.DeepEqual(h.required.Spec, found.Spec) ||
+		!hcoutil.CompareLabels(h.required, found) {
+		if req.HCOTriggered {
+			req.Logger.Info("Updating existing MigrationPolicy's Spec to new opinionated values", "name", h.required.Name)
+		} else {
+			req.Logger.Info("Reconciling an externally updated MigrationPolicy's Spec to its opinionated values", "name", h.required.Name)
+		}
+		hcoutil.MergeLabels(&h.required.ObjectMeta, &found.ObjectMeta)
+		h.required.Spec.DeepCopyInto(&found.Spec)
+		err := Client.Update(req.Ctx, found)
+		if err != nil {
+			return false, false, err
+		}
+		return true, !req.HCOTriggered, nil
+	}
+
+	return false, false, nil
+}
+
+// NewMigrationPolicy returns the KubeVirt MigrationPolicy of a live migration policy of the HyperConverged CR
+func NewMigrationPolicy(policy hcov1beta1.LiveMigrationPolicy) *migrationsv1alpha1.MigrationPolicy {
+	mp := NewMigrationPolicyWithNameOnly(policy.Name)
+
+	mp.Spec = migrationsv1alpha1.MigrationPolicySpec{
+		Selectors: &migrationsv1alpha1.Selectors{
+			NamespaceSelector:              migrationsv1alpha1.LabelSelector(policy.NamespaceSelector),
+			VirtualMachineInstanceSelector: migrationsv1alpha1.LabelSelector(policy.VirtualMachineInstanceSelector),
+		},
+		AllowAutoConverge:       policy.AllowAutoConverge,
+		AllowPostCopy:           policy.AllowPostCopy,
+		BandwidthPerMigration:   policy.BandwidthPerMigration,
+		CompletionTimeoutPerGiB: policy.CompletionTimeoutPerGiB,
+	}
+
+	return mp
+}
+
+// NewMigrationPolicyWithNameOnly returns the KubeVirt MigrationPolicy of a live migration policy of the
+// HyperConverged CR, without its spec
+func NewMigrationPolicyWithNameOnly(policyName string) *migrationsv1alpha1.MigrationPolicy {
+	labels := hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentCompute)
+	labels[MigrationPolicyLabel] = policyName
+
+	return &migrationsv1alpha1.MigrationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   migrationPolicyName(policyName),
+			Labels: labels,
+		},
+	}
+}
+
+func migrationPolicyName(policyName string) string {
+	return migrationPolicyNamePrefix + policyName
+}
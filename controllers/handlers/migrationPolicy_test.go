@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/tools/reference"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("MigrationPolicies tests", func() {
+	var (
+		hco *v1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Spec.MigrationPolicies = []v1beta1.LiveMigrationPolicy{
+			{
+				Name:                           "large-vms",
+				VirtualMachineInstanceSelector: map[string]string{"size": "large"},
+				AllowPostCopy:                  ptr.To(true),
+				CompletionTimeoutPerGiB:        ptr.To[int64](300),
+			},
+			{
+				Name:                  "tenant-a",
+				NamespaceSelector:     map[string]string{"tenant": "a"},
+				AllowAutoConverge:     ptr.To(true),
+				BandwidthPerMigration: ptr.To(resource.MustParse("64Mi")),
+			},
+		}
+		req = commontestutils.NewReq(hco)
+	})
+
+	listPolicies := func(cl client.Client) []migrationsv1alpha1.MigrationPolicy {
+		mpList := &migrationsv1alpha1.MigrationPolicyList{}
+		ExpectWithOffset(1, cl.List(context.Background(), mpList)).To(Succeed())
+		return mpList.Items
+	}
+
+	Context("test NewMigrationPolicy", func() {
+		It("should translate the policy to a MigrationPolicy", func() {
+			mp := NewMigrationPolicy(hco.Spec.MigrationPolicies[1])
+
+			Expect(mp.Name).To(Equal("hco-policy-tenant-a"))
+			Expect(mp.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hcoutil.HyperConvergedName))
+			Expect(mp.Labels).To(HaveKeyWithValue(MigrationPolicyLabel, "tenant-a"))
+
+			Expect(mp.Spec.Selectors).ToNot(BeNil())
+			Expect(mp.Spec.Selectors.NamespaceSelector).To(Equal(migrationsv1alpha1.LabelSelector{"tenant": "a"}))
+			Expect(mp.Spec.Selectors.VirtualMachineInstanceSelector).To(BeEmpty())
+			Expect(mp.Spec.AllowAutoConverge).To(HaveValue(BeTrue()))
+			Expect(mp.Spec.AllowPostCopy).To(BeNil())
+			Expect(mp.Spec.BandwidthPerMigration).To(HaveValue(Equal(resource.MustParse("64Mi"))))
+			Expect(mp.Spec.CompletionTimeoutPerGiB).To(BeNil())
+		})
+	})
+
+	Context("MigrationPolicies deployment", func() {
+		It("should create the MigrationPolicies, and add them to the related objects", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+			Expect(res.Type).To(Equal("MigrationPolicy"))
+			Expect(res.Name).To(Equal("hco-policy-large-vms, hco-policy-tenant-a"))
+
+			policies := listPolicies(cl)
+			Expect(policies).To(HaveLen(2))
+
+			// the related objects are updated on the next reconciliation, once the MigrationPolicies exist
+			res = handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+
+			policies = listPolicies(cl)
+			for _, mp := range policies {
+				objRef, err := reference.GetReference(commontestutils.GetScheme(), &mp)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(hco.Status.RelatedObjects).To(ContainElement(*objRef))
+			}
+		})
+
+		It("should not report a change if the MigrationPolicies are already up to date", func() {
+			cl := commontestutils.InitClient([]client.Object{
+				hco,
+				NewMigrationPolicy(hco.Spec.MigrationPolicies[0]),
+				NewMigrationPolicy(hco.Spec.MigrationPolicies[1]),
+			})
+			handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+			Expect(res.Updated).To(BeFalse())
+			Expect(res.Deleted).To(BeFalse())
+			Expect(res.Name).To(BeEmpty())
+		})
+
+		It("should revert a modified MigrationPolicy", func() {
+			modified := NewMigrationPolicy(hco.Spec.MigrationPolicies[0])
+			modified.Spec.AllowPostCopy = ptr.To(false)
+
+			cl := commontestutils.InitClient([]client.Object{
+				hco,
+				modified,
+				NewMigrationPolicy(hco.Spec.MigrationPolicies[1]),
+			})
+			handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+			req.HCOTriggered = false
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeTrue())
+			Expect(res.Name).To(Equal("hco-policy-large-vms"))
+			Expect(res.DriftedFields).ToNot(BeEmpty())
+
+			found := &migrationsv1alpha1.MigrationPolicy{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: "hco-policy-large-vms"}, found)).To(Succeed())
+			Expect(found.Spec.AllowPostCopy).To(HaveValue(BeTrue()))
+		})
+
+		It("should not update a MigrationPolicy with a semantically equal bandwidth", func() {
+			equal := NewMigrationPolicy(hco.Spec.MigrationPolicies[1])
+			equal.Spec.BandwidthPerMigration = ptr.To(resource.MustParse("67108864"))
+
+			cl := commontestutils.InitClient([]client.Object{
+				hco,
+				NewMigrationPolicy(hco.Spec.MigrationPolicies[0]),
+				equal,
+			})
+			handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+			Expect(res.Name).To(BeEmpty())
+		})
+
+		It("should update a MigrationPolicy if the policy was changed in the HyperConverged CR", func() {
+			cl := commontestutils.InitClient([]client.Object{
+				hco,
+				NewMigrationPolicy(hco.Spec.MigrationPolicies[0]),
+				NewMigrationPolicy(hco.Spec.MigrationPolicies[1]),
+			})
+			handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+			hco.Spec.MigrationPolicies[1].NamespaceSelector = map[string]string{"tenant": "b"}
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeFalse())
+			Expect(res.Name).To(Equal("hco-policy-tenant-a"))
+
+			found := &migrationsv1alpha1.MigrationPolicy{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: "hco-policy-tenant-a"}, found)).To(Succeed())
+			Expect(found.Spec.Selectors.NamespaceSelector).To(Equal(migrationsv1alpha1.LabelSelector{"tenant": "b"}))
+		})
+
+		It("should delete the MigrationPolicies of removed policies, and keep other MigrationPolicies", func() {
+			removed := NewMigrationPolicy(hco.Spec.MigrationPolicies[1])
+			objRef, err := reference.GetReference(commontestutils.GetScheme(), removed)
+			Expect(err).ToNot(HaveOccurred())
+			hco.Status.RelatedObjects = append(hco.Status.RelatedObjects, *objRef)
+
+			// created by the HyperConvergedProfile controller
+			profilePolicy := &migrationsv1alpha1.MigrationPolicy{}
+			profilePolicy.Name = "hco-profile-my-namespace"
+			profilePolicy.Labels = hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentCompute)
+
+			cl := commontestutils.InitClient([]client.Object{
+				hco,
+				NewMigrationPolicy(hco.Spec.MigrationPolicies[0]),
+				removed,
+				profilePolicy,
+			})
+			handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+			hco.Spec.MigrationPolicies = hco.Spec.MigrationPolicies[:1]
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+			Expect(res.Name).To(Equal("hco-policy-tenant-a"))
+
+			Expect(listPolicies(cl)).To(ConsistOf(
+				HaveField("Name", "hco-policy-large-vms"),
+				HaveField("Name", "hco-profile-my-namespace"),
+			))
+			Expect(hco.Status.RelatedObjects).ToNot(ContainElement(*objRef))
+		})
+
+		It("should delete an orphaned MigrationPolicy that its policy label was removed", func() {
+			orphaned := NewMigrationPolicy(v1beta1.LiveMigrationPolicy{Name: "removed"})
+			delete(orphaned.Labels, MigrationPolicyLabel)
+
+			cl := commontestutils.InitClient([]client.Object{
+				hco,
+				NewMigrationPolicy(hco.Spec.MigrationPolicies[0]),
+				NewMigrationPolicy(hco.Spec.MigrationPolicies[1]),
+				orphaned,
+			})
+			handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+			Expect(res.Name).To(Equal("hco-policy-removed"))
+
+			Expect(listPolicies(cl)).To(ConsistOf(
+				HaveField("Name", "hco-policy-large-vms"),
+				HaveField("Name", "hco-policy-tenant-a"),
+			))
+		})
+
+		It("should delete all the MigrationPolicies if the list is empty", func() {
+			cl := commontestutils.InitClient([]client.Object{
+				hco,
+				NewMigrationPolicy(hco.Spec.MigrationPolicies[0]),
+				NewMigrationPolicy(hco.Spec.MigrationPolicies[1]),
+			})
+			handler := NewMigrationPoliciesHandler(cl, commontestutils.GetScheme())
+
+			hco.Spec.MigrationPolicies = nil
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+			Expect(listPolicies(cl)).To(BeEmpty())
+		})
+	})
+})
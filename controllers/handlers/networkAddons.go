@@ -28,7 +28,7 @@ import (
 var defaultHco = components.GetOperatorCR()
 
 func NewCnaHandler(Client client.Client, Scheme *runtime.Scheme) *operands.GenericOperand {
-	return operands.NewGenericOperand(Client, Scheme, "NetworkAddonsConfig", &cnaHooks{}, false)
+	return operands.NewGenericOperand(Client, Scheme, "NetworkAddonsConfig", &cnaHooks{}, false).WithComponent(common.ComponentCNAO)
 }
 
 type cnaHooks struct {
@@ -19,6 +19,7 @@ import (
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -265,7 +266,7 @@ func NewPasstServiceAccountHandler(Client client.Client, Scheme *runtime.Scheme)
 // NewPasstDaemonSetHandler creates a conditional handler for passt DaemonSet
 func NewPasstDaemonSetHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
 	return createPasstConditionalHandler(
-		operands.NewDaemonSetHandler(Client, Scheme, NewPasstBindingCNIDaemonSet),
+		operands.NewDaemonSetHandler(Client, Scheme, NewPasstBindingCNIDaemonSet).WithComponent(common.ComponentPasst),
 		func(hc *hcov1beta1.HyperConverged) client.Object {
 			return NewPasstBindingCNIDaemonSet(hc)
 		},
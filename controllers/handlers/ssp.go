@@ -31,11 +31,12 @@ const (
 )
 
 func NewSspHandler(Client client.Client, Scheme *runtime.Scheme) *operands.GenericOperand {
-	return operands.NewGenericOperand(Client, Scheme, "SSP", &sspHooks{}, false)
+	return operands.NewGenericOperand(Client, Scheme, "SSP", &sspHooks{client: Client}, false)
 }
 
 type sspHooks struct {
 	sync.Mutex
+	client       client.Reader
 	cache        *sspv1beta3.SSP
 	dictStatuses []hcov1beta1.DataImportCronTemplateStatus
 }
@@ -96,6 +97,13 @@ func (*sspHooks) UpdateCR(req *common.HcoRequest, client client.Client, exists r
 }
 
 func (h *sspHooks) JustBeforeComplete(req *common.HcoRequest) {
+	h.Lock()
+	defer h.Unlock()
+
+	if err := goldenimages.UpdateImportStatus(req.Ctx, h.client, req.Instance, h.dictStatuses); err != nil {
+		req.Logger.Error(err, "failed to read the import status of the DataImportCronTemplates")
+	}
+
 	if !reflect.DeepEqual(h.dictStatuses, req.Instance.Status.DataImportCronTemplates) {
 		req.Instance.Status.DataImportCronTemplates = h.dictStatuses
 		req.StatusDirty = true
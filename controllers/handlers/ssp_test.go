@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -619,6 +620,60 @@ var _ = Describe("SSP Operands", func() {
 			})
 		})
 
+		Context("DataImportCronTemplates - import status", func() {
+			It("should set the import status from the DataImportCron and the DataSource", func(ctx context.Context) {
+				origFunc := goldenimages.GetDataImportCronTemplates
+				DeferCleanup(func() {
+					goldenimages.GetDataImportCronTemplates = origFunc
+				})
+
+				dict := makeDICT(1)
+				dict.Namespace = "golden-images"
+				goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+					return []hcov1beta1.DataImportCronTemplateStatus{dict}, nil
+				}
+
+				lastImport := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+				dic := &cdiv1beta1.DataImportCron{
+					ObjectMeta: metav1.ObjectMeta{Name: "image1", Namespace: "golden-images"},
+					Status: cdiv1beta1.DataImportCronStatus{
+						LastImportTimestamp: &lastImport,
+						CurrentImports:      []cdiv1beta1.ImportStatus{{DataVolumeName: "image1-1234", Digest: "sha256:1234"}},
+						Conditions: []cdiv1beta1.DataImportCronCondition{
+							{Type: cdiv1beta1.DataImportCronUpToDate, ConditionState: cdiv1beta1.ConditionState{Status: corev1.ConditionTrue}},
+						},
+					},
+				}
+				ds := &cdiv1beta1.DataSource{
+					ObjectMeta: metav1.ObjectMeta{Name: "image1", Namespace: "golden-images"},
+					Status: cdiv1beta1.DataSourceStatus{
+						Source: cdiv1beta1.DataSourceSource{PVC: &cdiv1beta1.DataVolumeSourcePVC{Name: "image1-1234", Namespace: "golden-images"}},
+						Conditions: []cdiv1beta1.DataSourceCondition{
+							{Type: cdiv1beta1.DataSourceReady, ConditionState: cdiv1beta1.ConditionState{Status: corev1.ConditionTrue}},
+						},
+					},
+				}
+
+				cli := commontestutils.InitClient([]client.Object{hco, dic, ds})
+				handler := NewSspHandler(cli, commontestutils.GetScheme())
+
+				res := handler.Ensure(req)
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				Expect(hco.Status.DataImportCronTemplates).To(HaveLen(1))
+				importStatus := hco.Status.DataImportCronTemplates[0].Status.Import
+				Expect(importStatus).ToNot(BeNil())
+				Expect(importStatus.LastImportTimestamp).To(HaveValue(Equal(lastImport)))
+				Expect(importStatus.CurrentDigest).To(Equal("sha256:1234"))
+				Expect(importStatus.UpToDate).To(BeTrue())
+				Expect(importStatus.SourceKind).To(Equal("PersistentVolumeClaim"))
+				Expect(importStatus.SourceReady).To(BeTrue())
+
+				Expect(metrics.GetDICTLastImportTimestamp("image1", "image1")).To(Equal(float64(lastImport.Unix())))
+				Expect(metrics.IsDICTSourceReady("image1", "image1")).To(BeTrue())
+			})
+		})
+
 		Context("DataImportCronTemplates - metrics", func() {
 			BeforeEach(func() {
 				origFuncGetDataImportCronTemplates := goldenimages.GetDataImportCronTemplates
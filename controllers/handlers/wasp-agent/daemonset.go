@@ -14,6 +14,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -35,7 +36,7 @@ var (
 
 func NewWaspAgentDaemonSetHandler(Client client.Client, Scheme *runtime.Scheme) operands.Operand {
 	return operands.NewConditionalHandler(
-		operands.NewDaemonSetHandler(Client, Scheme, newWaspAgentDaemonSet),
+		operands.NewDaemonSetHandler(Client, Scheme, newWaspAgentDaemonSet).WithComponent(common.ComponentWaspAgent),
 		shouldDeployWaspAgent,
 		func(hc *hcov1beta1.HyperConverged) client.Object {
 			return NewWaspAgentWithNameOnly(hc)
@@ -0,0 +1,409 @@
+package hyperconverged
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+const (
+	defaultCanaryBakeTime         = 10 * time.Minute
+	defaultCanaryProgressDeadline = 30 * time.Minute
+	canaryHealthCheckInterval     = 30 * time.Second
+
+	configRolloutCanaryReason     = "CanaryInProgress"
+	configRolloutCompletedReason  = "RolloutCompleted"
+	configRolloutRolledBackReason = "RolledBack"
+
+	configRolloutCanaryMessage     = "The new configuration is applied to the canary node pool"
+	configRolloutCompletedMessage  = "The configuration is applied to the whole cluster"
+	configRolloutDegradedMessage   = "The new configuration was rolled back, because %s became degraded on the canary node pool"
+	configRolloutDeadlineMessage   = "The new configuration was rolled back, because the components did not become healthy on the canary node pool within %s"
+	configRolloutRolledBackWarning = "; modify the workloads or the workloadUpdateStrategy fields to start a new rollout"
+)
+
+// rolloutConfig is the part of the HyperConverged spec that is subject to the canary configuration rollout
+type rolloutConfig struct {
+	Workloads              hcov1beta1.HyperConvergedConfig                 `json:"workloads"`
+	WorkloadUpdateStrategy hcov1beta1.HyperConvergedWorkloadUpdateStrategy `json:"workloadUpdateStrategy"`
+}
+
+// getRolloutConfig returns the rollout configuration of the HyperConverged spec. The maintenance windows are not
+// part of it; HCO evaluates them on its own, and they don't change the KubeVirt configuration.
+func getRolloutConfig(spec *hcov1beta1.HyperConvergedSpec) rolloutConfig {
+	config := rolloutConfig{
+		Workloads:              *spec.Workloads.DeepCopy(),
+		WorkloadUpdateStrategy: *spec.WorkloadUpdateStrategy.DeepCopy(),
+	}
+	config.WorkloadUpdateStrategy.MaintenanceWindows = nil
+
+	return config
+}
+
+// workloadsComponents are the components that their workloads are placed by the workloads node placement, and so are
+// affected by the canary configuration
+var workloadsComponents = []string{"KubeVirt", "CDI", common.ComponentCNAO, "AAQ"}
+
+// reconcileConfigRollout updates the state of the canary configuration rollout, and returns the rollout
+// configuration that should be applied to the operands in the current reconciliation. A nil result means that the
+// configuration from the HyperConverged spec should be applied as is.
+//
+// KubeVirt has no per-node workload update strategy, so the new workload update strategy is held until the canary
+// configuration is promoted, and the stable one is applied to the whole cluster in the meantime.
+func (r *ReconcileHyperConverged) reconcileConfigRollout(req *common.HcoRequest) *rolloutConfig {
+	strategy := req.Instance.Spec.ConfigRolloutStrategy
+	if strategy == nil || strategy.Type != hcov1beta1.ConfigRolloutCanary {
+		if req.Instance.Status.ConfigRollout != nil {
+			req.Logger.Info("the canary configuration rollout is disabled")
+			req.Instance.Status.ConfigRollout = nil
+			req.StatusDirty = true
+		}
+		return nil
+	}
+
+	target := getRolloutConfig(&req.Instance.Spec)
+	targetHash := getRolloutConfigHash(target)
+	status := req.Instance.Status.ConfigRollout
+
+	if status == nil {
+		// nothing to compare with; the current configuration is the stable one
+		req.Instance.Status.ConfigRollout = &hcov1beta1.ConfigRolloutStatus{
+			Phase:                        hcov1beta1.ConfigRolloutPhaseCompleted,
+			StableConfigHash:             targetHash,
+			StableNodePlacement:          target.Workloads.NodePlacement,
+			StableWorkloadUpdateStrategy: &target.WorkloadUpdateStrategy,
+			Message:                      configRolloutCompletedMessage,
+		}
+		req.StatusDirty = true
+		return nil
+	}
+
+	if targetHash == status.StableConfigHash {
+		if status.Phase != hcov1beta1.ConfigRolloutPhaseCompleted {
+			req.Logger.Info("the configuration change was reverted; stopping the configuration rollout")
+			setConfigRolloutCompleted(status)
+			req.StatusDirty = true
+		}
+		return nil
+	}
+
+	if targetHash != status.TargetConfigHash {
+		req.Logger.Info("starting a canary configuration rollout")
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "ConfigRollout", "Applying the new configuration to the canary node pool")
+
+		status.Phase = hcov1beta1.ConfigRolloutPhaseCanary
+		status.TargetConfigHash = targetHash
+		status.CanaryStartTime = ptrToNow()
+		status.CanaryHealthySince = nil
+		status.Message = configRolloutCanaryMessage
+		req.StatusDirty = true
+	}
+
+	nodePlacement := status.StableNodePlacement.DeepCopy()
+	if status.Phase != hcov1beta1.ConfigRolloutPhaseRolledBack {
+		nodePlacement = getCanaryNodePlacement(status.StableNodePlacement, target.Workloads.NodePlacement, strategy.CanaryNodeSelector)
+	}
+
+	return &rolloutConfig{
+		Workloads:              hcov1beta1.HyperConvergedConfig{NodePlacement: nodePlacement},
+		WorkloadUpdateStrategy: getStableWorkloadUpdateStrategy(status, target.WorkloadUpdateStrategy),
+	}
+}
+
+// getStableWorkloadUpdateStrategy returns the workload update strategy of the stable configuration. If it was not
+// recorded, there is no previous value to hold, so the target one is used.
+func getStableWorkloadUpdateStrategy(status *hcov1beta1.ConfigRolloutStatus, target hcov1beta1.HyperConvergedWorkloadUpdateStrategy) hcov1beta1.HyperConvergedWorkloadUpdateStrategy {
+	if status.StableWorkloadUpdateStrategy == nil {
+		return target
+	}
+	return *status.StableWorkloadUpdateStrategy.DeepCopy()
+}
+
+// progressConfigRollout checks the health of the components during the canary phase, and then promotes the new
+// configuration to the whole cluster, or rolls it back. It returns the time to wait for the next check.
+func (r *ReconcileHyperConverged) progressConfigRollout(req *common.HcoRequest, allComponentsAreUp bool) time.Duration {
+	status := req.Instance.Status.ConfigRollout
+	if status == nil || status.Phase != hcov1beta1.ConfigRolloutPhaseCanary || req.UpgradeMode {
+		return 0
+	}
+
+	strategy := req.Instance.Spec.ConfigRolloutStrategy
+	bakeTime := getDurationOrDefault(strategy.BakeTime, defaultCanaryBakeTime)
+	progressDeadline := getDurationOrDefault(strategy.ProgressDeadline, defaultCanaryProgressDeadline)
+
+	if degraded := getNewlyDegradedComponents(req, status.CanaryStartTime); len(degraded) > 0 {
+		r.rollbackConfig(req, status, fmt.Sprintf(configRolloutDegradedMessage, strings.Join(degraded, ", ")))
+		return requeueAfter
+	}
+
+	if !allComponentsAreUp {
+		if status.CanaryHealthySince != nil {
+			status.CanaryHealthySince = nil
+			req.StatusDirty = true
+		}
+
+		if time.Since(status.CanaryStartTime.Time) >= progressDeadline {
+			r.rollbackConfig(req, status, fmt.Sprintf(configRolloutDeadlineMessage, progressDeadline))
+			return requeueAfter
+		}
+
+		return canaryHealthCheckInterval
+	}
+
+	if status.CanaryHealthySince == nil {
+		status.CanaryHealthySince = ptrToNow()
+		req.StatusDirty = true
+	}
+
+	if remaining := bakeTime - time.Since(status.CanaryHealthySince.Time); remaining > 0 {
+		return remaining
+	}
+
+	req.Logger.Info("the canary configuration is healthy; applying it to the whole cluster")
+	r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "ConfigRollout", "Applying the new configuration to the whole cluster")
+
+	stable := getRolloutConfig(&req.Instance.Spec)
+	status.StableConfigHash = status.TargetConfigHash
+	status.StableNodePlacement = stable.Workloads.NodePlacement
+	status.StableWorkloadUpdateStrategy = &stable.WorkloadUpdateStrategy
+	setConfigRolloutCompleted(status)
+	req.StatusDirty = true
+
+	return requeueAfter
+}
+
+// getNewlyDegradedComponents returns the components that are affected by the canary configuration, and that became
+// degraded after the canary phase started. A component that was already degraded before, is not attributed to the
+// new configuration; it delays the promotion until the progress deadline instead.
+func getNewlyDegradedComponents(req *common.HcoRequest, canaryStartTime *metav1.Time) []string {
+	var degraded []string
+	for _, component := range workloadsComponents {
+		conds, found := req.ComponentConditions[component]
+		if !found || !conds.IsStatusConditionTrue(hcov1beta1.ConditionDegraded) {
+			continue
+		}
+
+		if wasDegradedBefore(req.Instance.Status.ComponentsHealth, component, canaryStartTime) {
+			continue
+		}
+
+		degraded = append(degraded, component)
+	}
+
+	return degraded
+}
+
+func wasDegradedBefore(componentsHealth []hcov1beta1.ComponentHealth, component string, t *metav1.Time) bool {
+	idx := slices.IndexFunc(componentsHealth, func(ch hcov1beta1.ComponentHealth) bool {
+		return ch.Name == component
+	})
+	if idx < 0 {
+		return false
+	}
+
+	cond := apimetav1.FindStatusCondition(componentsHealth[idx].Conditions, hcov1beta1.ConditionDegraded)
+	return cond != nil && cond.Status == metav1.ConditionTrue && cond.LastTransitionTime.Before(t)
+}
+
+func (r *ReconcileHyperConverged) rollbackConfig(req *common.HcoRequest, status *hcov1beta1.ConfigRolloutStatus, message string) {
+	req.Logger.Info("rolling back the canary configuration", "reason", message)
+	r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "ConfigRollout", message)
+
+	status.Phase = hcov1beta1.ConfigRolloutPhaseRolledBack
+	status.CanaryStartTime = nil
+	status.CanaryHealthySince = nil
+	status.Message = message + configRolloutRolledBackWarning
+	req.StatusDirty = true
+}
+
+func setConfigRolloutCompleted(status *hcov1beta1.ConfigRolloutStatus) {
+	status.Phase = hcov1beta1.ConfigRolloutPhaseCompleted
+	status.TargetConfigHash = ""
+	status.CanaryStartTime = nil
+	status.CanaryHealthySince = nil
+	status.Message = configRolloutCompletedMessage
+}
+
+// withRolloutConfig replaces the workloads and the workload update strategy fields of the HyperConverged CR with the
+// configuration of the current rollout phase, for the operands rendering. The maintenance windows are kept as is. The
+// returned function restores the original fields, so they won't be written back to the HyperConverged CR.
+func withRolloutConfig(hc *hcov1beta1.HyperConverged, config *rolloutConfig) func() {
+	if config == nil {
+		return func() {}
+	}
+
+	origWorkloads := hc.Spec.Workloads
+	origStrategy := hc.Spec.WorkloadUpdateStrategy
+
+	hc.Spec.Workloads = config.Workloads
+	hc.Spec.WorkloadUpdateStrategy = config.WorkloadUpdateStrategy
+	hc.Spec.WorkloadUpdateStrategy.MaintenanceWindows = origStrategy.MaintenanceWindows
+
+	return func() {
+		hc.Spec.Workloads = origWorkloads
+		hc.Spec.WorkloadUpdateStrategy = origStrategy
+	}
+}
+
+func (r *ReconcileHyperConverged) detectConfigRollout(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	status := req.Instance.Status.ConfigRollout
+	if status == nil {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionConfigRollout)
+		return
+	}
+
+	cond := metav1.Condition{
+		Type:               hcov1beta1.ConditionConfigRollout,
+		Status:             metav1.ConditionFalse,
+		Reason:             configRolloutCompletedReason,
+		Message:            status.Message,
+		ObservedGeneration: req.Instance.Generation,
+	}
+
+	switch status.Phase {
+	case hcov1beta1.ConfigRolloutPhaseCanary:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = configRolloutCanaryReason
+	case hcov1beta1.ConfigRolloutPhaseRolledBack:
+		cond.Reason = configRolloutRolledBackReason
+	}
+
+	apimetav1.SetStatusCondition(conditions, cond)
+}
+
+// getRolloutConfigHash returns a hash of the rollout configuration, to detect its modifications without keeping
+// the whole configuration in the status
+func getRolloutConfigHash(config rolloutConfig) string {
+	// can't fail; the configuration is built of plain serializable fields
+	data, _ := json.Marshal(config)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// getCanaryNodePlacement merges the stable and the target node placements into a single node placement, by using
+// required node affinity terms. The stable node placement terms are limited to the nodes out of the canary node pool,
+// and the target node placement terms are limited to the canary node pool.
+//
+// The preferred node affinity and the pod affinities are taken from the target node placement, and the tolerations
+// of both node placements are allowed.
+func getCanaryNodePlacement(stable, target *sdkapi.NodePlacement, canaryNodeSelector map[string]string) *sdkapi.NodePlacement {
+	if equality.Semantic.DeepEqual(stable, target) {
+		return target.DeepCopy()
+	}
+
+	canaryKeys := slices.Sorted(maps.Keys(canaryNodeSelector))
+
+	var terms []corev1.NodeSelectorTerm
+	for _, term := range getNodeSelectorTerms(stable) {
+		// a node is out of the canary node pool, if any of the canary labels does not match
+		for _, key := range canaryKeys {
+			nonCanaryTerm := *term.DeepCopy()
+			nonCanaryTerm.MatchExpressions = append(nonCanaryTerm.MatchExpressions, corev1.NodeSelectorRequirement{
+				Key:      key,
+				Operator: corev1.NodeSelectorOpNotIn,
+				Values:   []string{canaryNodeSelector[key]},
+			})
+			terms = append(terms, nonCanaryTerm)
+		}
+	}
+
+	for _, term := range getNodeSelectorTerms(target) {
+		for _, key := range canaryKeys {
+			term.MatchExpressions = append(term.MatchExpressions, corev1.NodeSelectorRequirement{
+				Key:      key,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{canaryNodeSelector[key]},
+			})
+		}
+		terms = append(terms, term)
+	}
+
+	placement := &sdkapi.NodePlacement{
+		Affinity: &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: terms,
+				},
+			},
+		},
+	}
+
+	if target != nil {
+		if target.Affinity != nil {
+			if target.Affinity.NodeAffinity != nil {
+				placement.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = slices.Clone(target.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+			}
+			placement.Affinity.PodAffinity = target.Affinity.PodAffinity.DeepCopy()
+			placement.Affinity.PodAntiAffinity = target.Affinity.PodAntiAffinity.DeepCopy()
+		}
+		placement.Tolerations = slices.Clone(target.Tolerations)
+	}
+
+	if stable != nil {
+		for _, toleration := range stable.Tolerations {
+			if !slices.ContainsFunc(placement.Tolerations, func(t corev1.Toleration) bool { return t.MatchToleration(&toleration) }) {
+				placement.Tolerations = append(placement.Tolerations, toleration)
+			}
+		}
+	}
+
+	return placement
+}
+
+// getNodeSelectorTerms returns the required node affinity terms of the node placement, with its node selector, as
+// match expressions of each one of the terms.
+func getNodeSelectorTerms(placement *sdkapi.NodePlacement) []corev1.NodeSelectorTerm {
+	if placement == nil {
+		return []corev1.NodeSelectorTerm{{}}
+	}
+
+	var terms []corev1.NodeSelectorTerm
+	if placement.Affinity != nil && placement.Affinity.NodeAffinity != nil && placement.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		for _, term := range placement.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+			terms = append(terms, *term.DeepCopy())
+		}
+	}
+
+	if len(terms) == 0 {
+		terms = []corev1.NodeSelectorTerm{{}}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(placement.NodeSelector)) {
+		for i := range terms {
+			terms[i].MatchExpressions = append(terms[i].MatchExpressions, corev1.NodeSelectorRequirement{
+				Key:      key,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{placement.NodeSelector[key]},
+			})
+		}
+	}
+
+	return terms
+}
+
+func getDurationOrDefault(d *metav1.Duration, defaultValue time.Duration) time.Duration {
+	if d == nil {
+		return defaultValue
+	}
+	return d.Duration
+}
+
+func ptrToNow() *metav1.Time {
+	now := metav1.Now()
+	return &now
+}
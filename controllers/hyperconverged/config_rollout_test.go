@@ -0,0 +1,346 @@
+package hyperconverged
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("canary configuration rollout", func() {
+	canaryNodeSelector := map[string]string{"example.com/canary": "true"}
+
+	var (
+		r   *ReconcileHyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hc := commontestutils.NewHco()
+		hc.Spec.ConfigRolloutStrategy = &hcov1beta1.ConfigRolloutStrategy{
+			Type:               hcov1beta1.ConfigRolloutCanary,
+			CanaryNodeSelector: canaryNodeSelector,
+			BakeTime:           &metav1.Duration{Duration: 10 * time.Minute},
+			ProgressDeadline:   &metav1.Duration{Duration: 30 * time.Minute},
+		}
+		hc.Spec.Workloads.NodePlacement = &sdkapi.NodePlacement{
+			NodeSelector: map[string]string{"pool": "old"},
+		}
+
+		req = commontestutils.NewReq(hc)
+		r = initReconciler(commontestutils.InitClient(nil), nil)
+	})
+
+	degradedConditions := []metav1.Condition{
+		{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionFalse, Reason: "Degraded"},
+		{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionTrue, Reason: "Degraded"},
+	}
+
+	startCanary := func() {
+		GinkgoHelper()
+		Expect(r.reconcileConfigRollout(req)).To(BeNil())
+
+		req.Instance.Spec.Workloads.NodePlacement = &sdkapi.NodePlacement{
+			NodeSelector: map[string]string{"pool": "new"},
+		}
+		req.Instance.Spec.WorkloadUpdateStrategy.BatchEvictionSize = ptr.To(20)
+
+		Expect(r.reconcileConfigRollout(req)).ToNot(BeNil())
+		Expect(req.Instance.Status.ConfigRollout.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseCanary))
+		req.StatusDirty = false
+	}
+
+	Context("reconcileConfigRollout", func() {
+		It("should not do anything if the canary rollout is not used", func() {
+			req.Instance.Spec.ConfigRolloutStrategy = nil
+
+			Expect(r.reconcileConfigRollout(req)).To(BeNil())
+			Expect(req.Instance.Status.ConfigRollout).To(BeNil())
+			Expect(req.StatusDirty).To(BeFalse())
+		})
+
+		It("should drop the rollout state when the canary rollout is disabled", func() {
+			startCanary()
+			req.Instance.Spec.ConfigRolloutStrategy.Type = hcov1beta1.ConfigRolloutImmediate
+
+			Expect(r.reconcileConfigRollout(req)).To(BeNil())
+			Expect(req.Instance.Status.ConfigRollout).To(BeNil())
+			Expect(req.StatusDirty).To(BeTrue())
+		})
+
+		It("should use the current configuration as the stable one, on the first time", func() {
+			Expect(r.reconcileConfigRollout(req)).To(BeNil())
+
+			status := req.Instance.Status.ConfigRollout
+			Expect(status).ToNot(BeNil())
+			Expect(status.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseCompleted))
+			Expect(status.StableNodePlacement.NodeSelector).To(HaveKeyWithValue("pool", "old"))
+			Expect(status.StableConfigHash).To(Equal(getRolloutConfigHash(getRolloutConfig(&req.Instance.Spec))))
+			Expect(status.TargetConfigHash).To(BeEmpty())
+		})
+
+		It("should apply the new node placement to the canary node pool", func() {
+			Expect(r.reconcileConfigRollout(req)).To(BeNil())
+
+			req.Instance.Spec.Workloads.NodePlacement.NodeSelector["pool"] = "new"
+
+			config := r.reconcileConfigRollout(req)
+			Expect(config).ToNot(BeNil())
+
+			status := req.Instance.Status.ConfigRollout
+			Expect(status.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseCanary))
+			Expect(status.CanaryStartTime).ToNot(BeNil())
+			Expect(status.TargetConfigHash).To(Equal(getRolloutConfigHash(getRolloutConfig(&req.Instance.Spec))))
+			Expect(status.StableNodePlacement.NodeSelector).To(HaveKeyWithValue("pool", "old"))
+
+			Expect(config.Workloads.NodePlacement.NodeSelector).To(BeEmpty())
+			Expect(config.Workloads.NodePlacement.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(HaveLen(2))
+
+			Expect(r.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents([]commontestutils.MockEvent{
+				{EventType: corev1.EventTypeNormal, Reason: "ConfigRollout", Msg: "Applying the new configuration to the canary node pool"},
+			})).To(BeTrue())
+		})
+
+		It("should hold a new workloadUpdateStrategy during the canary phase", func() {
+			req.Instance.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{"LiveMigrate"}
+			Expect(r.reconcileConfigRollout(req)).To(BeNil())
+			Expect(req.Instance.Status.ConfigRollout.StableWorkloadUpdateStrategy.WorkloadUpdateMethods).To(Equal([]string{"LiveMigrate"}))
+
+			req.Instance.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{"LiveMigrate", "Evict"}
+			req.Instance.Spec.WorkloadUpdateStrategy.BatchEvictionSize = ptr.To(20)
+
+			config := r.reconcileConfigRollout(req)
+			Expect(config).ToNot(BeNil())
+			Expect(req.Instance.Status.ConfigRollout.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseCanary))
+			Expect(config.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(Equal([]string{"LiveMigrate"}))
+			Expect(config.WorkloadUpdateStrategy.BatchEvictionSize).ToNot(HaveValue(Equal(20)))
+			Expect(config.Workloads.NodePlacement.NodeSelector).To(HaveKeyWithValue("pool", "old"))
+		})
+
+		It("should not roll out a maintenanceWindows change", func() {
+			Expect(r.reconcileConfigRollout(req)).To(BeNil())
+			req.StatusDirty = false
+
+			req.Instance.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []hcov1beta1.MaintenanceWindow{
+				{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+			}
+
+			Expect(r.reconcileConfigRollout(req)).To(BeNil())
+			Expect(req.Instance.Status.ConfigRollout.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseCompleted))
+			Expect(req.StatusDirty).To(BeFalse())
+		})
+
+		It("should complete the rollout if the change was reverted", func() {
+			startCanary()
+
+			req.Instance.Spec.Workloads.NodePlacement.NodeSelector["pool"] = "old"
+			req.Instance.Spec.WorkloadUpdateStrategy.BatchEvictionSize = ptr.To(10)
+
+			Expect(r.reconcileConfigRollout(req)).To(BeNil())
+			Expect(req.Instance.Status.ConfigRollout.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseCompleted))
+			Expect(req.Instance.Status.ConfigRollout.TargetConfigHash).To(BeEmpty())
+		})
+
+		It("should keep the stable configuration after a rollback", func() {
+			startCanary()
+			req.Instance.Status.ConfigRollout.Phase = hcov1beta1.ConfigRolloutPhaseRolledBack
+
+			config := r.reconcileConfigRollout(req)
+			Expect(config).ToNot(BeNil())
+			Expect(config.Workloads.NodePlacement.NodeSelector).To(HaveKeyWithValue("pool", "old"))
+			Expect(config.WorkloadUpdateStrategy.BatchEvictionSize).ToNot(HaveValue(Equal(20)))
+			Expect(req.Instance.Status.ConfigRollout.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseRolledBack))
+		})
+	})
+
+	Context("progressConfigRollout", func() {
+		It("should wait for the bake time", func() {
+			startCanary()
+
+			requeue := r.progressConfigRollout(req, true)
+			Expect(requeue).To(BeNumerically(">", 9*time.Minute))
+			Expect(req.Instance.Status.ConfigRollout.CanaryHealthySince).ToNot(BeNil())
+			Expect(req.Instance.Status.ConfigRollout.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseCanary))
+		})
+
+		It("should promote the new configuration after the bake time", func() {
+			startCanary()
+			req.Instance.Status.ConfigRollout.CanaryHealthySince = &metav1.Time{Time: time.Now().Add(-11 * time.Minute)}
+
+			Expect(r.progressConfigRollout(req, true)).To(Equal(requeueAfter))
+
+			status := req.Instance.Status.ConfigRollout
+			Expect(status.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseCompleted))
+			Expect(status.StableNodePlacement.NodeSelector).To(HaveKeyWithValue("pool", "new"))
+			Expect(status.StableWorkloadUpdateStrategy.BatchEvictionSize).To(HaveValue(Equal(20)))
+			Expect(status.StableConfigHash).To(Equal(getRolloutConfigHash(getRolloutConfig(&req.Instance.Spec))))
+			Expect(status.TargetConfigHash).To(BeEmpty())
+
+			Expect(r.reconcileConfigRollout(req)).To(BeNil())
+		})
+
+		It("should roll back if a workloads component became degraded during the canary phase", func() {
+			startCanary()
+			req.ComponentConditions.SetComponentConditions("KubeVirt", degradedConditions)
+
+			Expect(r.progressConfigRollout(req, false)).To(Equal(requeueAfter))
+			Expect(req.Instance.Status.ConfigRollout.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseRolledBack))
+			Expect(req.Instance.Status.ConfigRollout.Message).To(ContainSubstring("because KubeVirt became degraded"))
+		})
+
+		It("should not roll back if the component was already degraded before the canary phase", func() {
+			startCanary()
+			req.ComponentConditions.SetComponentConditions("KubeVirt", degradedConditions)
+			req.Instance.Status.ComponentsHealth = []hcov1beta1.ComponentHealth{{
+				Name: "KubeVirt",
+				Conditions: []metav1.Condition{{
+					Type:               hcov1beta1.ConditionDegraded,
+					Status:             metav1.ConditionTrue,
+					Reason:             "Degraded",
+					LastTransitionTime: metav1.NewTime(req.Instance.Status.ConfigRollout.CanaryStartTime.Add(-time.Minute)),
+				}},
+			}}
+
+			Expect(r.progressConfigRollout(req, false)).To(Equal(canaryHealthCheckInterval))
+			Expect(req.Instance.Status.ConfigRollout.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseCanary))
+		})
+
+		It("should not roll back if a component that is not affected by the workloads configuration is degraded", func() {
+			startCanary()
+			req.ComponentConditions.SetComponentConditions("SSP", degradedConditions)
+
+			Expect(r.progressConfigRollout(req, false)).To(Equal(canaryHealthCheckInterval))
+			Expect(req.Instance.Status.ConfigRollout.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseCanary))
+		})
+
+		It("should roll back if the components are not healthy before the progress deadline", func() {
+			startCanary()
+			req.Instance.Status.ConfigRollout.CanaryStartTime = &metav1.Time{Time: time.Now().Add(-31 * time.Minute)}
+
+			Expect(r.progressConfigRollout(req, false)).To(Equal(requeueAfter))
+			Expect(req.Instance.Status.ConfigRollout.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseRolledBack))
+		})
+
+		It("should keep waiting while the components are progressing", func() {
+			startCanary()
+
+			Expect(r.progressConfigRollout(req, false)).To(Equal(canaryHealthCheckInterval))
+			Expect(req.Instance.Status.ConfigRollout.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseCanary))
+		})
+
+		It("should not progress the rollout during upgrade", func() {
+			startCanary()
+			req.SetUpgradeMode(true)
+			req.Instance.Status.ConfigRollout.CanaryHealthySince = &metav1.Time{Time: time.Now().Add(-11 * time.Minute)}
+
+			Expect(r.progressConfigRollout(req, true)).To(BeZero())
+			Expect(req.Instance.Status.ConfigRollout.Phase).To(Equal(hcov1beta1.ConfigRolloutPhaseCanary))
+		})
+	})
+
+	Context("detectConfigRollout", func() {
+		It("should set the ConfigRollout condition according to the rollout phase", func() {
+			var conditions []metav1.Condition
+
+			r.detectConfigRollout(req, &conditions)
+			Expect(conditions).To(BeEmpty())
+
+			startCanary()
+			r.detectConfigRollout(req, &conditions)
+			cond := apimetav1.FindStatusCondition(conditions, hcov1beta1.ConditionConfigRollout)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(configRolloutCanaryReason))
+
+			req.Instance.Status.ConfigRollout.Phase = hcov1beta1.ConfigRolloutPhaseRolledBack
+			r.detectConfigRollout(req, &conditions)
+			cond = apimetav1.FindStatusCondition(conditions, hcov1beta1.ConditionConfigRollout)
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(configRolloutRolledBackReason))
+
+			req.Instance.Status.ConfigRollout = nil
+			r.detectConfigRollout(req, &conditions)
+			Expect(apimetav1.FindStatusCondition(conditions, hcov1beta1.ConditionConfigRollout)).To(BeNil())
+		})
+	})
+
+	Context("withRolloutConfig", func() {
+		It("should replace the rollout fields, and then restore them", func() {
+			startCanary()
+			config := r.reconcileConfigRollout(req)
+
+			req.Instance.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []hcov1beta1.MaintenanceWindow{
+				{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+			}
+
+			restore := withRolloutConfig(req.Instance, config)
+			Expect(req.Instance.Spec.Workloads).To(Equal(config.Workloads))
+			Expect(req.Instance.Spec.WorkloadUpdateStrategy.BatchEvictionSize).ToNot(HaveValue(Equal(20)))
+			Expect(req.Instance.Spec.WorkloadUpdateStrategy.MaintenanceWindows).To(HaveLen(1))
+
+			restore()
+			Expect(req.Instance.Spec.Workloads.NodePlacement.NodeSelector).To(HaveKeyWithValue("pool", "new"))
+			Expect(req.Instance.Spec.WorkloadUpdateStrategy.BatchEvictionSize).To(HaveValue(Equal(20)))
+		})
+	})
+
+	Context("getCanaryNodePlacement", func() {
+		It("should limit the stable placement to the non-canary nodes, and the target placement to the canary nodes", func() {
+			stable := &sdkapi.NodePlacement{
+				NodeSelector: map[string]string{"pool": "old"},
+				Tolerations:  []corev1.Toleration{{Key: "old", Operator: corev1.TolerationOpExists}},
+			}
+			target := &sdkapi.NodePlacement{
+				NodeSelector: map[string]string{"pool": "new"},
+				Tolerations:  []corev1.Toleration{{Key: "new", Operator: corev1.TolerationOpExists}},
+			}
+
+			placement := getCanaryNodePlacement(stable, target, canaryNodeSelector)
+
+			Expect(placement.NodeSelector).To(BeEmpty())
+			Expect(placement.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(Equal([]corev1.NodeSelectorTerm{
+				{
+					MatchExpressions: []corev1.NodeSelectorRequirement{
+						{Key: "pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"old"}},
+						{Key: "example.com/canary", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"true"}},
+					},
+				},
+				{
+					MatchExpressions: []corev1.NodeSelectorRequirement{
+						{Key: "pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"new"}},
+						{Key: "example.com/canary", Operator: corev1.NodeSelectorOpIn, Values: []string{"true"}},
+					},
+				},
+			}))
+			Expect(placement.Tolerations).To(ConsistOf(target.Tolerations[0], stable.Tolerations[0]))
+		})
+
+		It("should allow all the non-canary nodes, if there was no stable placement", func() {
+			target := &sdkapi.NodePlacement{NodeSelector: map[string]string{"pool": "new"}}
+
+			placement := getCanaryNodePlacement(nil, target, canaryNodeSelector)
+
+			terms := placement.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			Expect(terms).To(HaveLen(2))
+			Expect(terms[0].MatchExpressions).To(Equal([]corev1.NodeSelectorRequirement{
+				{Key: "example.com/canary", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"true"}},
+			}))
+		})
+
+		It("should return the target placement if it was not changed", func() {
+			target := &sdkapi.NodePlacement{NodeSelector: map[string]string{"pool": "new"}}
+			Expect(getCanaryNodePlacement(target.DeepCopy(), target, canaryNodeSelector)).To(Equal(target))
+		})
+	})
+})
@@ -4,9 +4,11 @@ import (
 	"cmp"
 	"context"
 	"fmt"
+	"maps"
 	"os"
 	"reflect"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/blang/semver/v4"
@@ -45,6 +47,7 @@ import (
 
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	sspv1beta3 "kubevirt.io/ssp-operator/api/v1beta3"
@@ -52,6 +55,7 @@ import (
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/alerts"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operandhandler"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/reqresolver"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
@@ -82,6 +86,10 @@ const (
 	commonProgressingReason     = "HCOProgressing"
 	taintedConfigurationReason  = "UnsupportedFeatureAnnotation"
 	taintedConfigurationMessage = "Unsupported feature was activated via an HCO annotation"
+	unmanagedOperandsReason     = "UnmanagedOperands"
+	unmanagedOperandsMessage    = "The following operands are not reconciled by HCO: "
+	unmanagedOperandReasonFmt   = "%sUnmanaged"
+	notUpgradeableUnmanagedMsg  = "HCO is not Upgradeable while some operands are unmanaged: "
 	systemHealthStatusHealthy   = "healthy"
 	systemHealthStatusWarning   = "warning"
 	systemHealthStatusError     = "error"
@@ -91,6 +99,16 @@ const (
 	requestedStatusKey = "requested status"
 
 	requeueAfter = time.Millisecond * 100
+
+	// the requeue reasons, for the kubevirt_hco_reconcile_requeues_total metric
+	requeueReasonError             = "error"
+	requeueReasonInitialization    = "initialization"
+	requeueReasonUpgrade           = "upgrade"
+	requeueReasonOperandError      = "operand_error"
+	requeueReasonConfigRollout     = "config_rollout"
+	requeueReasonMaintenanceWindow = "maintenance_window"
+	requeueReasonStatusUpdate      = "status_update"
+	requeueReasonConflict          = "conflict"
 )
 
 // JSONPatchAnnotationNames - annotations used to patch operand CRs with unsupported/unofficial/hidden features.
@@ -107,9 +125,11 @@ func RegisterReconciler(mgr manager.Manager,
 	ci hcoutil.ClusterInfo,
 	upgradeableCond hcoutil.Condition,
 	ingressEventCh <-chan event.GenericEvent,
-	nodeEventChannel <-chan event.GenericEvent) error {
+	nodeEventChannel <-chan event.GenericEvent,
+	dictCatalogEventChannel <-chan event.GenericEvent,
+	dictArchEventChannel <-chan event.GenericEvent) error {
 
-	return add(mgr, newReconciler(mgr, ci, upgradeableCond), ci, ingressEventCh, nodeEventChannel)
+	return add(mgr, newReconciler(mgr, ci, upgradeableCond), ci, ingressEventCh, nodeEventChannel, dictCatalogEventChannel, dictArchEventChannel)
 }
 
 // newReconciler returns a new reconcile.Reconciler
@@ -136,7 +156,7 @@ func newReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo, upgradeableCond
 }
 
 // newCRDremover returns a new CRDRemover
-func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, ingressEventCh <-chan event.GenericEvent, nodeEventChannel <-chan event.GenericEvent) error {
+func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, ingressEventCh <-chan event.GenericEvent, nodeEventChannel <-chan event.GenericEvent, dictCatalogEventChannel <-chan event.GenericEvent, dictArchEventChannel <-chan event.GenericEvent) error {
 	// Create a new controller
 	c, err := controller.New("hyperconverged-controller", mgr, controller.Options{Reconciler: r})
 	if err != nil {
@@ -194,6 +214,8 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, in
 			&corev1.Namespace{},
 			&appsv1.Deployment{},
 			&securityv1.SecurityContextConstraints{},
+			&cdiv1beta1.DataImportCron{},
+			&cdiv1beta1.DataSource{},
 		}...)
 	}
 
@@ -203,6 +225,12 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, in
 		}...)
 	}
 
+	if ci.IsMigrationPolicyAvailable() {
+		secondaryResources = append(secondaryResources, []client.Object{
+			&migrationsv1alpha1.MigrationPolicy{},
+		}...)
+	}
+
 	// Watch secondary resources
 	for _, resource := range secondaryResources {
 		msg := fmt.Sprintf("Reconciling for %T", resource)
@@ -275,6 +303,40 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, in
 		if err != nil {
 			return err
 		}
+
+		err = c.Watch(
+			source.Channel(
+				dictCatalogEventChannel,
+				handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+					// the DataImportCronTemplate catalog controller initiate this by pushing an event to the
+					// dictCatalogEventChannel channel. This will force this controller to re-generate the
+					// DataImportCronTemplates in the SSP CR.
+					log.Info("Reconciling for a DataImportCronTemplate catalog change")
+					return []reconcile.Request{
+						reqresolver.GetDictCatalogResource(),
+					}
+				}),
+			))
+		if err != nil {
+			return err
+		}
+
+		err = c.Watch(
+			source.Channel(
+				dictArchEventChannel,
+				handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+					// the DataImportCronTemplate architecture resolver initiate this by pushing an event to the
+					// dictArchEventChannel channel, when it completes a resolution. This will force this controller
+					// to re-generate the DataImportCronTemplates in the SSP CR.
+					log.Info("Reconciling for a DataImportCronTemplate architecture resolution")
+					return []reconcile.Request{
+						reqresolver.GetDictArchResource(),
+					}
+				}),
+			))
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -295,6 +357,8 @@ type ReconcileHyperConverged struct {
 	firstLoop            bool
 	upgradeableCondition hcoutil.Condition
 	monitoringReconciler *alerts.MonitoringReconciler
+	// workloadUpdatesHeld is true if the operands were last rendered outside the maintenance windows
+	workloadUpdatesHeld bool
 }
 
 // Reconcile reads that state of the cluster for a HyperConverged object and makes changes based on the state read
@@ -346,24 +410,55 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, err
 	}
 
+	reconcileStart := time.Now()
 	result, err := r.doReconcile(hcoRequest)
+	metrics.ObserveReconcileDuration(time.Since(reconcileStart), getReconcileResult(result, err))
 	if err != nil {
 		r.eventEmitter.EmitEvent(hcoRequest.Instance, corev1.EventTypeWarning, "ReconcileError", err.Error())
+		metrics.IncReconcileRequeues(requeueReasonError)
 		return result, err
 	}
 
 	if err = r.setOperatorUpgradeableStatus(hcoRequest); err != nil {
+		metrics.IncReconcileRequeues(requeueReasonError)
 		return reconcile.Result{}, err
 	}
 
 	requeue, err := r.updateHyperConverged(hcoRequest)
-	if requeue || apierrors.IsConflict(err) {
+	if apierrors.IsConflict(err) {
+		hcoRequest.RequeueReason = requeueReasonConflict
+		result.RequeueAfter = requeueAfter
+	} else if requeue {
+		hcoRequest.RequeueReason = requeueReasonStatusUpdate
 		result.RequeueAfter = requeueAfter
 	}
 
+	countRequeue(hcoRequest, result, err)
+
 	return result, err
 }
 
+func getReconcileResult(result reconcile.Result, err error) string {
+	switch {
+	case err != nil:
+		return metrics.ReconcileResultError
+	case result.RequeueAfter > 0:
+		return metrics.ReconcileResultRequeue
+	default:
+		return metrics.ReconcileResultSuccess
+	}
+}
+
+// countRequeue updates the requeue counter, if the request is requeued
+func countRequeue(req *common.HcoRequest, result reconcile.Result, err error) {
+	switch {
+	case err != nil && !apierrors.IsConflict(err):
+		metrics.IncReconcileRequeues(requeueReasonError)
+	case result.RequeueAfter > 0 && req.RequeueReason != "":
+		metrics.IncReconcileRequeues(req.RequeueReason)
+	}
+}
+
 // refreshAPIServerCR refreshes the APIServer cR, if the request is triggered by this CR.
 func (r *ReconcileHyperConverged) refreshAPIServerCR(ctx context.Context, logger logr.Logger, originalRequest reconcile.Request) error {
 	if reqresolver.IsTriggeredByAPIServerCR(originalRequest) {
@@ -442,16 +537,36 @@ func (r *ReconcileHyperConverged) handleUpgrade(req *common.HcoRequest) (*reconc
 
 	if modified {
 		r.updateConditions(req)
+		req.RequeueReason = requeueReasonUpgrade
 		return &reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
 	return nil, nil
 }
 
 func (r *ReconcileHyperConverged) EnsureOperandAndComplete(req *common.HcoRequest, init bool) (reconcile.Result, error) {
-	if err := r.operandHandler.Ensure(req); err != nil {
+	windowState := getMaintenanceWindowState(req.Instance, time.Now())
+	if held := windowState.workloadUpdatesHeld(); held != r.workloadUpdatesHeld {
+		// the cached operands were rendered with the workload update methods of the previous window state
+		r.operandHandler.Reset()
+		r.workloadUpdatesHeld = held
+	}
+
+	restoreSpec := withRolloutConfig(req.Instance, r.reconcileConfigRollout(req))
+	restoreMethods := withMaintenanceWindowState(req.Instance, windowState)
+	err := r.operandHandler.Ensure(req)
+	if err == nil {
+		// the rollout configuration is still applied here, so the metrics report what was actually pushed to the
+		// operands
+		handlers.UpdateEffectiveConfigMetrics(req.Instance)
+	}
+	restoreMethods()
+	restoreSpec()
+
+	if err != nil {
 		r.updateConditions(req)
 		requeue := time.Duration(0)
 		if init {
+			req.RequeueReason = requeueReasonOperandError
 			requeue = requeueAfter
 		}
 		return reconcile.Result{RequeueAfter: requeue}, nil
@@ -461,12 +576,13 @@ func (r *ReconcileHyperConverged) EnsureOperandAndComplete(req *common.HcoReques
 
 	// Requeue if we just created everything
 	if init {
+		req.RequeueReason = requeueReasonInitialization
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
 
-	r.completeReconciliation(req)
+	requeue := r.completeReconciliation(req, windowState)
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: requeue}, nil
 }
 
 func updateStatus(req *common.HcoRequest) {
@@ -870,8 +986,18 @@ func (r *ReconcileHyperConverged) aggregateComponentConditions(req *common.HcoRe
 	return allComponentsAreUp
 }
 
-func (r *ReconcileHyperConverged) completeReconciliation(req *common.HcoRequest) {
+func (r *ReconcileHyperConverged) completeReconciliation(req *common.HcoRequest, windowState maintenanceWindowState) time.Duration {
 	allComponentsAreUp := r.aggregateComponentConditions(req)
+	holdUpgradesWhileOperandsUnmanaged(req)
+
+	rolloutRequeue := r.progressConfigRollout(req, allComponentsAreUp)
+	requeue := minRequeue(rolloutRequeue, r.checkMaintenanceWindows(req, windowState))
+	if requeue > 0 {
+		req.RequeueReason = requeueReasonMaintenanceWindow
+		if requeue == rolloutRequeue {
+			req.RequeueReason = requeueReasonConfigRollout
+		}
+	}
 
 	hcoReady := false
 
@@ -923,7 +1049,55 @@ func (r *ReconcileHyperConverged) completeReconciliation(req *common.HcoRequest)
 		}
 	}
 
+	updateComponentsHealth(req)
+
 	r.updateConditions(req)
+
+	return requeue
+}
+
+// updateComponentsHealth updates the componentsHealth status field and the component health metric, from the
+// conditions that the components reported in this reconciliation. It is only called when all the operands were
+// reconciled, so the components that were not reported, are no longer deployed.
+func updateComponentsHealth(req *common.HcoRequest) {
+	var componentsHealth []hcov1beta1.ComponentHealth
+	healthStatuses := make(map[string]float64, len(req.ComponentConditions))
+
+	for _, component := range slices.Sorted(maps.Keys(req.ComponentConditions)) {
+		componentConds := req.ComponentConditions[component]
+
+		// keep the transition time of the conditions that were not changed
+		var conditions []metav1.Condition
+		if idx := slices.IndexFunc(req.Instance.Status.ComponentsHealth, func(ch hcov1beta1.ComponentHealth) bool {
+			return ch.Name == component
+		}); idx >= 0 {
+			conditions = slices.Clone(req.Instance.Status.ComponentsHealth[idx].Conditions)
+		}
+
+		for _, condType := range common.ComponentConditionTypes {
+			if cond, found := componentConds.GetCondition(condType); found {
+				cond.ObservedGeneration = req.Instance.Generation
+				apimetav1.SetStatusCondition(&conditions, cond)
+			} else {
+				apimetav1.RemoveStatusCondition(&conditions, condType)
+			}
+		}
+
+		healthStatus := getComponentHealthStatus(componentConds)
+		componentsHealth = append(componentsHealth, hcov1beta1.ComponentHealth{
+			Name:         component,
+			HealthStatus: healthStatus,
+			Conditions:   conditions,
+		})
+		healthStatuses[component] = getNumericalHealthStatus(healthStatus)
+	}
+
+	if !reflect.DeepEqual(componentsHealth, req.Instance.Status.ComponentsHealth) {
+		req.Instance.Status.ComponentsHealth = componentsHealth
+		req.StatusDirty = true
+	}
+
+	metrics.SetHCOMetricComponentsHealthStatus(healthStatuses)
 }
 
 // This function is used to exit from the reconcile function, updating the conditions and returns the reconcile result
@@ -948,6 +1122,10 @@ func (r *ReconcileHyperConverged) updateConditions(req *common.HcoRequest) {
 	// Detect a "TaintedConfiguration" state, and raise a corresponding event
 	r.detectTaintedConfiguration(req, &conditions)
 
+	r.detectUnmanagedOperands(req, &conditions)
+
+	r.detectConfigRollout(req, &conditions)
+
 	if !reflect.DeepEqual(conditions, req.Instance.Status.Conditions) {
 		req.Instance.Status.Conditions = conditions
 		req.StatusDirty = true
@@ -1015,6 +1193,65 @@ func (r *ReconcileHyperConverged) detectTaintedConfiguration(req *common.HcoRequ
 	}
 }
 
+func (r *ReconcileHyperConverged) detectUnmanagedOperands(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	names := getUnmanagedOperandNames(req.Instance)
+	conditionExists := apimetav1.IsStatusConditionTrue(req.Instance.Status.Conditions, hcov1beta1.ConditionUnmanagedOperands)
+
+	if len(names) > 0 {
+		apimetav1.SetStatusCondition(conditions, metav1.Condition{
+			Type:               hcov1beta1.ConditionUnmanagedOperands,
+			Status:             metav1.ConditionTrue,
+			Reason:             unmanagedOperandsReason,
+			Message:            unmanagedOperandsMessage + strings.Join(names, ", "),
+			ObservedGeneration: req.Instance.Generation,
+		})
+
+		if !conditionExists {
+			req.Logger.Info("Some operands are unmanaged", "unmanagedOperands", names)
+		}
+	} else if conditionExists {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionUnmanagedOperands)
+		req.Logger.Info("All the operands are managed again")
+	}
+}
+
+// holdUpgradesWhileOperandsUnmanaged sets the Upgradeable condition to False while some operands are unmanaged;
+// HCO can't upgrade an operand that it does not reconcile. The condition is set after the component conditions are
+// aggregated, so the unmanaged operands don't affect the availability of HCO.
+func holdUpgradesWhileOperandsUnmanaged(req *common.HcoRequest) {
+	names := getUnmanagedOperandNames(req.Instance)
+	if len(names) == 0 {
+		return
+	}
+
+	req.Upgradeable = false
+
+	// a degraded or progressing state is more important to report
+	if cond, found := req.Conditions.GetCondition(hcov1beta1.ConditionUpgradeable); found && cond.Status == metav1.ConditionFalse {
+		return
+	}
+
+	req.Conditions.SetStatusCondition(metav1.Condition{
+		Type:               hcov1beta1.ConditionUpgradeable,
+		Status:             metav1.ConditionFalse,
+		Reason:             fmt.Sprintf(unmanagedOperandReasonFmt, names[0]),
+		Message:            notUpgradeableUnmanagedMsg + strings.Join(names, ", "),
+		ObservedGeneration: req.Instance.Generation,
+	})
+}
+
+// getUnmanagedOperandNames returns the sorted names of the unmanaged operands, so the messages of the conditions don't
+// depend on the order of the spec.unmanagedOperands list
+func getUnmanagedOperandNames(hc *hcov1beta1.HyperConverged) []string {
+	names := make([]string, 0, len(hc.Spec.UnmanagedOperands))
+	for _, operand := range hc.Spec.UnmanagedOperands {
+		names = append(names, string(operand))
+	}
+
+	slices.Sort(names)
+	return names
+}
+
 func (r *ReconcileHyperConverged) getSystemHealthStatus(conditions common.HcoConditions) string {
 	if isSystemHealthStatusError(conditions) {
 		return systemHealthStatusError
@@ -1035,6 +1272,18 @@ func isSystemHealthStatusWarning(conditions common.HcoConditions) bool {
 	return !conditions.IsStatusConditionTrue(hcov1beta1.ConditionReconcileComplete) || conditions.IsStatusConditionTrue(hcov1beta1.ConditionProgressing)
 }
 
+func getComponentHealthStatus(conditions common.HcoConditions) string {
+	if !conditions.IsStatusConditionTrue(hcov1beta1.ConditionAvailable) || conditions.IsStatusConditionTrue(hcov1beta1.ConditionDegraded) {
+		return systemHealthStatusError
+	}
+
+	if conditions.IsStatusConditionTrue(hcov1beta1.ConditionProgressing) {
+		return systemHealthStatusWarning
+	}
+
+	return systemHealthStatusHealthy
+}
+
 func getNumOfChangesJSONPatch(jsonPatch string) int {
 	patches, err := jsonpatch.DecodePatch([]byte(jsonPatch))
 	if err != nil {
@@ -1122,16 +1371,22 @@ func (r *ReconcileHyperConverged) applyUpgradePatches(req *common.HcoRequest) (b
 		return false, err
 	}
 
-	tmpInstance, err := upgradepatch.ApplyUpgradePatch(req.Logger, req.Instance, knownHcoSV)
+	tmpInstance, reports, err := upgradepatch.ApplyUpgradePatch(req.Logger, req.Instance, knownHcoSV)
 	if err != nil {
 		return false, err
 	}
 
+	recordUpgradePatches(req, knownHcoVersion, r.ownVersion, reports)
+
 	for _, p := range upgradepatch.GetObjectsToBeRemoved() {
 		removed, err := r.removeLeftover(req, knownHcoSV, p)
 		if err != nil {
 			return removed, err
 		}
+
+		if removed {
+			recordRemovedObject(req, knownHcoVersion, r.ownVersion, p)
+		}
 	}
 
 	if !reflect.DeepEqual(tmpInstance.Spec, req.Instance.Spec) {
@@ -1141,6 +1396,14 @@ func (r *ReconcileHyperConverged) applyUpgradePatches(req *common.HcoRequest) (b
 		req.Dirty = true
 	}
 
+	// upgrade patches only modify the spec, but some migrations, like the tuningPolicy one, also drop annotations
+	if !maps.Equal(tmpInstance.Annotations, req.Instance.Annotations) {
+		req.Logger.Info("updating HCO annotations as a result of upgrade patches")
+		req.Instance.Annotations = tmpInstance.Annotations
+		modified = true
+		req.Dirty = true
+	}
+
 	return modified, nil
 }
 
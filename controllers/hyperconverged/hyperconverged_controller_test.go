@@ -23,6 +23,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -144,6 +145,26 @@ var _ = Describe("HyperconvergedController", func() {
 				})))
 			})
 
+			It("should record the reconcile duration and the requeue reason", func() {
+				hco := commontestutils.NewHco()
+
+				ci := hcoutil.GetClusterInfo()
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco, ci.GetCSV()})
+				r := initReconciler(cl, nil)
+
+				durationCountBefore, err := metrics.GetReconcileDurationCount(metrics.ReconcileResultRequeue)
+				Expect(err).ToNot(HaveOccurred())
+				requeuesBefore, err := metrics.GetReconcileRequeuesCount(requeueReasonInitialization)
+				Expect(err).ToNot(HaveOccurred())
+
+				res, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal(reconcile.Result{RequeueAfter: requeueAfter}))
+
+				Expect(metrics.GetReconcileDurationCount(metrics.ReconcileResultRequeue)).To(Equal(durationCountBefore + 1))
+				Expect(metrics.GetReconcileRequeuesCount(requeueReasonInitialization)).To(Equal(requeuesBefore + 1))
+			})
+
 			It("should create all managed resources", func() {
 
 				hco := commontestutils.NewHco()
@@ -618,6 +639,59 @@ var _ = Describe("HyperconvergedController", func() {
 				verifySystemHealthStatusHealthy(foundResource)
 			})
 
+			It("should report the health of each component", func() {
+				expected := getBasicDeployment()
+
+				cl := expected.initClient()
+				foundResource, _, requeue := doReconcile(cl, expected.hco, nil)
+				Expect(requeue).To(BeFalse())
+
+				Expect(foundResource.Status.ComponentsHealth).To(ContainElements(
+					HaveField("Name", "KubeVirt"),
+					HaveField("Name", "CDI"),
+					HaveField("Name", "CNAO"),
+					HaveField("Name", "SSP"),
+				))
+				for _, component := range []string{"KubeVirt", "CDI", "CNAO", "SSP"} {
+					Expect(foundResource.Status.ComponentsHealth).To(ContainElement(And(
+						HaveField("Name", component),
+						HaveField("HealthStatus", systemHealthStatusHealthy),
+						HaveField("Conditions", ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+							Type:   hcov1beta1.ConditionAvailable,
+							Status: metav1.ConditionTrue,
+							Reason: "NotReported",
+						}))),
+					)))
+					Expect(metrics.GetHCOMetricComponentHealthStatus(component)).To(Equal(metrics.SystemHealthStatusHealthy))
+				}
+
+				By("make both KubeVirt and CDI unavailable")
+				expected.kv.Status.Conditions = expected.kv.Status.Conditions[1:]
+				expected.cdi.Status.Conditions = expected.cdi.Status.Conditions[1:]
+
+				cl = expected.initClient()
+				foundResource, _, _ = doReconcile(cl, expected.hco, nil)
+
+				for _, component := range []string{"KubeVirt", "CDI"} {
+					Expect(foundResource.Status.ComponentsHealth).To(ContainElement(And(
+						HaveField("Name", component),
+						HaveField("HealthStatus", systemHealthStatusError),
+						HaveField("Conditions", ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+							Type:    hcov1beta1.ConditionAvailable,
+							Status:  metav1.ConditionFalse,
+							Reason:  component + "NotAvailable",
+							Message: `missing "Available" condition`,
+						}))),
+					)))
+					Expect(metrics.GetHCOMetricComponentHealthStatus(component)).To(Equal(metrics.SystemHealthStatusError))
+				}
+
+				Expect(foundResource.Status.ComponentsHealth).To(ContainElement(And(
+					HaveField("Name", "SSP"),
+					HaveField("HealthStatus", systemHealthStatusHealthy),
+				)))
+			})
+
 			It("should increment counter when out-of-band change overwritten", func() {
 				hco := commontestutils.NewHco()
 				hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
@@ -1365,6 +1439,42 @@ var _ = Describe("HyperconvergedController", func() {
 				validateOperatorCondition(reconciler, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
 			})
 
+			It("don't complete the upgrade while an operand is unmanaged", func() {
+				// old HCO Version is set
+				UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+				// all the operands are already upgraded, but CDI is unmanaged
+				expected.hco.Spec.UnmanagedOperands = []hcov1beta1.OperandKind{hcov1beta1.OperandKindCDI}
+
+				cl := expected.initClient()
+				foundResource, reconciler, _ := doReconcile(cl, expected.hco, nil)
+
+				for range 3 {
+					foundResource, reconciler, _ = doReconcile(cl, foundResource, reconciler)
+				}
+
+				ver, ok := GetVersion(&foundResource.Status, hcoVersionName)
+				Expect(ok).To(BeTrue())
+				Expect(ver).To(Equal(oldVersion))
+				Expect(reconciler.upgradeMode).To(BeTrue())
+
+				cond := apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionProgressing)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				Expect(cond.Reason).To(Equal("HCOUpgrading"))
+
+				// managing the operand again completes the upgrade
+				foundResource.Spec.UnmanagedOperands = nil
+				Expect(cl.Update(context.TODO(), foundResource)).To(Succeed())
+
+				for range 2 {
+					foundResource, reconciler, _ = doReconcile(cl, foundResource, reconciler)
+				}
+
+				ver, ok = GetVersion(&foundResource.Status, hcoVersionName)
+				Expect(ok).To(BeTrue())
+				Expect(ver).To(Equal(newHCOVersion))
+			})
+
 			It("don't increase the overwrittenModifications metric during upgrade", func() {
 				// old HCO Version is set
 				UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
@@ -1840,6 +1950,22 @@ var _ = Describe("HyperconvergedController", func() {
 				})
 			})
 
+			It("should migrate the tuningPolicy annotation on upgrade", func() {
+				UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+				expected.hco.Spec.TuningPolicy = hcov1beta1.HyperConvergedAnnotationTuningPolicy
+				expected.hco.Annotations = map[string]string{common.TuningPolicyAnnotationName: `{"qps": 100, "burst": 200}`}
+
+				cl := expected.initClient()
+				foundResource, _, requeue := doReconcile(cl, expected.hco, nil)
+				Expect(requeue).To(BeTrue())
+
+				Expect(foundResource.Spec.TuningPolicy).To(Equal(hcov1beta1.HyperConvergedCustomTuningPolicy))
+				Expect(foundResource.Spec.CustomTuningPolicy).To(Equal(&hcov1beta1.CustomTuningPolicy{
+					Default: &hcov1beta1.ComponentRateLimiter{QPS: resource.MustParse("100"), Burst: 200},
+				}))
+				Expect(foundResource.Annotations).ToNot(HaveKey(common.TuningPolicyAnnotationName))
+			})
+
 			Context("remove leftovers on upgrades", func() {
 
 				It("should remove ConfigMap v2v-vmware upgrading from <= 1.6.0", func() {
@@ -1942,6 +2068,17 @@ var _ = Describe("HyperconvergedController", func() {
 						Expect(foundResource.Status.RelatedObjects).To(ContainElement(objRef))
 					}
 
+					Expect(foundResource.Status.UpgradeHistory).To(HaveLen(1))
+					history := foundResource.Status.UpgradeHistory[0]
+					Expect(history.FromVersion).To(Equal("1.4.99"))
+					Expect(history.ToVersion).To(Equal(newHCOVersion))
+					Expect(history.StartTime.IsZero()).To(BeFalse())
+					Expect(history.AppliedPatches).ToNot(BeEmpty())
+					Expect(history.RemovedObjects).To(ContainElements(
+						corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: cmToBeRemoved1.Name, Namespace: cmToBeRemoved1.Namespace},
+						corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: cmToBeRemoved2.Name, Namespace: cmToBeRemoved2.Namespace},
+					))
+					Expect(history.RemovedObjects).ToNot(ContainElement(HaveField("Namespace", cmNotToBeRemoved1.Namespace)))
 				})
 
 				It("should not remove ConfigMap v2v-vmware upgrading from >= 1.6.1", func() {
@@ -2785,6 +2922,127 @@ var _ = Describe("HyperconvergedController", func() {
 			})
 		})
 
+		Context("Detection of unmanaged operands", func() {
+			var (
+				hcoNamespace *corev1.Namespace
+				hco          *hcov1beta1.HyperConverged
+			)
+			BeforeEach(func() {
+				hcoNamespace = commontestutils.NewHcoNamespace()
+				hco = commontestutils.NewHco()
+				UpdateVersion(&hco.Status, hcoVersionName, version.Version)
+			})
+
+			It("Raises an UnmanagedOperands condition, and does not reconcile the unmanaged operands", func() {
+				hco.Spec.UnmanagedOperands = []hcov1beta1.OperandKind{hcov1beta1.OperandKindNetworkAddonsConfig, hcov1beta1.OperandKindCDI}
+
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+				r := initReconciler(cl, nil)
+
+				res, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal(reconcile.Result{RequeueAfter: requeueAfter}))
+
+				foundResource := &hcov1beta1.HyperConverged{}
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(hco), foundResource)).To(Succeed())
+
+				Expect(foundResource.Status.Conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1beta1.ConditionUnmanagedOperands,
+					Status:  metav1.ConditionTrue,
+					Reason:  unmanagedOperandsReason,
+					Message: unmanagedOperandsMessage + "CDI, NetworkAddonsConfig",
+				})))
+
+				cna := handlers.NewNetworkAddonsWithNameOnly(hco)
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cna), cna)).To(MatchError(apierrors.IsNotFound, "not found error"))
+
+				cdi := handlers.NewCDIWithNameOnly(hco)
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cdi), cdi)).To(MatchError(apierrors.IsNotFound, "not found error"))
+
+				kv := handlers.NewKubeVirtWithNameOnly(hco)
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+			})
+
+			It("Sets the Upgradeable condition to False while some operands are unmanaged", func() {
+				expected := getBasicDeployment()
+				expected.hco.Spec.UnmanagedOperands = []hcov1beta1.OperandKind{hcov1beta1.OperandKindNetworkAddonsConfig, hcov1beta1.OperandKindCDI}
+
+				cl := expected.initClient()
+				foundResource, r, _ := doReconcile(cl, expected.hco, nil)
+
+				Expect(foundResource.Status.Conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1beta1.ConditionUpgradeable,
+					Status:  metav1.ConditionFalse,
+					Reason:  "CDIUnmanaged",
+					Message: notUpgradeableUnmanagedMsg + "CDI, NetworkAddonsConfig",
+				})))
+				checkAvailability(foundResource, metav1.ConditionTrue)
+				validateOperatorCondition(r, metav1.ConditionFalse, "CDIUnmanaged", notUpgradeableUnmanagedMsg+"CDI, NetworkAddonsConfig")
+			})
+
+			It("Removes the UnmanagedOperands condition when all the operands are managed", func() {
+				hco.Status.Conditions = append(hco.Status.Conditions, metav1.Condition{
+					Type:    hcov1beta1.ConditionUnmanagedOperands,
+					Status:  metav1.ConditionTrue,
+					Reason:  unmanagedOperandsReason,
+					Message: unmanagedOperandsMessage + "KubeVirt",
+				})
+
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+				r := initReconciler(cl, nil)
+
+				res, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.IsZero()).To(BeTrue())
+
+				foundResource := &hcov1beta1.HyperConverged{}
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(hco), foundResource)).To(Succeed())
+
+				Expect(apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionUnmanagedOperands)).To(BeNil())
+			})
+		})
+
+		Context("Maintenance windows", func() {
+			It("Drops the KubeVirt workload update methods outside the maintenance windows, until a window opens", func() {
+				expected := getBasicDeployment()
+				expected.hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{"LiveMigrate"}
+				// opens in 3 hours (in "minute hour" format)
+				expected.hco.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []hcov1beta1.MaintenanceWindow{
+					{Schedule: time.Now().UTC().Add(3 * time.Hour).Format("4 15 * * *"), Duration: metav1.Duration{Duration: time.Hour}},
+				}
+
+				cl := expected.initClient()
+				r := initReconciler(cl, nil)
+				r.firstLoop = false
+
+				res, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.RequeueAfter).To(BeNumerically(">", 2*time.Hour))
+				Expect(res.RequeueAfter).To(BeNumerically("<=", 3*time.Hour))
+
+				kv := handlers.NewKubeVirtWithNameOnly(expected.hco)
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+				Expect(kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(BeEmpty())
+
+				foundResource := &hcov1beta1.HyperConverged{}
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(expected.hco), foundResource)).To(Succeed())
+				Expect(foundResource.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(ConsistOf("LiveMigrate"))
+
+				// the window opens; the next reconciliation is not triggered by the HyperConverged CR, so the cached
+				// KubeVirt CR must be dropped because of the new window state
+				foundResource.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []hcov1beta1.MaintenanceWindow{
+					{Schedule: "* * * * *", Duration: metav1.Duration{Duration: time.Hour}},
+				}
+				Expect(cl.Update(context.TODO(), foundResource)).To(Succeed())
+
+				_, err = r.Reconcile(context.TODO(), reqresolver.GetSecondaryCRRequest())
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+				Expect(kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(ConsistOf(kubevirtcorev1.WorkloadUpdateMethodLiveMigrate))
+			})
+		})
+
 		Context("Detection of a tainted configuration", func() {
 			var (
 				hcoNamespace *corev1.Namespace
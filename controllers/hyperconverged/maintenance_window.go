@@ -0,0 +1,114 @@
+package hyperconverged
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/maintenancewindow"
+)
+
+const (
+	outsideMaintenanceWindowReason         = "OutsideMaintenanceWindow"
+	outsideMaintenanceWindowMessageFormat  = "HCO is not Upgradeable outside the maintenance windows; the next maintenance window opens at %s"
+	noUpcomingMaintenanceWindowMessage     = "HCO is not Upgradeable outside the maintenance windows; none of the maintenance windows is expected to open"
+	invalidMaintenanceWindowsMessageFormat = "HCO is not Upgradeable, because the maintenance windows are invalid: %v"
+)
+
+// maintenanceWindowState is the state of the maintenance windows of the workload update strategy. It is evaluated once
+// per reconciliation, so the KubeVirt CR and the Upgradeable condition are always based on the same state.
+type maintenanceWindowState struct {
+	maintenancewindow.State
+
+	// hasWindows is false if there are no maintenance windows, and so the workloads may be updated at any time
+	hasWindows bool
+	err        error
+}
+
+func getMaintenanceWindowState(hc *hcov1beta1.HyperConverged, now time.Time) maintenanceWindowState {
+	windows := hc.Spec.WorkloadUpdateStrategy.MaintenanceWindows
+	if len(windows) == 0 {
+		return maintenanceWindowState{}
+	}
+
+	state, err := maintenancewindow.GetState(windows, now)
+	return maintenanceWindowState{State: state, hasWindows: true, err: err}
+}
+
+// workloadUpdatesHeld returns true outside the maintenance windows, or if the windows are invalid
+func (s maintenanceWindowState) workloadUpdatesHeld() bool {
+	return s.hasWindows && (s.err != nil || !s.Open)
+}
+
+// withMaintenanceWindowState drops the workload update methods of the HyperConverged CR outside the maintenance
+// windows, for the operands rendering, so KubeVirt won't disrupt workloads until the next window opens. The returned
+// function restores the original methods, so they won't be written back to the HyperConverged CR.
+func withMaintenanceWindowState(hc *hcov1beta1.HyperConverged, state maintenanceWindowState) func() {
+	if !state.workloadUpdatesHeld() {
+		return func() {}
+	}
+
+	origMethods := hc.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods
+	hc.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = nil
+
+	return func() {
+		hc.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = origMethods
+	}
+}
+
+// checkMaintenanceWindows blocks upgrades outside the maintenance windows of the workload update strategy, if there
+// are any. It returns the time to wait before the next window opens or the current one closes, so the reconciliation
+// could be requeued at that point, to update KubeVirt and the Upgradeable condition.
+func (r *ReconcileHyperConverged) checkMaintenanceWindows(req *common.HcoRequest, state maintenanceWindowState) time.Duration {
+	if !state.hasWindows {
+		return 0
+	}
+
+	if state.err != nil {
+		req.Logger.Error(state.err, "failed to evaluate the maintenance windows")
+		r.holdUpgradesOutsideMaintenanceWindow(req, fmt.Sprintf(invalidMaintenanceWindowsMessageFormat, state.err))
+		return 0
+	}
+
+	if !state.Open {
+		msg := noUpcomingMaintenanceWindowMessage
+		if !state.NextTransition.IsZero() {
+			msg = fmt.Sprintf(outsideMaintenanceWindowMessageFormat, state.NextTransition.Format(time.RFC3339))
+		}
+		r.holdUpgradesOutsideMaintenanceWindow(req, msg)
+	}
+
+	if state.NextTransition.IsZero() {
+		return 0
+	}
+
+	return max(time.Until(state.NextTransition), time.Second)
+}
+
+func (r *ReconcileHyperConverged) holdUpgradesOutsideMaintenanceWindow(req *common.HcoRequest, msg string) {
+	req.Upgradeable = false
+
+	// a degraded or progressing state is more important to report
+	if cond, found := req.Conditions.GetCondition(hcov1beta1.ConditionUpgradeable); found && cond.Status == metav1.ConditionFalse {
+		return
+	}
+
+	req.Conditions.SetStatusCondition(metav1.Condition{
+		Type:               hcov1beta1.ConditionUpgradeable,
+		Status:             metav1.ConditionFalse,
+		Reason:             outsideMaintenanceWindowReason,
+		Message:            msg,
+		ObservedGeneration: req.Instance.Generation,
+	})
+}
+
+// minRequeue returns the shortest of the non-zero requeue periods
+func minRequeue(a, b time.Duration) time.Duration {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
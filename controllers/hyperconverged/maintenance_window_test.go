@@ -0,0 +1,189 @@
+package hyperconverged
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("maintenance windows", func() {
+	var (
+		r   *ReconcileHyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		req = commontestutils.NewReq(commontestutils.NewHco())
+		req.Upgradeable = true
+		r = initReconciler(commontestutils.InitClient(nil), nil)
+	})
+
+	setWindow := func(schedule string, duration time.Duration) {
+		req.Instance.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []hcov1beta1.MaintenanceWindow{
+			{Schedule: schedule, Duration: metav1.Duration{Duration: duration}},
+		}
+	}
+
+	checkMaintenanceWindows := func() time.Duration {
+		return r.checkMaintenanceWindows(req, getMaintenanceWindowState(req.Instance, time.Now()))
+	}
+
+	It("should not do anything if there are no maintenance windows", func() {
+		Expect(checkMaintenanceWindows()).To(BeZero())
+		Expect(req.Upgradeable).To(BeTrue())
+		Expect(req.Conditions.HasCondition(hcov1beta1.ConditionUpgradeable)).To(BeFalse())
+	})
+
+	It("should allow upgrades within a maintenance window, and requeue when it closes", func() {
+		// opened an hour ago (in "minute hour" format)
+		setWindow(time.Now().UTC().Add(-time.Hour).Format("4 15 * * *"), 2*time.Hour)
+
+		requeue := checkMaintenanceWindows()
+		Expect(requeue).To(BeNumerically(">", 59*time.Minute))
+		Expect(requeue).To(BeNumerically("<=", time.Hour))
+		Expect(req.Upgradeable).To(BeTrue())
+		Expect(req.Conditions.HasCondition(hcov1beta1.ConditionUpgradeable)).To(BeFalse())
+	})
+
+	It("should block upgrades outside the maintenance windows, and requeue when the next one opens", func() {
+		next := time.Now().UTC().Add(3 * time.Hour)
+		// opens in 3 hours (in "minute hour" format)
+		setWindow(next.Format("4 15 * * *"), time.Minute)
+
+		requeue := checkMaintenanceWindows()
+		Expect(requeue).To(BeNumerically(">", 2*time.Hour))
+		Expect(requeue).To(BeNumerically("<=", 3*time.Hour))
+		Expect(req.Upgradeable).To(BeFalse())
+
+		cond, found := req.Conditions.GetCondition(hcov1beta1.ConditionUpgradeable)
+		Expect(found).To(BeTrue())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(outsideMaintenanceWindowReason))
+		Expect(cond.Message).To(ContainSubstring(next.Truncate(time.Minute).Format(time.RFC3339)))
+	})
+
+	It("should explain when no maintenance window is expected to open", func() {
+		setWindow("0 0 31 2 *", time.Hour)
+
+		Expect(checkMaintenanceWindows()).To(BeZero())
+		Expect(req.Upgradeable).To(BeFalse())
+
+		cond, found := req.Conditions.GetCondition(hcov1beta1.ConditionUpgradeable)
+		Expect(found).To(BeTrue())
+		Expect(cond.Message).To(Equal(noUpcomingMaintenanceWindowMessage))
+	})
+
+	It("should block upgrades if the maintenance windows are invalid", func() {
+		setWindow("wrong", time.Hour)
+
+		Expect(checkMaintenanceWindows()).To(BeZero())
+		Expect(req.Upgradeable).To(BeFalse())
+
+		cond, found := req.Conditions.GetCondition(hcov1beta1.ConditionUpgradeable)
+		Expect(found).To(BeTrue())
+		Expect(cond.Message).To(ContainSubstring("the maintenance windows are invalid"))
+	})
+
+	It("should not override the reason if the Upgradeable condition is already false", func() {
+		setWindow("0 0 31 2 *", time.Hour)
+		req.Conditions.SetStatusCondition(metav1.Condition{
+			Type:    hcov1beta1.ConditionUpgradeable,
+			Status:  metav1.ConditionFalse,
+			Reason:  commonDegradedReason,
+			Message: "HCO is not Upgradeable due to degraded components",
+		})
+
+		checkMaintenanceWindows()
+		Expect(req.Upgradeable).To(BeFalse())
+
+		cond, found := req.Conditions.GetCondition(hcov1beta1.ConditionUpgradeable)
+		Expect(found).To(BeTrue())
+		Expect(cond.Reason).To(Equal(commonDegradedReason))
+	})
+
+	It("should override the Upgradeable condition, if it is true", func() {
+		setWindow("0 0 31 2 *", time.Hour)
+		req.Conditions.SetStatusCondition(metav1.Condition{
+			Type:    hcov1beta1.ConditionUpgradeable,
+			Status:  metav1.ConditionTrue,
+			Reason:  reconcileCompleted,
+			Message: reconcileCompletedMessage,
+		})
+
+		checkMaintenanceWindows()
+
+		cond, found := req.Conditions.GetCondition(hcov1beta1.ConditionUpgradeable)
+		Expect(found).To(BeTrue())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(outsideMaintenanceWindowReason))
+	})
+
+	Context("withMaintenanceWindowState", func() {
+		BeforeEach(func() {
+			req.Instance.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{"LiveMigrate"}
+		})
+
+		It("should keep the workload update methods if there are no maintenance windows", func() {
+			restore := withMaintenanceWindowState(req.Instance, getMaintenanceWindowState(req.Instance, time.Now()))
+			Expect(req.Instance.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(ConsistOf("LiveMigrate"))
+			restore()
+		})
+
+		It("should keep the workload update methods within a maintenance window", func() {
+			setWindow("* * * * *", time.Hour)
+
+			restore := withMaintenanceWindowState(req.Instance, getMaintenanceWindowState(req.Instance, time.Now()))
+			Expect(req.Instance.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(ConsistOf("LiveMigrate"))
+			restore()
+		})
+
+		It("should drop the workload update methods outside the maintenance windows, and then restore them", func() {
+			setWindow("0 0 31 2 *", time.Hour)
+
+			restore := withMaintenanceWindowState(req.Instance, getMaintenanceWindowState(req.Instance, time.Now()))
+			Expect(req.Instance.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(BeEmpty())
+
+			restore()
+			Expect(req.Instance.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(ConsistOf("LiveMigrate"))
+		})
+
+		It("should drop the workload update methods if the maintenance windows are invalid", func() {
+			setWindow("wrong", time.Hour)
+
+			restore := withMaintenanceWindowState(req.Instance, getMaintenanceWindowState(req.Instance, time.Now()))
+			Expect(req.Instance.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(BeEmpty())
+			restore()
+		})
+
+		It("should evaluate the windows at the given time", func() {
+			// opens every day at 01:00 UTC, for an hour
+			setWindow("0 1 * * *", time.Hour)
+			day := time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)
+
+			closed := getMaintenanceWindowState(req.Instance, day.Add(30*time.Minute))
+			Expect(closed.workloadUpdatesHeld()).To(BeTrue())
+			Expect(closed.NextTransition).To(Equal(day.Add(time.Hour)))
+
+			open := getMaintenanceWindowState(req.Instance, day.Add(90*time.Minute))
+			Expect(open.workloadUpdatesHeld()).To(BeFalse())
+			Expect(open.NextTransition).To(Equal(day.Add(2 * time.Hour)))
+		})
+	})
+
+	DescribeTable("minRequeue", func(a, b, expected time.Duration) {
+		Expect(minRequeue(a, b)).To(Equal(expected))
+	},
+		Entry("both zero", time.Duration(0), time.Duration(0), time.Duration(0)),
+		Entry("first is zero", time.Duration(0), time.Minute, time.Minute),
+		Entry("second is zero", time.Minute, time.Duration(0), time.Minute),
+		Entry("first is shorter", time.Second, time.Minute, time.Second),
+		Entry("second is shorter", time.Minute, time.Second, time.Second),
+	)
+})
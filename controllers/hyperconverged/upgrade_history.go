@@ -0,0 +1,86 @@
+package hyperconverged
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/upgradepatch"
+)
+
+const maxUpgradeHistoryEntries = 5
+
+// recordUpgradePatches adds a new upgrade history entry for the current upgrade, with the results of the upgrade
+// patches.
+//
+// The upgrade patches are re-applied on each reconciliation during the upgrade, but only the first results are
+// recorded; later results are no longer relevant, because the HyperConverged CR was already modified by the first
+// application.
+func recordUpgradePatches(req *common.HcoRequest, fromVersion, toVersion string, reports []upgradepatch.PatchReport) {
+	if getUpgradeHistoryEntry(req, fromVersion, toVersion) != nil {
+		return
+	}
+
+	entry := hcov1beta1.UpgradeHistoryEntry{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		StartTime:   metav1.Now(),
+	}
+
+	for _, report := range reports {
+		record := hcov1beta1.UpgradePatchRecord{Index: report.Index, SemverRange: report.SemverRange}
+		if report.Result == upgradepatch.PatchTestFailed {
+			entry.SkippedPatches = append(entry.SkippedPatches, record)
+		} else {
+			entry.AppliedPatches = append(entry.AppliedPatches, record)
+		}
+	}
+
+	history := append(req.Instance.Status.UpgradeHistory, entry)
+	if len(history) > maxUpgradeHistoryEntries {
+		history = history[len(history)-maxUpgradeHistoryEntries:]
+	}
+
+	req.Instance.Status.UpgradeHistory = history
+	req.StatusDirty = true
+}
+
+// recordRemovedObject adds a deleted leftover object to the upgrade history entry of the current upgrade
+func recordRemovedObject(req *common.HcoRequest, fromVersion, toVersion string, obj upgradepatch.ObjectToBeRemoved) {
+	entry := getUpgradeHistoryEntry(req, fromVersion, toVersion)
+	if entry == nil {
+		return
+	}
+
+	apiVersion, kind := obj.GroupVersionKind.ToAPIVersionAndKind()
+	ref := corev1.ObjectReference{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Namespace:  obj.ObjectKey.Namespace,
+		Name:       obj.ObjectKey.Name,
+	}
+
+	if slices.Contains(entry.RemovedObjects, ref) {
+		return
+	}
+
+	entry.RemovedObjects = append(entry.RemovedObjects, ref)
+	req.StatusDirty = true
+}
+
+func getUpgradeHistoryEntry(req *common.HcoRequest, fromVersion, toVersion string) *hcov1beta1.UpgradeHistoryEntry {
+	history := req.Instance.Status.UpgradeHistory
+	if len(history) == 0 {
+		return nil
+	}
+
+	entry := &history[len(history)-1]
+	if entry.FromVersion != fromVersion || entry.ToVersion != toVersion {
+		return nil
+	}
+
+	return entry
+}
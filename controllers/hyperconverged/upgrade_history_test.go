@@ -0,0 +1,100 @@
+package hyperconverged
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/upgradepatch"
+)
+
+var _ = Describe("test upgrade history", func() {
+	reports := []upgradepatch.PatchReport{
+		{Index: 0, SemverRange: "<1.2.0", Result: upgradepatch.PatchApplied},
+		{Index: 2, SemverRange: "<1.3.0", Result: upgradepatch.PatchTestFailed},
+		{Index: 3, SemverRange: "<1.3.0", Result: upgradepatch.PatchApplied},
+	}
+
+	leftover := upgradepatch.ObjectToBeRemoved{
+		GroupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+		ObjectKey:        types.NamespacedName{Name: "leftover", Namespace: commontestutils.Namespace},
+	}
+
+	It("should add a new upgrade history entry", func() {
+		req := commontestutils.NewReq(commontestutils.NewHco())
+
+		recordUpgradePatches(req, "1.1.0", "1.3.0", reports)
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(req.Instance.Status.UpgradeHistory).To(HaveLen(1))
+
+		entry := req.Instance.Status.UpgradeHistory[0]
+		Expect(entry.FromVersion).To(Equal("1.1.0"))
+		Expect(entry.ToVersion).To(Equal("1.3.0"))
+		Expect(entry.StartTime.IsZero()).To(BeFalse())
+		Expect(entry.AppliedPatches).To(Equal([]hcov1beta1.UpgradePatchRecord{
+			{Index: 0, SemverRange: "<1.2.0"},
+			{Index: 3, SemverRange: "<1.3.0"},
+		}))
+		Expect(entry.SkippedPatches).To(Equal([]hcov1beta1.UpgradePatchRecord{
+			{Index: 2, SemverRange: "<1.3.0"},
+		}))
+		Expect(entry.RemovedObjects).To(BeEmpty())
+	})
+
+	It("should not modify the entry of the current upgrade", func() {
+		req := commontestutils.NewReq(commontestutils.NewHco())
+		recordUpgradePatches(req, "1.1.0", "1.3.0", reports)
+
+		req.StatusDirty = false
+		recordUpgradePatches(req, "1.1.0", "1.3.0", []upgradepatch.PatchReport{
+			{Index: 0, SemverRange: "<1.2.0", Result: upgradepatch.PatchTestFailed},
+		})
+
+		Expect(req.StatusDirty).To(BeFalse())
+		Expect(req.Instance.Status.UpgradeHistory).To(HaveLen(1))
+		Expect(req.Instance.Status.UpgradeHistory[0].AppliedPatches).To(HaveLen(2))
+	})
+
+	It("should keep only the latest upgrades", func() {
+		req := commontestutils.NewReq(commontestutils.NewHco())
+
+		for i := range maxUpgradeHistoryEntries + 2 {
+			recordUpgradePatches(req, fmt.Sprintf("1.%d.0", i), fmt.Sprintf("1.%d.0", i+1), nil)
+		}
+
+		Expect(req.Instance.Status.UpgradeHistory).To(HaveLen(maxUpgradeHistoryEntries))
+		Expect(req.Instance.Status.UpgradeHistory[0].FromVersion).To(Equal("1.2.0"))
+		Expect(req.Instance.Status.UpgradeHistory[maxUpgradeHistoryEntries-1].ToVersion).To(Equal(fmt.Sprintf("1.%d.0", maxUpgradeHistoryEntries+2)))
+	})
+
+	It("should record a removed object only once", func() {
+		req := commontestutils.NewReq(commontestutils.NewHco())
+		recordUpgradePatches(req, "1.1.0", "1.3.0", nil)
+
+		recordRemovedObject(req, "1.1.0", "1.3.0", leftover)
+		recordRemovedObject(req, "1.1.0", "1.3.0", leftover)
+
+		Expect(req.Instance.Status.UpgradeHistory[0].RemovedObjects).To(Equal([]corev1.ObjectReference{
+			{APIVersion: "v1", Kind: "ConfigMap", Name: "leftover", Namespace: commontestutils.Namespace},
+		}))
+	})
+
+	It("should not record a removed object without an entry for the current upgrade", func() {
+		req := commontestutils.NewReq(commontestutils.NewHco())
+		recordUpgradePatches(req, "1.0.0", "1.1.0", nil)
+		req.StatusDirty = false
+
+		recordRemovedObject(req, "1.1.0", "1.3.0", leftover)
+
+		Expect(req.StatusDirty).To(BeFalse())
+		Expect(req.Instance.Status.UpgradeHistory[0].RemovedObjects).To(BeEmpty())
+	})
+})
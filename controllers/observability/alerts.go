@@ -12,12 +12,28 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
 )
 
+// ReconcileAlerts creates or updates the PrometheusRule of the observability alerts, with the alert rule overrides of
+// the HyperConverged CR. The invalid overrides are skipped, and are returned as an InvalidConfigurationError, once the
+// PrometheusRule is reconciled with the valid ones.
 func (r *Reconciler) ReconcileAlerts(ctx context.Context) error {
 	desiredPromRule, err := rules.BuildPrometheusRule(r.namespace, r.owner)
 	if err != nil {
 		return fmt.Errorf("failed to build PrometheusRule: %v", err)
 	}
 
+	hc, err := r.getHyperConverged(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read the HyperConverged CR: %w", err)
+	}
+
+	var overridesErr error
+	if hc != nil {
+		if overridesErr = rules.ApplyOverrides(desiredPromRule, hc.Spec.AlertRuleOverrides); overridesErr != nil {
+			log.Error(overridesErr, "invalid alert rule overrides; ignoring the invalid overrides")
+			overridesErr = &InvalidConfigurationError{err: fmt.Errorf("invalid alert rule overrides: %w", overridesErr)}
+		}
+	}
+
 	existingPromRule := &promv1.PrometheusRule{}
 	err = r.Get(ctx, types.NamespacedName{
 		Name:      desiredPromRule.Name,
@@ -31,7 +47,7 @@ func (r *Reconciler) ReconcileAlerts(ctx context.Context) error {
 				return fmt.Errorf("failed to create PrometheusRule: %v", createErr)
 			}
 
-			return nil
+			return overridesErr
 		}
 
 		return fmt.Errorf("failed to get PrometheusRule: %v", err)
@@ -45,5 +61,19 @@ func (r *Reconciler) ReconcileAlerts(ctx context.Context) error {
 		}
 	}
 
-	return nil
+	return overridesErr
+}
+
+// InvalidConfigurationError is an invalid configuration in the HyperConverged CR. It is reported in the
+// ObservabilityReconciled condition, but is not retried; the HyperConverged CR must be fixed first.
+type InvalidConfigurationError struct {
+	err error
+}
+
+func (e *InvalidConfigurationError) Error() string {
+	return e.err.Error()
+}
+
+func (e *InvalidConfigurationError) Unwrap() error {
+	return e.err
 }
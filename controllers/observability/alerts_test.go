@@ -2,6 +2,8 @@ package observability_test
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -11,10 +13,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/observability"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
@@ -69,4 +73,79 @@ var _ = Describe("Reconcile Alerts", func() {
 
 		Expect(foundPromRules.Spec).To(Equal(promRules.Spec))
 	})
+
+	Context("alert rule overrides", func() {
+		newReconcilerWithHCO := func(alertOverrides []hcov1beta1.AlertRuleOverride) {
+			hco := commontestutils.NewHco()
+			hco.Namespace = namespace
+			hco.Spec.AlertRuleOverrides = alertOverrides
+
+			cl = commontestutils.InitClient([]client.Object{hco})
+			mgr, err := commontestutils.NewManagerMock(&rest.Config{}, manager.Options{}, cl, logger)
+			Expect(err).ToNot(HaveOccurred())
+
+			reconciler = observability.NewReconciler(mgr, namespace, &appsv1.Deployment{})
+		}
+
+		getAlert := func(pr promv1.PrometheusRule, name string) *promv1.Rule {
+			for _, group := range pr.Spec.Groups {
+				for _, rule := range group.Rules {
+					if rule.Alert == name {
+						return &rule
+					}
+				}
+			}
+			return nil
+		}
+
+		It("Should merge the alert rule overrides of the HyperConverged CR", func() {
+			newReconcilerWithHCO([]hcov1beta1.AlertRuleOverride{
+				{
+					Alert:     "HighCPUWorkload",
+					Threshold: ptr.To("0.75"),
+					For:       &metav1.Duration{Duration: 10 * time.Minute},
+				},
+				{
+					Alert:    "PersistentVolumeFillingUp",
+					Disabled: true,
+				},
+			})
+
+			Expect(reconciler.ReconcileAlerts(context.TODO())).To(Succeed())
+
+			var foundPromRules promv1.PrometheusRule
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(promRules), &foundPromRules)).To(Succeed())
+
+			Expect(getAlert(foundPromRules, "PersistentVolumeFillingUp")).To(BeNil())
+
+			alert := getAlert(foundPromRules, "HighCPUWorkload")
+			Expect(alert).ToNot(BeNil())
+			Expect(alert.Expr.String()).To(Equal("instance:node_cpu_utilisation:rate1m >= 0.75"))
+			Expect(alert.Annotations["description"]).To(ContainSubstring("above 75%"))
+			Expect(alert.For).To(HaveValue(Equal(promv1.Duration("10m"))))
+		})
+
+		It("Should skip the invalid alert rule overrides, and apply the valid ones", func() {
+			newReconcilerWithHCO([]hcov1beta1.AlertRuleOverride{
+				{
+					Alert:    "PersistentVolumeFillingUp",
+					Disabled: true,
+				},
+				{
+					Alert:     "HAControlPlaneDown",
+					Threshold: ptr.To("1"),
+				},
+			})
+
+			err := reconciler.ReconcileAlerts(context.TODO())
+			Expect(err).To(MatchError(ContainSubstring("invalid override of the HAControlPlaneDown alert")))
+			var invalidConfigErr *observability.InvalidConfigurationError
+			Expect(errors.As(err, &invalidConfigErr)).To(BeTrue())
+
+			var foundPromRules promv1.PrometheusRule
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(promRules), &foundPromRules)).To(Succeed())
+			Expect(getAlert(foundPromRules, "PersistentVolumeFillingUp")).To(BeNil())
+			Expect(getAlert(foundPromRules, "HAControlPlaneDown")).To(Equal(getAlert(*promRules, "HAControlPlaneDown")))
+		})
+	})
 })
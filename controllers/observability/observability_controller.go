@@ -2,74 +2,186 @@ package observability
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"time"
 
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
+const (
+	// DefaultSilencePollInterval is the default interval of polling the alertmanager silences, that can't be watched.
+	// It can be replaced with the SILENCE_POLL_INTERVAL environment variable.
+	DefaultSilencePollInterval = 5 * time.Minute
+
+	// the silences are renewed two intervals before they expire, and their minimal duration is 1 hour
+	minSilencePollInterval = 1 * time.Minute
+	maxSilencePollInterval = 30 * time.Minute
+
+	// the initial delay of retrying a failed reconciliation. The delay is doubled on each consecutive failure, up to
+	// the silence poll interval.
+	retryBaseDelay = 5 * time.Second
+
+	reconcileCompletedReason = "ReconcileCompleted"
+	reconcileFailedReason    = "ReconcileFailed"
+	// the reason of an ObservabilityReconciled condition of an invalid configuration in the HyperConverged CR
+	invalidConfigurationReason = "InvalidConfiguration"
+)
+
 var (
-	log         = logf.Log.WithName("controller_observability")
-	periodicity = 1 * time.Hour
+	log = logf.Log.WithName("controller_observability")
+
+	// all the events are mapped to the same request, so the observability resources are reconciled at once
+	observabilityRequest = reconcile.Request{NamespacedName: types.NamespacedName{Name: "observability"}}
 )
 
 type Reconciler struct {
 	client.Client
 
-	namespace string
-	config    *rest.Config
-	events    chan event.GenericEvent
-	owner     *metav1.OwnerReference
+	// apiReader reads the objects that are not in the cache of the manager, like the alertmanager CA ConfigMap
+	apiReader client.Reader
+
+	namespace           string
+	config              *rest.Config
+	events              chan event.GenericEvent
+	owner               *metav1.OwnerReference
+	silencePollInterval time.Duration
+
+	// prometheusRuleAvailable is false if the PrometheusRule CRD does not exist; the observability alerts are not
+	// reconciled in this case
+	prometheusRuleAvailable bool
 
 	amApi *alertmanager.Api
 }
 
+// Reconcile reconciles the alertmanager silences and the PrometheusRule of the observability alerts, and reports the
+// outcome in the ObservabilityReconciled condition of the HyperConverged CR. A successful reconciliation is repeated
+// after the silence poll interval; a failed one is retried with an exponential backoff. An invalid configuration in
+// the HyperConverged CR is reported in the condition, but is not retried.
 func (r *Reconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
 	log.Info("Reconciling Observability")
 
-	var errors []error
+	reconcileErrs := []error{r.ReconcileSilences(ctx)}
+	if r.prometheusRuleAvailable {
+		reconcileErrs = append(reconcileErrs, r.ReconcileAlerts(ctx))
+	}
+
+	var errs, configErrs []error
+	for _, err := range reconcileErrs {
+		var invalidConfigErr *InvalidConfigurationError
+		switch {
+		case err == nil:
+		case errors.As(err, &invalidConfigErr):
+			configErrs = append(configErrs, err)
+		default:
+			errs = append(errs, err)
+		}
+	}
+
+	var reconcileErr error
+	if len(errs) > 0 {
+		reconcileErr = fmt.Errorf("reconciliation failed: %v", errs)
+		log.Error(reconcileErr, "Reconciliation failed")
+	}
 
-	if err := r.ensurePodDisruptionBudgetAtLimitIsSilenced(); err != nil {
-		errors = append(errors, err)
+	cond := getCondition(reconcileErr, errors.Join(configErrs...))
+	condErr := r.updateCondition(ctx, cond)
+	if condErr != nil {
+		log.Error(condErr, "failed to update the ObservabilityReconciled condition")
 	}
 
-	if err := r.ReconcileAlerts(ctx); err != nil {
-		errors = append(errors, err)
+	switch {
+	case reconcileErr != nil:
+		return ctrl.Result{}, reconcileErr
+	case condErr != nil:
+		return ctrl.Result{}, condErr
+	}
+
+	return ctrl.Result{RequeueAfter: r.silencePollInterval}, nil
+}
+
+// getCondition returns the ObservabilityReconciled condition of the reconciliation outcome. A failed reconciliation
+// takes precedence over an invalid configuration.
+func getCondition(reconcileErr, configErr error) metav1.Condition {
+	cond := metav1.Condition{
+		Type:    hcov1beta1.ConditionObservabilityReconciled,
+		Status:  metav1.ConditionTrue,
+		Reason:  reconcileCompletedReason,
+		Message: "Reconcile completed successfully",
 	}
 
-	if len(errors) > 0 {
-		err := fmt.Errorf("reconciliation failed: %v", errors)
-		log.Error(err, "Reconciliation failed")
-		return ctrl.Result{}, err
+	switch {
+	case reconcileErr != nil:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = reconcileFailedReason
+		cond.Message = reconcileErr.Error()
+	case configErr != nil:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = invalidConfigurationReason
+		cond.Message = configErr.Error()
 	}
 
-	return ctrl.Result{}, nil
+	return cond
+}
+
+// updateCondition sets the ObservabilityReconciled condition of the HyperConverged CR, if exists. The HyperConverged
+// controller updates the status of the same CR, so the condition is patched with an optimistic lock, and the patch is
+// retried, on top of the latest version of the CR, on a conflict.
+func (r *Reconciler) updateCondition(ctx context.Context, cond metav1.Condition) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		hc, err := r.getHyperConverged(ctx)
+		if err != nil || hc == nil {
+			return err
+		}
+
+		orig := hc.DeepCopy()
+		cond.ObservedGeneration = hc.Generation
+		if !apimetav1.SetStatusCondition(&hc.Status.Conditions, cond) {
+			return nil
+		}
+
+		return r.Status().Patch(ctx, hc, client.MergeFromWithOptions(orig, client.MergeFromWithOptimisticLock{}))
+	})
 }
 
 func NewReconciler(mgr ctrl.Manager, namespace string, ownerDeployment *appsv1.Deployment) *Reconciler {
 	return &Reconciler{
-		Client:    mgr.GetClient(),
-		namespace: namespace,
-		config:    mgr.GetConfig(),
-		events:    make(chan event.GenericEvent, 1),
-		owner:     buildOwnerReference(ownerDeployment),
+		Client:                  mgr.GetClient(),
+		apiReader:               mgr.GetAPIReader(),
+		namespace:               namespace,
+		config:                  mgr.GetConfig(),
+		events:                  make(chan event.GenericEvent, 1),
+		owner:                   buildOwnerReference(ownerDeployment),
+		silencePollInterval:     getSilencePollInterval(),
+		prometheusRuleAvailable: true,
 	}
 }
 
-func SetupWithManager(mgr ctrl.Manager, ownerDeployment *appsv1.Deployment) error {
+func SetupWithManager(mgr ctrl.Manager, ci util.ClusterInfo) error {
 	log.Info("Setting up controller")
 
 	namespace := util.GetOperatorNamespaceFromEnv()
@@ -79,32 +191,68 @@ func SetupWithManager(mgr ctrl.Manager, ownerDeployment *appsv1.Deployment) erro
 		return fmt.Errorf("failed to setup Prometheus rules: %v", err)
 	}
 
-	r := NewReconciler(mgr, namespace, ownerDeployment)
-	r.startEventLoop()
+	r := NewReconciler(mgr, namespace, ci.GetDeployment())
+	r.prometheusRuleAvailable = ci.IsMonitoringAvailable()
+	r.triggerInitialReconcile()
+
+	enqueueObservabilityRequest := handler.EnqueueRequestsFromMapFunc(func(_ context.Context, _ client.Object) []reconcile.Request {
+		return []reconcile.Request{observabilityRequest}
+	})
 
-	return ctrl.NewControllerManagedBy(mgr).
+	bldr := ctrl.NewControllerManagedBy(mgr).
 		Named("observability").
+		WithOptions(controller.Options{
+			RateLimiter: workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](retryBaseDelay, r.silencePollInterval),
+		}).
+		// the initial reconciliation, for the silences that HCO declares on its own
 		WatchesRawSource(source.Channel(
 			r.events,
-			&handler.EnqueueRequestForObject{},
+			enqueueObservabilityRequest,
 		)).
-		Complete(r)
+		// the silences and the alert rule overrides of the HyperConverged CR
+		Watches(
+			&hcov1beta1.HyperConverged{},
+			enqueueObservabilityRequest,
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		)
+
+	// recreate or restore the PrometheusRule, if it was deleted or modified. The PrometheusRule kind can't be
+	// watched if its CRD does not exist.
+	if r.prometheusRuleAvailable {
+		bldr = bldr.Watches(
+			&promv1.PrometheusRule{},
+			enqueueObservabilityRequest,
+			builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetName() == rules.PrometheusRuleName && obj.GetNamespace() == namespace
+			})),
+		)
+	}
+
+	return bldr.Complete(r)
 }
 
-func (r *Reconciler) startEventLoop() {
-	ticker := time.NewTicker(periodicity)
+func (r *Reconciler) triggerInitialReconcile() {
+	r.events <- event.GenericEvent{
+		Object: &metav1.PartialObjectMetadata{},
+	}
+}
 
-	go func() {
-		r.events <- event.GenericEvent{
-			Object: &metav1.PartialObjectMetadata{},
-		}
+// getSilencePollInterval returns the interval of the SILENCE_POLL_INTERVAL environment variable, if set and valid,
+// or else, the default interval
+func getSilencePollInterval() time.Duration {
+	value, found := os.LookupEnv(util.SilencePollIntervalEnvV)
+	if !found || value == "" {
+		return DefaultSilencePollInterval
+	}
 
-		for range ticker.C {
-			r.events <- event.GenericEvent{
-				Object: &metav1.PartialObjectMetadata{},
-			}
-		}
-	}()
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval < minSilencePollInterval || interval > maxSilencePollInterval {
+		log.Info(fmt.Sprintf("the silence poll interval must be a duration between %s and %s; using the default interval", minSilencePollInterval, maxSilencePollInterval),
+			"interval", value, "default", DefaultSilencePollInterval)
+		return DefaultSilencePollInterval
+	}
+
+	return interval
 }
 
 func buildOwnerReference(ownerDeployment *appsv1.Deployment) *metav1.OwnerReference {
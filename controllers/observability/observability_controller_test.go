@@ -1,27 +1,44 @@
 package observability
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/config"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
 const testNamespace = "observability_test"
 
 var logger = logf.Log.WithName("observability-controller")
 
+// noMonitoringClusterInfo is a cluster with no PrometheusRule CRD
+type noMonitoringClusterInfo struct {
+	commontestutils.ClusterInfoMock
+}
+
+func (noMonitoringClusterInfo) IsMonitoringAvailable() bool {
+	return false
+}
+
 var _ = Describe("Observability Controller", func() {
 	var mgr manager.Manager
 
@@ -31,16 +48,47 @@ var _ = Describe("Observability Controller", func() {
 
 		cl := commontestutils.InitClient([]client.Object{})
 
-		mgr, err = commontestutils.NewManagerMock(&rest.Config{}, manager.Options{}, cl, logger)
+		// the controller is set up more than once in the tests
+		mgr, err = commontestutils.NewManagerMock(&rest.Config{}, manager.Options{Controller: config.Controller{SkipNameValidation: ptr.To(true)}}, cl, logger)
 		Expect(err).ToNot(HaveOccurred())
 	})
 
 	It("Should successfully setup the controller", func() {
-		err := SetupWithManager(mgr, &appsv1.Deployment{})
+		err := SetupWithManager(mgr, commontestutils.ClusterInfoMock{})
 		Expect(err).ToNot(HaveOccurred())
 		Expect(rules.ListAlerts()).To(Not(BeEmpty()))
 	})
 
+	It("Should successfully setup the controller, if the PrometheusRule CRD does not exist", func() {
+		err := SetupWithManager(mgr, noMonitoringClusterInfo{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Should not reconcile the PrometheusRule, if its CRD does not exist", func() {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				_, _ = w.Write([]byte("[]"))
+			}
+		}))
+		DeferCleanup(ts.Close)
+		Expect(os.Setenv(util.AlertmanagerURLEnvV, ts.URL)).To(Succeed())
+		DeferCleanup(os.Unsetenv, util.AlertmanagerURLEnvV)
+
+		cl := commontestutils.InitClient([]client.Object{})
+		mgr, err := commontestutils.NewManagerMock(&rest.Config{}, manager.Options{}, cl, logger)
+		Expect(err).ToNot(HaveOccurred())
+
+		reconciler := NewReconciler(mgr, testNamespace, &appsv1.Deployment{})
+		reconciler.prometheusRuleAvailable = false
+
+		_, err = reconciler.Reconcile(context.TODO(), observabilityRequest)
+		Expect(err).ToNot(HaveOccurred())
+
+		promRules := &promv1.PrometheusRuleList{}
+		Expect(cl.List(context.TODO(), promRules)).To(Succeed())
+		Expect(promRules.Items).To(BeEmpty())
+	})
+
 	It("Should successfully reconcile observability", func() {
 		ownerDeployment := &appsv1.Deployment{
 			ObjectMeta: metav1.ObjectMeta{
@@ -55,13 +103,54 @@ var _ = Describe("Observability Controller", func() {
 		Expect(reconciler.Client).To(Equal(mgr.GetClient()))
 	})
 
-	It("Should receive periodic events in reconciler events channel", func() {
+	It("Should receive the initial event in reconciler events channel", func() {
 		reconciler := NewReconciler(mgr, testNamespace, &appsv1.Deployment{})
-		reconciler.startEventLoop()
+		reconciler.triggerInitialReconcile()
 
 		Eventually(reconciler.events).
 			WithTimeout(5 * time.Second).
 			WithPolling(100 * time.Millisecond).
 			Should(Receive())
 	})
+
+	Context("silence poll interval", func() {
+		AfterEach(func() {
+			Expect(os.Unsetenv(util.SilencePollIntervalEnvV)).To(Succeed())
+		})
+
+		It("Should use the default interval if the environment variable is not set", func() {
+			reconciler := NewReconciler(mgr, testNamespace, &appsv1.Deployment{})
+			Expect(reconciler.silencePollInterval).To(Equal(DefaultSilencePollInterval))
+		})
+
+		It("Should use the interval of the environment variable", func() {
+			Expect(os.Setenv(util.SilencePollIntervalEnvV, "10m")).To(Succeed())
+
+			reconciler := NewReconciler(mgr, testNamespace, &appsv1.Deployment{})
+			Expect(reconciler.silencePollInterval).To(Equal(10 * time.Minute))
+		})
+
+		DescribeTable("Should use the default interval if the environment variable is not valid", func(value string) {
+			Expect(os.Setenv(util.SilencePollIntervalEnvV, value)).To(Succeed())
+
+			reconciler := NewReconciler(mgr, testNamespace, &appsv1.Deployment{})
+			Expect(reconciler.silencePollInterval).To(Equal(DefaultSilencePollInterval))
+		},
+			Entry("malformed", "often"),
+			Entry("too short", "10s"),
+			Entry("too long", "1h"),
+		)
+
+		It("Should renew the silences that expire within two poll intervals", func() {
+			spec := silenceSpec{duration: 24 * time.Hour}
+			now := time.Now()
+			silenceEndsAt := func(d time.Duration) alertmanager.Silence {
+				return alertmanager.Silence{EndsAt: now.Add(d).UTC().Format(time.RFC3339)}
+			}
+
+			Expect(spec.shouldRenew(silenceEndsAt(15*time.Minute), now, 10*time.Minute)).To(BeTrue())
+			Expect(spec.shouldRenew(silenceEndsAt(25*time.Minute), now, 10*time.Minute)).To(BeFalse())
+			Expect(silenceSpec{}.shouldRenew(silenceEndsAt(time.Minute), now, 10*time.Minute)).To(BeFalse())
+		})
+	})
 })
@@ -0,0 +1,326 @@
+package observability
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// ServiceAccountTlsCertPath is the path to the OpenShift service CA certificate, that is trusted for the TLS
+	// communication with the alertmanager API, in addition to the system CA certificates
+	ServiceAccountTlsCertPath = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+
+	// AlertmanagerSvcHost is the default alertmanager endpoint, of the OpenShift monitoring stack. It can be replaced
+	// with the ALERTMANAGER_URL environment variable; e.g. for a self-hosted alertmanager.
+	AlertmanagerSvcHost = "https://alertmanager-main.openshift-monitoring.svc.cluster.local:9094"
+
+	silenceCreatedBy    = "hyperconverged-cluster-operator"
+	silenceStateActive  = "active"
+	silenceStatePending = "pending"
+)
+
+// the end time of the silences with no duration
+var noExpiration = time.Date(3000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// silenceSpec is an alertmanager silence that HCO maintains
+type silenceSpec struct {
+	comment  string
+	matchers []alertmanager.Matcher
+	// zero means that the silence never expires
+	duration time.Duration
+}
+
+// ReconcileSilences makes sure that all the silences that HCO declares are active, and deletes the silences that
+// were created by HCO, but are no longer declared.
+func (r *Reconciler) ReconcileSilences(ctx context.Context) error {
+	if r.amApi == nil {
+		var err error
+		r.amApi, err = r.NewAlertmanagerApi(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to initialize alertmanager api: %w", err)
+		}
+	}
+
+	hc, err := r.getHyperConverged(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read the HyperConverged CR: %w", err)
+	}
+
+	desired := getDesiredSilences(hc)
+
+	amSilences, err := r.amApi.ListSilences()
+	if err != nil {
+		return fmt.Errorf("failed to list alertmanager silences: %w", err)
+	}
+
+	now := time.Now()
+	found := make([]bool, len(desired))
+	var errs []error
+
+	for _, silence := range amSilences {
+		if !isHCOSilence(silence) {
+			continue
+		}
+
+		idx := slices.IndexFunc(desired, func(spec silenceSpec) bool {
+			return spec.matches(silence)
+		})
+
+		switch {
+		case idx < 0 || found[idx]:
+			// HCO no longer declares this silence, or this is a duplicate
+			if err = r.amApi.DeleteSilence(silence.ID); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete alertmanager silence: %w", err))
+				continue
+			}
+			log.Info("Deleted an alertmanager silence that is no longer declared", "comment", silence.Comment)
+
+		case desired[idx].shouldRenew(silence, now, r.silencePollInterval):
+			found[idx] = true
+			if err = r.renewSilence(desired[idx], silence, now); err != nil {
+				errs = append(errs, err)
+			}
+
+		default:
+			found[idx] = true
+		}
+	}
+
+	for i, spec := range desired {
+		if found[i] {
+			continue
+		}
+
+		if err = r.amApi.CreateSilence(spec.toSilence(now)); err != nil {
+			errs = append(errs, fmt.Errorf("failed to create alertmanager silence: %w", err))
+			continue
+		}
+		log.Info("Created an alertmanager silence", "comment", spec.comment)
+	}
+
+	return errors.Join(errs...)
+}
+
+// renewSilence replaces a silence that is about to expire. The new silence is created first, so the alerts stay
+// silenced.
+func (r *Reconciler) renewSilence(spec silenceSpec, silence alertmanager.Silence, now time.Time) error {
+	if err := r.amApi.CreateSilence(spec.toSilence(now)); err != nil {
+		return fmt.Errorf("failed to renew alertmanager silence: %w", err)
+	}
+
+	if err := r.amApi.DeleteSilence(silence.ID); err != nil {
+		return fmt.Errorf("failed to delete the renewed alertmanager silence: %w", err)
+	}
+
+	log.Info("Renewed an alertmanager silence", "comment", spec.comment)
+	return nil
+}
+
+func (r *Reconciler) getHyperConverged(ctx context.Context) (*hcov1beta1.HyperConverged, error) {
+	hc := &hcov1beta1.HyperConverged{}
+	err := r.Get(ctx, types.NamespacedName{Name: hcoutil.HyperConvergedName, Namespace: r.namespace}, hc)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return hc, nil
+}
+
+// getDesiredSilences returns the silences that HCO declares on its own, and the silences of the HyperConverged CR
+func getDesiredSilences(hc *hcov1beta1.HyperConverged) []silenceSpec {
+	desired := []silenceSpec{podDisruptionBudgetAtLimitSilence()}
+
+	if hc == nil {
+		return desired
+	}
+
+	for _, silence := range hc.Spec.AlertSilences {
+		spec := silenceSpec{
+			comment:  silence.Comment,
+			matchers: make([]alertmanager.Matcher, 0, len(silence.Matchers)),
+		}
+
+		for _, matcher := range silence.Matchers {
+			spec.matchers = append(spec.matchers, alertmanager.Matcher{
+				IsEqual: !matcher.IsNotEqual,
+				IsRegex: matcher.IsRegex,
+				Name:    matcher.Name,
+				Value:   matcher.Value,
+			})
+		}
+
+		if silence.Duration != nil {
+			spec.duration = silence.Duration.Duration
+		}
+
+		desired = append(desired, spec)
+	}
+
+	return desired
+}
+
+// isHCOSilence returns true if the alertmanager silence was created by HCO, and is not expired. A pending silence, that
+// its start time was not reached yet, is treated as an active one.
+func isHCOSilence(silence alertmanager.Silence) bool {
+	if silence.CreatedBy != silenceCreatedBy {
+		return false
+	}
+
+	return silence.Status.State == silenceStateActive || silence.Status.State == silenceStatePending
+}
+
+// matches returns true if the alertmanager silence was created from this spec
+func (spec silenceSpec) matches(silence alertmanager.Silence) bool {
+	if spec.comment != silence.Comment || len(spec.matchers) != len(silence.Matchers) {
+		return false
+	}
+
+	for _, matcher := range spec.matchers {
+		if !slices.Contains(silence.Matchers, matcher) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// shouldRenew returns true if the silence expires within two poll intervals; i.e. before the next poll, even if it
+// is delayed by a failed reconciliation
+func (spec silenceSpec) shouldRenew(silence alertmanager.Silence, now time.Time, pollInterval time.Duration) bool {
+	if spec.duration == 0 {
+		return false
+	}
+
+	endsAt, err := time.Parse(time.RFC3339, silence.EndsAt)
+	if err != nil {
+		return true
+	}
+
+	return endsAt.Sub(now) < 2*pollInterval
+}
+
+func (spec silenceSpec) toSilence(now time.Time) alertmanager.Silence {
+	endsAt := noExpiration
+	if spec.duration > 0 {
+		endsAt = now.Add(spec.duration)
+	}
+
+	return alertmanager.Silence{
+		Comment:   spec.comment,
+		CreatedBy: silenceCreatedBy,
+		EndsAt:    endsAt.UTC().Format(time.RFC3339),
+		Matchers:  spec.matchers,
+		StartsAt:  now.UTC().Format(time.RFC3339),
+	}
+}
+
+func (r *Reconciler) NewAlertmanagerApi(ctx context.Context) (*alertmanager.Api, error) {
+	host := GetAlertmanagerURL()
+
+	httpClient, err := NewHTTPClient(ctx, r.apiReader, r.namespace, host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http client: %w", err)
+	}
+
+	return alertmanager.NewAPI(*httpClient, host, r.config.BearerToken), nil
+}
+
+// GetAlertmanagerURL returns the alertmanager endpoint; the one of the ALERTMANAGER_URL environment variable, if set,
+// or else, the one of the OpenShift monitoring stack
+func GetAlertmanagerURL() string {
+	if url, found := os.LookupEnv(hcoutil.AlertmanagerURLEnvV); found && url != "" {
+		return strings.TrimSuffix(url, "/")
+	}
+
+	return AlertmanagerSvcHost
+}
+
+// IsAlertmanagerURLSet returns true if the alertmanager endpoint is set by the ALERTMANAGER_URL environment variable
+func IsAlertmanagerURLSet() bool {
+	return GetAlertmanagerURL() != AlertmanagerSvcHost
+}
+
+// NewHTTPClient returns the http client of the alertmanager endpoint. For https, the alertmanager certificate is
+// verified with the system CA certificates, the OpenShift service CA, if exists, and the optional CA certificates of
+// the ALERTMANAGER_CA_FILE file and of the ALERTMANAGER_CA_CONFIGMAP ConfigMap in the operator namespace.
+func NewHTTPClient(ctx context.Context, cl client.Reader, namespace, host string) (*http.Client, error) {
+	if strings.HasPrefix(host, "http://") {
+		return &http.Client{}, nil
+	}
+
+	caCertPool, err := x509.SystemCertPool()
+	if err != nil {
+		caCertPool = x509.NewCertPool()
+	}
+
+	caCert, err := os.ReadFile(ServiceAccountTlsCertPath)
+	if err == nil {
+		caCertPool.AppendCertsFromPEM(caCert)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read service account TLS certificate: %w", err)
+	}
+
+	if caFile, found := os.LookupEnv(hcoutil.AlertmanagerCAFileEnvV); found && caFile != "" {
+		caCert, err = os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the alertmanager CA file: %w", err)
+		}
+
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid CA certificate in the alertmanager CA file %s", caFile)
+		}
+	}
+
+	if cmName, found := os.LookupEnv(hcoutil.AlertmanagerCAConfigMapEnvV); found && cmName != "" {
+		if err = appendConfigMapCerts(ctx, cl, types.NamespacedName{Name: cmName, Namespace: namespace}, caCertPool); err != nil {
+			return nil, err
+		}
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: caCertPool},
+		},
+	}, nil
+}
+
+// appendConfigMapCerts adds the PEM encoded CA certificates of all the keys of the ConfigMap to the pool
+func appendConfigMapCerts(ctx context.Context, cl client.Reader, key types.NamespacedName, caCertPool *x509.CertPool) error {
+	cm := &corev1.ConfigMap{}
+	if err := cl.Get(ctx, key, cm); err != nil {
+		return fmt.Errorf("failed to read the alertmanager CA ConfigMap %s: %w", key, err)
+	}
+
+	appended := false
+	for _, caCert := range cm.Data {
+		if caCertPool.AppendCertsFromPEM([]byte(caCert)) {
+			appended = true
+		}
+	}
+
+	if !appended {
+		return fmt.Errorf("no valid CA certificate in the alertmanager CA ConfigMap %s", key)
+	}
+
+	return nil
+}
@@ -0,0 +1,431 @@
+package observability_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/observability"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// fakeAlertmanager keeps the silences in memory
+type fakeAlertmanager struct {
+	sync.Mutex
+	silences []alertmanager.Silence
+	nextID   int
+}
+
+func (am *fakeAlertmanager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	am.Lock()
+	defer am.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v2/silences":
+		Expect(json.NewEncoder(w).Encode(am.silences)).To(Succeed())
+
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v2/silences":
+		silence := alertmanager.Silence{}
+		Expect(json.NewDecoder(r.Body).Decode(&silence)).To(Succeed())
+		am.nextID++
+		silence.ID = strings.Repeat("x", am.nextID)
+		silence.Status.State = "active"
+		am.silences = append(am.silences, silence)
+
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v2/silence/"):
+		id := strings.TrimPrefix(r.URL.Path, "/api/v2/silence/")
+		am.silences = slices.DeleteFunc(am.silences, func(silence alertmanager.Silence) bool {
+			return silence.ID == id
+		})
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (am *fakeAlertmanager) getSilences() []alertmanager.Silence {
+	am.Lock()
+	defer am.Unlock()
+
+	return slices.Clone(am.silences)
+}
+
+var _ = Describe("Reconcile Silences", func() {
+	var (
+		am *fakeAlertmanager
+		ts *httptest.Server
+	)
+
+	BeforeEach(func() {
+		am = &fakeAlertmanager{}
+		ts = httptest.NewServer(am)
+
+		Expect(os.Setenv(hcoutil.AlertmanagerURLEnvV, ts.URL)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		ts.Close()
+		Expect(os.Unsetenv(hcoutil.AlertmanagerURLEnvV)).To(Succeed())
+	})
+
+	newReconciler := func(objs ...client.Object) *observability.Reconciler {
+		cl := commontestutils.InitClient(objs)
+		mgr, err := commontestutils.NewManagerMock(&rest.Config{}, manager.Options{}, cl, logger)
+		Expect(err).ToNot(HaveOccurred())
+
+		return observability.NewReconciler(mgr, commontestutils.Namespace, &appsv1.Deployment{})
+	}
+
+	getComments := func() []string {
+		var comments []string
+		for _, silence := range am.getSilences() {
+			comments = append(comments, silence.Comment)
+		}
+		return comments
+	}
+
+	It("should use the alertmanager of the ALERTMANAGER_URL environment variable", func() {
+		Expect(observability.IsAlertmanagerURLSet()).To(BeTrue())
+		Expect(observability.GetAlertmanagerURL()).To(Equal(ts.URL))
+	})
+
+	It("should create the PodDisruptionBudgetAtLimit silence, if there is no HyperConverged CR", func() {
+		reconciler := newReconciler()
+		Expect(reconciler.ReconcileSilences(context.TODO())).To(Succeed())
+
+		silences := am.getSilences()
+		Expect(silences).To(HaveLen(1))
+		Expect(observability.IsPodDisruptionBudgetAtLimitSilence(silences[0])).To(BeTrue())
+		Expect(silences[0].EndsAt).To(Equal("3000-01-01T00:00:00Z"))
+	})
+
+	It("should create the silences of the HyperConverged CR, only once", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.AlertSilences = []hcov1beta1.AlertSilence{
+			{
+				Comment: "maintenance of the storage backend",
+				Matchers: []hcov1beta1.AlertSilenceMatcher{
+					{Name: "alertname", Value: "PersistentVolumeFillingUp"},
+					{Name: "namespace", Value: "prod-.*", IsRegex: true, IsNotEqual: true},
+				},
+				Duration: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+		}
+
+		reconciler := newReconciler(hco)
+		Expect(reconciler.ReconcileSilences(context.TODO())).To(Succeed())
+		Expect(reconciler.ReconcileSilences(context.TODO())).To(Succeed())
+
+		silences := am.getSilences()
+		Expect(silences).To(HaveLen(2))
+
+		idx := slices.IndexFunc(silences, func(silence alertmanager.Silence) bool {
+			return silence.Comment == "maintenance of the storage backend"
+		})
+		Expect(idx).To(BeNumerically(">=", 0))
+
+		silence := silences[idx]
+		Expect(silence.CreatedBy).To(Equal("hyperconverged-cluster-operator"))
+		Expect(silence.Matchers).To(ConsistOf(
+			alertmanager.Matcher{Name: "alertname", Value: "PersistentVolumeFillingUp", IsEqual: true},
+			alertmanager.Matcher{Name: "namespace", Value: "prod-.*", IsRegex: true},
+		))
+
+		endsAt, err := time.Parse(time.RFC3339, silence.EndsAt)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(endsAt).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Minute))
+	})
+
+	It("should delete the silences that are no longer declared, and keep the silences of others", func() {
+		am.silences = []alertmanager.Silence{
+			{
+				ID:        "old",
+				Comment:   "removed from the HyperConverged CR",
+				CreatedBy: "hyperconverged-cluster-operator",
+				EndsAt:    "3000-01-01T00:00:00Z",
+				Matchers:  []alertmanager.Matcher{{Name: "alertname", Value: "SomeAlert", IsEqual: true}},
+				Status:    alertmanager.Status{State: "active"},
+			},
+			{
+				ID:        "user",
+				Comment:   "created by the cluster admin",
+				CreatedBy: "admin",
+				EndsAt:    "3000-01-01T00:00:00Z",
+				Matchers:  []alertmanager.Matcher{{Name: "alertname", Value: "SomeAlert", IsEqual: true}},
+				Status:    alertmanager.Status{State: "active"},
+			},
+		}
+
+		reconciler := newReconciler(commontestutils.NewHco())
+		Expect(reconciler.ReconcileSilences(context.TODO())).To(Succeed())
+
+		Expect(getComments()).To(ConsistOf(
+			"created by the cluster admin",
+			"Silence KubeVirt PodDisruptionBudgetAtLimit alerts",
+		))
+	})
+
+	It("should keep a pending silence, as an active one", func() {
+		am.silences = []alertmanager.Silence{
+			{
+				ID:        "pending",
+				Comment:   "Silence KubeVirt PodDisruptionBudgetAtLimit alerts",
+				CreatedBy: "hyperconverged-cluster-operator",
+				EndsAt:    "3000-01-01T00:00:00Z",
+				Matchers: []alertmanager.Matcher{
+					{Name: "alertname", Value: "PodDisruptionBudgetAtLimit", IsEqual: true},
+					{Name: "poddisruptionbudget", Value: "kubevirt-disruption-budget-.*", IsEqual: true, IsRegex: true},
+				},
+				Status: alertmanager.Status{State: "pending"},
+			},
+		}
+
+		reconciler := newReconciler(commontestutils.NewHco())
+		Expect(reconciler.ReconcileSilences(context.TODO())).To(Succeed())
+
+		silences := am.getSilences()
+		Expect(silences).To(HaveLen(1))
+		Expect(silences[0].ID).To(Equal("pending"))
+		Expect(observability.IsPodDisruptionBudgetAtLimitSilence(silences[0])).To(BeTrue())
+	})
+
+	It("should renew a silence that is about to expire", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.AlertSilences = []hcov1beta1.AlertSilence{
+			{
+				Comment:  "expiring",
+				Matchers: []hcov1beta1.AlertSilenceMatcher{{Name: "alertname", Value: "SomeAlert"}},
+				Duration: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+		}
+
+		am.silences = []alertmanager.Silence{
+			{
+				ID:        "expiring",
+				Comment:   "expiring",
+				CreatedBy: "hyperconverged-cluster-operator",
+				EndsAt:    time.Now().Add(10 * time.Minute).UTC().Format(time.RFC3339),
+				Matchers:  []alertmanager.Matcher{{Name: "alertname", Value: "SomeAlert", IsEqual: true}},
+				Status:    alertmanager.Status{State: "active"},
+			},
+		}
+
+		oldEndsAt := am.silences[0].EndsAt
+
+		reconciler := newReconciler(hco)
+		Expect(reconciler.ReconcileSilences(context.TODO())).To(Succeed())
+
+		silences := am.getSilences()
+		Expect(silences).To(HaveLen(2))
+		Expect(silences).ToNot(ContainElement(HaveField("ID", "expiring")))
+		Expect(silences).To(ContainElement(And(
+			HaveField("Comment", "expiring"),
+			HaveField("EndsAt", Not(Equal(oldEndsAt))),
+		)))
+	})
+
+	Context("Reconcile", func() {
+		BeforeEach(func() {
+			Expect(rules.SetupRules()).To(Succeed())
+		})
+
+		It("should report a successful reconciliation in the HyperConverged CR, and poll the silences", func() {
+			hco := commontestutils.NewHco()
+			reconciler := newReconciler(hco)
+
+			res, err := reconciler.Reconcile(context.TODO(), reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.RequeueAfter).To(Equal(observability.DefaultSilencePollInterval))
+
+			Expect(am.getSilences()).To(ContainElement(Satisfy(observability.IsPodDisruptionBudgetAtLimitSilence)))
+
+			foundHC := &hcov1beta1.HyperConverged{}
+			Expect(reconciler.Get(context.TODO(), client.ObjectKeyFromObject(hco), foundHC)).To(Succeed())
+
+			cond := apimetav1.FindStatusCondition(foundHC.Status.Conditions, hcov1beta1.ConditionObservabilityReconciled)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal("ReconcileCompleted"))
+		})
+
+		It("should retry patching the condition on a conflict, and keep the other conditions", func() {
+			hco := commontestutils.NewHco()
+			apimetav1.SetStatusCondition(&hco.Status.Conditions, metav1.Condition{
+				Type:   hcov1beta1.ConditionAvailable,
+				Status: metav1.ConditionTrue,
+				Reason: "ReconcileCompleted",
+			})
+			reconciler := newReconciler(hco)
+
+			sw := reconciler.Client.Status().(*commontestutils.HcoTestStatusWriter)
+			sw.InitiateErrors(apierrors.NewConflict(hcov1beta1.SchemeGroupVersion.WithResource("hyperconvergeds").GroupResource(), hco.Name, errors.New("test error")))
+
+			res, err := reconciler.Reconcile(context.TODO(), reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.RequeueAfter).To(Equal(observability.DefaultSilencePollInterval))
+
+			foundHC := &hcov1beta1.HyperConverged{}
+			Expect(reconciler.Get(context.TODO(), client.ObjectKeyFromObject(hco), foundHC)).To(Succeed())
+
+			Expect(apimetav1.IsStatusConditionTrue(foundHC.Status.Conditions, hcov1beta1.ConditionObservabilityReconciled)).To(BeTrue())
+			Expect(apimetav1.IsStatusConditionTrue(foundHC.Status.Conditions, hcov1beta1.ConditionAvailable)).To(BeTrue())
+		})
+
+		It("should report invalid alert rule overrides in the HyperConverged CR, without retrying", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.AlertRuleOverrides = []hcov1beta1.AlertRuleOverride{
+				{Alert: "PersistentVolumeFillingUp", Disabled: true},
+				{Alert: "HAControlPlaneDown", Threshold: ptr.To("0.5")},
+			}
+			reconciler := newReconciler(hco)
+
+			res, err := reconciler.Reconcile(context.TODO(), reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.RequeueAfter).To(Equal(observability.DefaultSilencePollInterval))
+
+			foundHC := &hcov1beta1.HyperConverged{}
+			Expect(reconciler.Get(context.TODO(), client.ObjectKeyFromObject(hco), foundHC)).To(Succeed())
+
+			cond := apimetav1.FindStatusCondition(foundHC.Status.Conditions, hcov1beta1.ConditionObservabilityReconciled)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("InvalidConfiguration"))
+			Expect(cond.Message).To(ContainSubstring("invalid override of the HAControlPlaneDown alert"))
+		})
+
+		It("should report a failed reconciliation in the HyperConverged CR, and return the error to retry", func() {
+			hco := commontestutils.NewHco()
+			reconciler := newReconciler(hco)
+
+			// the alertmanager is not available
+			ts.Close()
+
+			res, err := reconciler.Reconcile(context.TODO(), reconcile.Request{})
+			Expect(err).To(MatchError(ContainSubstring("failed to list alertmanager silences")))
+			Expect(res.RequeueAfter).To(BeZero())
+
+			foundHC := &hcov1beta1.HyperConverged{}
+			Expect(reconciler.Get(context.TODO(), client.ObjectKeyFromObject(hco), foundHC)).To(Succeed())
+
+			cond := apimetav1.FindStatusCondition(foundHC.Status.Conditions, hcov1beta1.ConditionObservabilityReconciled)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("ReconcileFailed"))
+			Expect(cond.Message).To(ContainSubstring("failed to list alertmanager silences"))
+		})
+	})
+})
+
+var _ = Describe("Alertmanager https client", func() {
+	var (
+		am *fakeAlertmanager
+		ts *httptest.Server
+	)
+
+	BeforeEach(func() {
+		// a self-hosted alertmanager with a self-signed certificate, on a cluster with no OpenShift service CA
+		am = &fakeAlertmanager{}
+		ts = httptest.NewTLSServer(am)
+
+		Expect(os.Setenv(hcoutil.AlertmanagerURLEnvV, ts.URL)).To(Succeed())
+
+		DeferCleanup(func() {
+			ts.Close()
+			Expect(os.Unsetenv(hcoutil.AlertmanagerURLEnvV)).To(Succeed())
+			Expect(os.Unsetenv(hcoutil.AlertmanagerCAFileEnvV)).To(Succeed())
+			Expect(os.Unsetenv(hcoutil.AlertmanagerCAConfigMapEnvV)).To(Succeed())
+		})
+	})
+
+	caPEM := func() []byte {
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	}
+
+	newReconciler := func(objs ...client.Object) *observability.Reconciler {
+		cl := commontestutils.InitClient(objs)
+		mgr, err := commontestutils.NewManagerMock(&rest.Config{}, manager.Options{}, cl, logger)
+		Expect(err).ToNot(HaveOccurred())
+
+		return observability.NewReconciler(mgr, commontestutils.Namespace, &appsv1.Deployment{})
+	}
+
+	It("should fail to verify an unknown CA, with the system CA certificates only", func() {
+		reconciler := newReconciler()
+		Expect(reconciler.ReconcileSilences(context.TODO())).To(MatchError(ContainSubstring("certificate")))
+		Expect(am.getSilences()).To(BeEmpty())
+	})
+
+	It("should trust the CA of the ALERTMANAGER_CA_FILE file", func() {
+		caFile := filepath.Join(GinkgoT().TempDir(), "ca.crt")
+		Expect(os.WriteFile(caFile, caPEM(), 0600)).To(Succeed())
+		Expect(os.Setenv(hcoutil.AlertmanagerCAFileEnvV, caFile)).To(Succeed())
+
+		reconciler := newReconciler()
+		Expect(reconciler.ReconcileSilences(context.TODO())).To(Succeed())
+		Expect(am.getSilences()).To(HaveLen(1))
+	})
+
+	It("should fail if the ALERTMANAGER_CA_FILE file does not exist", func() {
+		Expect(os.Setenv(hcoutil.AlertmanagerCAFileEnvV, filepath.Join(GinkgoT().TempDir(), "missing.crt"))).To(Succeed())
+
+		reconciler := newReconciler()
+		Expect(reconciler.ReconcileSilences(context.TODO())).To(MatchError(ContainSubstring("failed to read the alertmanager CA file")))
+	})
+
+	It("should trust the CA of the ALERTMANAGER_CA_CONFIGMAP ConfigMap", func() {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-ca", Namespace: commontestutils.Namespace},
+			Data:       map[string]string{"ca.crt": string(caPEM())},
+		}
+		Expect(os.Setenv(hcoutil.AlertmanagerCAConfigMapEnvV, cm.Name)).To(Succeed())
+
+		reconciler := newReconciler(cm)
+		Expect(reconciler.ReconcileSilences(context.TODO())).To(Succeed())
+		Expect(am.getSilences()).To(HaveLen(1))
+	})
+
+	It("should fail if the ALERTMANAGER_CA_CONFIGMAP ConfigMap has no valid certificate", func() {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-ca", Namespace: commontestutils.Namespace},
+			Data:       map[string]string{"ca.crt": "not a certificate"},
+		}
+		Expect(os.Setenv(hcoutil.AlertmanagerCAConfigMapEnvV, cm.Name)).To(Succeed())
+
+		reconciler := newReconciler(cm)
+		Expect(reconciler.ReconcileSilences(context.TODO())).To(MatchError(ContainSubstring("no valid CA certificate in the alertmanager CA ConfigMap")))
+	})
+
+	It("should fail if the ALERTMANAGER_CA_CONFIGMAP ConfigMap does not exist", func() {
+		Expect(os.Setenv(hcoutil.AlertmanagerCAConfigMapEnvV, "alertmanager-ca")).To(Succeed())
+
+		reconciler := newReconciler()
+		Expect(reconciler.ReconcileSilences(context.TODO())).To(MatchError(ContainSubstring("failed to read the alertmanager CA ConfigMap")))
+	})
+})
@@ -3,6 +3,8 @@ package operandhandler
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -34,6 +36,7 @@ const (
 	ErrHCOUninstall       = "ErrHCOUninstall"
 	uninstallHCOErrorMsg  = "The uninstall request failed on dependent components, please check their logs."
 	deleteTimeOut         = 30 * time.Second
+	maxOperandDrifts      = 10
 )
 
 var (
@@ -46,6 +49,8 @@ type OperandHandler struct {
 	// save for deletions
 	objects      []client.Object
 	eventEmitter hcoutil.EventEmitter
+	// the MigrationPolicy CRD is deployed; the migration policies of the HyperConverged CR are managed
+	migrationPolicyAvailable bool
 }
 
 func NewOperandHandler(client client.Client, scheme *runtime.Scheme, ci hcoutil.ClusterInfo, eventEmitter hcoutil.EventEmitter) *OperandHandler {
@@ -73,6 +78,14 @@ func NewOperandHandler(client client.Client, scheme *runtime.Scheme, ci hcoutil.
 		}...)
 	}
 
+	if ci.IsNADAvailable() {
+		operandList = append(operandList, handlers.NewMigrationNetworkHandler(client, scheme))
+	}
+
+	if ci.IsMigrationPolicyAvailable() {
+		operandList = append(operandList, handlers.NewMigrationPoliciesHandler(client, scheme))
+	}
+
 	if ci.IsOpenshift() && ci.IsConsolePluginImageProvided() {
 		operandList = append(operandList, handlers.NewConsoleHandler(client))
 		operandList = append(operandList, operands.NewServiceHandler(client, scheme, handlers.NewKvUIPluginSvc))
@@ -84,9 +97,10 @@ func NewOperandHandler(client client.Client, scheme *runtime.Scheme, ci hcoutil.
 	}
 
 	return &OperandHandler{
-		client:       client,
-		operands:     operandList,
-		eventEmitter: eventEmitter,
+		client:                   client,
+		operands:                 operandList,
+		eventEmitter:             eventEmitter,
+		migrationPolicyAvailable: ci.IsMigrationPolicyAvailable(),
 	}
 }
 
@@ -188,7 +202,10 @@ func (h *OperandHandler) addOperand(scheme *runtime.Scheme, hc *hcov1beta1.Hyper
 
 func (h *OperandHandler) Ensure(req *common.HcoRequest) error {
 	for _, handler := range h.operands {
-		res := handler.Ensure(req)
+		start := time.Now()
+		res := ensureOperand(req, handler)
+		metrics.ObserveOperandEnsureDuration(getHandlerType(handler, res), time.Since(start), getEnsureResult(res))
+
 		if res.Err != nil {
 			req.Logger.Error(res.Err, "failed to Ensure an operand")
 
@@ -217,6 +234,44 @@ func (h *OperandHandler) Ensure(req *common.HcoRequest) error {
 
 }
 
+// ensureOperand reconciles the operand, unless it is listed in the HyperConverged spec.unmanagedOperands field. In
+// this case, the operand conditions are reported, but the operand CR is left as is.
+func ensureOperand(req *common.HcoRequest, handler operands.Operand) *operands.EnsureResult {
+	if u, ok := handler.(operands.Unmanageable); ok && slices.Contains(req.Instance.Spec.UnmanagedOperands, u.GetOperandKind()) {
+		return u.EnsureUnmanaged(req)
+	}
+
+	return handler.Ensure(req)
+}
+
+// getHandlerType returns the kind of the operand, for the metrics. If the handler failed before the operand was
+// generated, the kind is not known, so the handler type is used instead.
+func getHandlerType(handler operands.Operand, res *operands.EnsureResult) string {
+	if res.Type != "" {
+		return res.Type
+	}
+
+	t := fmt.Sprintf("%T", handler)
+	return t[strings.LastIndex(t, ".")+1:]
+}
+
+func getEnsureResult(res *operands.EnsureResult) string {
+	switch {
+	case res.Err != nil:
+		return metrics.EnsureResultError
+	case res.Created:
+		return metrics.EnsureResultCreated
+	case res.Overwritten:
+		return metrics.EnsureResultOverwritten
+	case res.Updated:
+		return metrics.EnsureResultUpdated
+	case res.Deleted:
+		return metrics.EnsureResultDeleted
+	default:
+		return metrics.EnsureResultUnchanged
+	}
+}
+
 func (h *OperandHandler) handleUpdatedOperand(req *common.HcoRequest, res *operands.EnsureResult) {
 	if !res.Overwritten {
 		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated %s %s", res.Type, res.Name))
@@ -224,10 +279,29 @@ func (h *OperandHandler) handleUpdatedOperand(req *common.HcoRequest, res *opera
 		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "Overwritten", fmt.Sprintf("Overwritten %s %s", res.Type, res.Name))
 		if !req.UpgradeMode {
 			metrics.IncOverwrittenModifications(res.Type, res.Name)
+			addOperandDrift(req, res)
 		}
 	}
 }
 
+// addOperandDrift records a reverted out-of-band modification in the HyperConverged status. Only the latest
+// maxOperandDrifts modifications are kept.
+func addOperandDrift(req *common.HcoRequest, res *operands.EnsureResult) {
+	drifts := append(req.Instance.Status.OperandDrifts, hcov1beta1.OperandDrift{
+		Kind:       res.Type,
+		Name:       res.Name,
+		RevertTime: metav1.Now(),
+		Fields:     res.DriftedFields,
+	})
+
+	if len(drifts) > maxOperandDrifts {
+		drifts = drifts[len(drifts)-maxOperandDrifts:]
+	}
+
+	req.Instance.Status.OperandDrifts = drifts
+	req.StatusDirty = true
+}
+
 func (h *OperandHandler) EnsureDeleted(req *common.HcoRequest) error {
 
 	tCtx, cancel := context.WithTimeout(req.Ctx, deleteTimeOut)
@@ -244,6 +318,20 @@ func (h *OperandHandler) EnsureDeleted(req *common.HcoRequest) error {
 		passt.NewPasstBindingCNIDaemonSetWithNameOnly(req.Instance),
 		passt.NewPasstBindingCNINetworkAttachmentDefinition(req.Instance),
 		passt.NewPasstBindingCNISecurityContextConstraints(req.Instance),
+		handlers.NewMigrationNetworkNADWithNameOnly(req.Instance),
+	}
+
+	if h.migrationPolicyAvailable {
+		// list the existing MigrationPolicies, to also delete the ones of policies that were already removed from the
+		// HyperConverged CR, or that their deletion failed
+		policies, err := handlers.ListMigrationPolicies(tCtx, h.client)
+		if err != nil {
+			return err
+		}
+
+		for i := range policies {
+			resources = append(resources, &policies[i])
+		}
 	}
 
 	resources = append(resources, h.objects...)
@@ -23,11 +23,14 @@ import (
 
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 )
 
 func TestOperators(t *testing.T) {
@@ -178,6 +181,118 @@ var _ = Describe("Test operandHandler", func() {
 			})
 		})
 
+		It("should report reverted out-of-band modifications in the HyperConverged status", func() {
+			hco := commontestutils.NewHco()
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco, ci.GetCSV()})
+
+			eventEmitter := commontestutils.NewEventEmitterMock()
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+			Expect(req.Instance.Status.OperandDrifts).To(BeEmpty())
+
+			kv := handlers.NewKubeVirtWithNameOnly(hco)
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+			kv.Spec.UninstallStrategy = kubevirtcorev1.KubeVirtUninstallStrategyRemoveWorkloads
+			Expect(cli.Update(req.Ctx, kv)).To(Succeed())
+
+			overwrittenCountBefore, err := metrics.GetOperandEnsureDurationCount("KubeVirt", metrics.EnsureResultOverwritten)
+			Expect(err).ToNot(HaveOccurred())
+
+			handler.Reset()
+			eventEmitter.Reset()
+			req = commontestutils.NewReq(hco)
+			req.HCOTriggered = false
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			Expect(metrics.GetOperandEnsureDurationCount("KubeVirt", metrics.EnsureResultOverwritten)).To(Equal(overwrittenCountBefore + 1))
+
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "Overwritten",
+					Msg:       "Overwritten KubeVirt kubevirt-kubevirt-hyperconverged",
+				},
+			})).To(BeTrue())
+
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.OperandDrifts).To(HaveLen(1))
+			drift := req.Instance.Status.OperandDrifts[0]
+			Expect(drift.Kind).To(Equal("KubeVirt"))
+			Expect(drift.Name).To(Equal("kubevirt-kubevirt-hyperconverged"))
+			Expect(drift.RevertTime.IsZero()).To(BeFalse())
+			Expect(drift.Fields).To(ContainElement(hcov1beta1.DriftedField{
+				Path:     "/spec/uninstallStrategy",
+				Previous: `"RemoveWorkloads"`,
+				Desired:  `"BlockUninstallIfWorkloadsExist"`,
+			}))
+		})
+
+		It("should not modify unmanaged operands, but should still report their conditions", func() {
+			hco := commontestutils.NewHco()
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco, ci.GetCSV()})
+
+			eventEmitter := commontestutils.NewEventEmitterMock()
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			cna := handlers.NewNetworkAddonsWithNameOnly(hco)
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(cna), cna)).To(Succeed())
+			cna.Spec.KubeMacPool = nil
+			cna.Status.Conditions = nil
+			Expect(cli.Update(req.Ctx, cna)).To(Succeed())
+
+			hco.Spec.UnmanagedOperands = []hcov1beta1.OperandKind{hcov1beta1.OperandKindNetworkAddonsConfig}
+			hco.Status.RelatedObjects = nil
+			handler.Reset()
+			eventEmitter.Reset()
+			req = commontestutils.NewReq(hco)
+			req.HCOTriggered = false
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "Overwritten",
+					Msg:       "Overwritten NetworkAddonsConfig cluster",
+				},
+			})).To(BeFalse())
+
+			foundCna := handlers.NewNetworkAddonsWithNameOnly(hco)
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(foundCna), foundCna)).To(Succeed())
+			Expect(foundCna.Spec.KubeMacPool).To(BeNil())
+
+			cnaRef, err := reference.GetReference(commontestutils.GetScheme(), foundCna)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hco.Status.RelatedObjects).To(ContainElement(*cnaRef))
+
+			Expect(req.Conditions).To(HaveKey(hcov1beta1.ConditionAvailable))
+		})
+
+		It("should keep only the latest operand drifts", func() {
+			hco := commontestutils.NewHco()
+			req := commontestutils.NewReq(hco)
+
+			for i := range maxOperandDrifts + 2 {
+				res := &operands.EnsureResult{Type: "ConfigMap", Name: fmt.Sprintf("cm%d", i)}
+				addOperandDrift(req, res)
+			}
+
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.OperandDrifts).To(HaveLen(maxOperandDrifts))
+			Expect(req.Instance.Status.OperandDrifts[0].Name).To(Equal("cm2"))
+			Expect(req.Instance.Status.OperandDrifts[maxOperandDrifts-1].Name).To(Equal(fmt.Sprintf("cm%d", maxOperandDrifts+1)))
+		})
+
 		It("should handle errors on Ensure loop", func() {
 			hco := commontestutils.NewHco()
 			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
@@ -200,7 +315,11 @@ var _ = Describe("Test operandHandler", func() {
 				return nil
 			})
 
+			errorCountBefore, err := metrics.GetOperandEnsureDurationCount("CDI", metrics.EnsureResultError)
+			Expect(err).ToNot(HaveOccurred())
+
 			Expect(handler.Ensure(req)).To(Equal(fakeError))
+			Expect(metrics.GetOperandEnsureDurationCount("CDI", metrics.EnsureResultError)).To(Equal(errorCountBefore + 1))
 
 			Expect(req.ComponentUpgradeInProgress).To(BeFalse())
 			cond := req.Conditions[hcov1beta1.ConditionReconcileComplete]
@@ -306,6 +425,34 @@ var _ = Describe("Test operandHandler", func() {
 			})
 		})
 
+		It("should delete the MigrationPolicies of the HyperConverged CR, including orphaned ones", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.MigrationPolicies = []hcov1beta1.LiveMigrationPolicy{{Name: "large-vms", AllowPostCopy: ptr.To(true)}}
+
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco, ci.GetCSV()})
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, commontestutils.NewEventEmitterMock())
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			// a MigrationPolicy of a policy that is not in the HyperConverged CR, without the policy label
+			orphaned := handlers.NewMigrationPolicyWithNameOnly("removed")
+			delete(orphaned.Labels, handlers.MigrationPolicyLabel)
+			Expect(cli.Create(req.Ctx, orphaned)).To(Succeed())
+
+			mpList := &migrationsv1alpha1.MigrationPolicyList{}
+			Expect(cli.List(req.Ctx, mpList)).To(Succeed())
+			Expect(mpList.Items).To(HaveLen(2))
+
+			Expect(handler.EnsureDeleted(req)).To(Succeed())
+
+			Expect(cli.List(req.Ctx, mpList)).To(Succeed())
+			Expect(mpList.Items).To(BeEmpty())
+		})
+
 		It("delete KV error handling", func() {
 			hco := commontestutils.NewHco()
 			ci := commontestutils.ClusterInfoMock{}
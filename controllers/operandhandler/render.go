@@ -0,0 +1,219 @@
+package operandhandler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	csvv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// ClusterFacts are the cluster properties that affect the operand CRs generated from the HyperConverged CR. They
+// replace the actual cluster when rendering the operands offline.
+type ClusterFacts struct {
+	Openshift                     bool     `json:"openshift,omitempty"`
+	ManagedByOLM                  bool     `json:"managedByOLM,omitempty"`
+	ConsolePluginImageProvided    bool     `json:"consolePluginImageProvided,omitempty"`
+	MonitoringAvailable           bool     `json:"monitoringAvailable,omitempty"`
+	DeschedulerAvailable          bool     `json:"deschedulerAvailable,omitempty"`
+	NADAvailable                  bool     `json:"nadAvailable,omitempty"`
+	MigrationPolicyAvailable      bool     `json:"migrationPolicyAvailable,omitempty"`
+	SingleStackIPv6               bool     `json:"singleStackIPv6,omitempty"`
+	BaseDomain                    string   `json:"baseDomain,omitempty"`
+	ControlPlaneHighlyAvailable   bool     `json:"controlPlaneHighlyAvailable,omitempty"`
+	ControlPlaneNodeExists        bool     `json:"controlPlaneNodeExists,omitempty"`
+	InfrastructureHighlyAvailable bool     `json:"infrastructureHighlyAvailable,omitempty"`
+	ControlPlaneArchitectures     []string `json:"controlPlaneArchitectures,omitempty"`
+	WorkloadsArchitectures        []string `json:"workloadsArchitectures,omitempty"`
+
+	// APIServerTLSSecurityProfile is the TLS security profile of the cluster APIServer CR, used when the
+	// HyperConverged CR does not set its own one.
+	APIServerTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile `json:"apiServerTLSSecurityProfile,omitempty"`
+}
+
+// Render returns the desired state of all the operands that HCO would deploy for the given HyperConverged CR, as
+// generated by their GetFullCr hooks. Nothing is read from or written to an actual cluster; the cluster is
+// simulated by the given facts.
+//
+// Render temporarily replaces the global cluster info and node info functions, so it must not run concurrently with
+// the operator reconcile loop.
+func Render(hc *hcov1beta1.HyperConverged, facts ClusterFacts, scheme *runtime.Scheme) ([]client.Object, error) {
+	ci := &renderClusterInfo{facts: facts}
+	restore := facts.apply(ci)
+	defer restore()
+
+	hc = hc.DeepCopy()
+	hcov1beta1.SetObjectDefaults_HyperConverged(hc)
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).Build()
+	h := NewOperandHandler(cli, scheme, ci, nil)
+	h.FirstUseInitiation(scheme, ci, hc)
+
+	return h.render(hc, scheme)
+}
+
+func (h *OperandHandler) render(hc *hcov1beta1.HyperConverged, scheme *runtime.Scheme) ([]client.Object, error) {
+	objects := make([]client.Object, 0, len(h.operands))
+	for _, handler := range h.operands {
+		if ch, ok := handler.(*operands.ConditionalHandler); ok && !ch.ShouldDeploy(hc) {
+			continue
+		}
+
+		// operands that are not CRGetters, like the CSV handler, only patch existing objects
+		gh, ok := handler.(operands.CRGetter)
+		if !ok {
+			continue
+		}
+
+		obj, err := gh.GetFullCr(hc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render operand %T: %w", handler, err)
+		}
+
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return nil, err
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+func (f ClusterFacts) apply(ci hcoutil.ClusterInfo) func() {
+	origGetClusterInfo := hcoutil.GetClusterInfo
+	origIsControlPlaneHighlyAvailable := nodeinfo.IsControlPlaneHighlyAvailable
+	origIsControlPlaneNodeExists := nodeinfo.IsControlPlaneNodeExists
+	origIsInfrastructureHighlyAvailable := nodeinfo.IsInfrastructureHighlyAvailable
+	origGetControlPlaneArchitectures := nodeinfo.GetControlPlaneArchitectures
+	origGetWorkloadsArchitectures := nodeinfo.GetWorkloadsArchitectures
+
+	hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+		return ci
+	}
+	nodeinfo.IsControlPlaneHighlyAvailable = func() bool {
+		return f.ControlPlaneHighlyAvailable
+	}
+	nodeinfo.IsControlPlaneNodeExists = func() bool {
+		return f.ControlPlaneNodeExists
+	}
+	nodeinfo.IsInfrastructureHighlyAvailable = func() bool {
+		return f.InfrastructureHighlyAvailable
+	}
+	nodeinfo.GetControlPlaneArchitectures = func() []string {
+		return f.ControlPlaneArchitectures
+	}
+	nodeinfo.GetWorkloadsArchitectures = func() []string {
+		return f.WorkloadsArchitectures
+	}
+
+	return func() {
+		hcoutil.GetClusterInfo = origGetClusterInfo
+		nodeinfo.IsControlPlaneHighlyAvailable = origIsControlPlaneHighlyAvailable
+		nodeinfo.IsControlPlaneNodeExists = origIsControlPlaneNodeExists
+		nodeinfo.IsInfrastructureHighlyAvailable = origIsInfrastructureHighlyAvailable
+		nodeinfo.GetControlPlaneArchitectures = origGetControlPlaneArchitectures
+		nodeinfo.GetWorkloadsArchitectures = origGetWorkloadsArchitectures
+	}
+}
+
+// renderClusterInfo implements the ClusterInfo interface from a static set of cluster facts
+type renderClusterInfo struct {
+	facts ClusterFacts
+}
+
+var _ hcoutil.ClusterInfo = &renderClusterInfo{}
+
+func (c renderClusterInfo) Init(_ context.Context, _ client.Client, _ logr.Logger) error {
+	return nil
+}
+
+func (c renderClusterInfo) IsOpenshift() bool {
+	return c.facts.Openshift
+}
+
+func (c renderClusterInfo) IsRunningLocally() bool {
+	return false
+}
+
+func (c renderClusterInfo) GetBaseDomain() string {
+	return c.facts.BaseDomain
+}
+
+func (c renderClusterInfo) IsManagedByOLM() bool {
+	return c.facts.ManagedByOLM
+}
+
+func (c renderClusterInfo) IsConsolePluginImageProvided() bool {
+	return c.facts.ConsolePluginImageProvided
+}
+
+func (c renderClusterInfo) IsMonitoringAvailable() bool {
+	return c.facts.MonitoringAvailable
+}
+
+func (c renderClusterInfo) IsDeschedulerAvailable() bool {
+	return c.facts.DeschedulerAvailable
+}
+
+func (c renderClusterInfo) IsNADAvailable() bool {
+	return c.facts.NADAvailable
+}
+
+func (c renderClusterInfo) IsMigrationPolicyAvailable() bool {
+	return c.facts.MigrationPolicyAvailable
+}
+
+func (c renderClusterInfo) IsDeschedulerCRDDeployed(_ context.Context, _ client.Client) bool {
+	return c.facts.DeschedulerAvailable
+}
+
+func (c renderClusterInfo) IsMigrationPolicyCRDDeployed(_ context.Context, _ client.Client) bool {
+	return c.facts.MigrationPolicyAvailable
+}
+
+func (c renderClusterInfo) IsSingleStackIPv6() bool {
+	return c.facts.SingleStackIPv6
+}
+
+func (c renderClusterInfo) GetTLSSecurityProfile(hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *openshiftconfigv1.TLSSecurityProfile {
+	if hcoTLSSecurityProfile != nil {
+		return hcoTLSSecurityProfile
+	} else if c.facts.APIServerTLSSecurityProfile != nil {
+		return c.facts.APIServerTLSSecurityProfile
+	}
+	return &openshiftconfigv1.TLSSecurityProfile{
+		Type:         openshiftconfigv1.TLSProfileIntermediateType,
+		Intermediate: &openshiftconfigv1.IntermediateTLSProfile{},
+	}
+}
+
+func (c renderClusterInfo) RefreshAPIServerCR(_ context.Context, _ client.Client) error {
+	return nil
+}
+
+func (c renderClusterInfo) GetPod() *corev1.Pod {
+	return &corev1.Pod{}
+}
+
+func (c renderClusterInfo) GetDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{}
+}
+
+func (c renderClusterInfo) GetCSV() *csvv1alpha1.ClusterServiceVersion {
+	return &csvv1alpha1.ClusterServiceVersion{}
+}
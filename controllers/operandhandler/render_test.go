@@ -0,0 +1,92 @@
+package operandhandler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	sspv1beta3 "kubevirt.io/ssp-operator/api/v1beta3"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Test Render", func() {
+	origLogger := logger
+	BeforeEach(func() {
+		logger = GinkgoLogr
+		DeferCleanup(func() {
+			logger = origLogger
+		})
+	})
+
+	findObject := func(objects []client.Object, kind string) client.Object {
+		for _, obj := range objects {
+			if obj.GetObjectKind().GroupVersionKind().Kind == kind {
+				return obj
+			}
+		}
+		return nil
+	}
+
+	It("should render the operands for kubernetes", func() {
+		hco := commontestutils.NewHco()
+
+		objects, err := Render(hco, ClusterFacts{}, commontestutils.GetScheme())
+		Expect(err).ToNot(HaveOccurred())
+
+		kv := findObject(objects, "KubeVirt")
+		Expect(kv).To(BeAssignableToTypeOf(&kubevirtcorev1.KubeVirt{}))
+		Expect(kv.GetName()).To(Equal("kubevirt-kubevirt-hyperconverged"))
+
+		Expect(findObject(objects, "CDI")).To(BeAssignableToTypeOf(&cdiv1beta1.CDI{}))
+		Expect(findObject(objects, "NetworkAddonsConfig")).To(BeAssignableToTypeOf(&networkaddonsv1.NetworkAddonsConfig{}))
+		Expect(findObject(objects, "SSP")).To(BeNil())
+		Expect(findObject(objects, "AAQ")).To(BeNil())
+	})
+
+	It("should render the openshift only operands", func() {
+		hco := commontestutils.NewHco()
+
+		objects, err := Render(hco, ClusterFacts{Openshift: true, WorkloadsArchitectures: []string{"amd64"}}, commontestutils.GetScheme())
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(findObject(objects, "SSP")).To(BeAssignableToTypeOf(&sspv1beta3.SSP{}))
+	})
+
+	It("should render conditional operands only if they are enabled", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.EnableApplicationAwareQuota = ptr.To(true)
+
+		objects, err := Render(hco, ClusterFacts{}, commontestutils.GetScheme())
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(findObject(objects, "AAQ")).To(BeAssignableToTypeOf(&aaqv1alpha1.AAQ{}))
+	})
+
+	It("should use the simulated cluster facts, and restore the originals when done", func() {
+		origClusterInfo := hcoutil.GetClusterInfo()
+		hco := commontestutils.NewHco()
+
+		objects, err := Render(hco, ClusterFacts{InfrastructureHighlyAvailable: true}, commontestutils.GetScheme())
+		Expect(err).ToNot(HaveOccurred())
+
+		kv := findObject(objects, "KubeVirt").(*kubevirtcorev1.KubeVirt)
+		Expect(kv.Spec.Infra).ToNot(BeNil())
+		Expect(kv.Spec.Infra.Replicas).To(BeNil())
+
+		objects, err = Render(hco, ClusterFacts{}, commontestutils.GetScheme())
+		Expect(err).ToNot(HaveOccurred())
+
+		kv = findObject(objects, "KubeVirt").(*kubevirtcorev1.KubeVirt)
+		Expect(kv.Spec.Infra).ToNot(BeNil())
+		Expect(kv.Spec.Infra.Replicas).To(HaveValue(Equal(uint8(1))))
+
+		Expect(hcoutil.GetClusterInfo()).To(BeIdenticalTo(origClusterInfo))
+	})
+})
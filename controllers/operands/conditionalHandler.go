@@ -37,6 +37,26 @@ func (ch *ConditionalHandler) Ensure(req *common.HcoRequest) *EnsureResult {
 	return ch.ensureDeleted(req)
 }
 
+func (ch *ConditionalHandler) GetOperandKind() v1beta1.OperandKind {
+	return ch.operand.GetOperandKind()
+}
+
+// EnsureUnmanaged reports the operand conditions if it should be deployed. Unlike Ensure, it never removes the CR.
+// Like any unmanaged operand, its upgrade is never done.
+func (ch *ConditionalHandler) EnsureUnmanaged(req *common.HcoRequest) *EnsureResult {
+	if ch.shouldDeploy(req.Instance) {
+		return ch.operand.EnsureUnmanaged(req)
+	}
+
+	cr := ch.getCRWithName(req.Instance)
+	return NewEnsureResult(cr).SetName(cr.GetName()).SetUpgradeDone(false)
+}
+
+// ShouldDeploy returns true if the operand CR is expected to exist for the given HyperConverged CR
+func (ch *ConditionalHandler) ShouldDeploy(hc *v1beta1.HyperConverged) bool {
+	return ch.shouldDeploy(hc)
+}
+
 func (ch *ConditionalHandler) Reset() {
 	ch.operand.Reset()
 }
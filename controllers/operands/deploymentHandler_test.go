@@ -9,6 +9,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
@@ -175,6 +176,81 @@ var _ = Describe("Deployment Handler", func() {
 
 	})
 
+	Context("report the component health", func() {
+		var (
+			hco *hcov1beta1.HyperConverged
+			req *common.HcoRequest
+		)
+
+		BeforeEach(func() {
+			hco = commontestutils.NewHco()
+			req = commontestutils.NewReq(hco)
+		})
+
+		It("should not report the Deployment if it is not part of a component", func() {
+			cl := commontestutils.InitClient([]client.Object{NewExpectedDeployment(hco)})
+
+			handler := NewDeploymentHandler(cl, commontestutils.GetScheme(), NewExpectedDeployment, hco)
+			Expect(handler.Ensure(req).Err).ToNot(HaveOccurred())
+
+			Expect(req.ComponentConditions).To(BeEmpty())
+		})
+
+		It("should report the Deployment as not available, if some of its replicas are not available", func() {
+			deployment := NewExpectedDeployment(hco)
+			deployment.Spec.Replicas = ptr.To[int32](2)
+			deployment.Status.AvailableReplicas = 1
+			deployment.Status.UpdatedReplicas = 2
+
+			cl := commontestutils.InitClient([]client.Object{deployment})
+
+			handler := NewDeploymentHandler(cl, commontestutils.GetScheme(), func(hc *hcov1beta1.HyperConverged) *appsv1.Deployment {
+				dep := NewExpectedDeployment(hc)
+				dep.Spec.Replicas = ptr.To[int32](2)
+				return dep
+			}, hco).WithComponent("MyComponent")
+			Expect(handler.Ensure(req).Err).ToNot(HaveOccurred())
+
+			Expect(req.ComponentConditions).To(HaveKey("MyComponent"))
+			conds := req.ComponentConditions["MyComponent"]
+			Expect(conds[hcov1beta1.ConditionAvailable].Status).To(Equal(metav1.ConditionFalse))
+			Expect(conds[hcov1beta1.ConditionAvailable].Message).To(Equal("Deployment modifiedDeployment: 1 of 2 replicas are available"))
+			Expect(conds[hcov1beta1.ConditionProgressing].Status).To(Equal(metav1.ConditionFalse))
+			Expect(conds[hcov1beta1.ConditionDegraded].Status).To(Equal(metav1.ConditionFalse))
+		})
+
+		It("should report the Deployment as progressing, if the latest generation was not observed yet", func() {
+			deployment := NewExpectedDeployment(hco)
+			deployment.Generation = 2
+			deployment.Status.ObservedGeneration = 1
+			deployment.Status.AvailableReplicas = 1
+			deployment.Status.UpdatedReplicas = 1
+
+			cl := commontestutils.InitClient([]client.Object{deployment})
+
+			handler := NewDeploymentHandler(cl, commontestutils.GetScheme(), NewExpectedDeployment, hco).WithComponent("MyComponent")
+			Expect(handler.Ensure(req).Err).ToNot(HaveOccurred())
+
+			conds := req.ComponentConditions["MyComponent"]
+			Expect(conds[hcov1beta1.ConditionAvailable].Status).To(Equal(metav1.ConditionTrue))
+			Expect(conds[hcov1beta1.ConditionProgressing].Status).To(Equal(metav1.ConditionTrue))
+			Expect(conds[hcov1beta1.ConditionProgressing].Reason).To(Equal("RolloutInProgress"))
+		})
+
+		It("should report a new Deployment as not available", func() {
+			cl := commontestutils.InitClient([]client.Object{})
+
+			handler := NewDeploymentHandler(cl, commontestutils.GetScheme(), NewExpectedDeployment, hco).WithComponent("MyComponent")
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			conds := req.ComponentConditions["MyComponent"]
+			Expect(conds[hcov1beta1.ConditionAvailable].Status).To(Equal(metav1.ConditionFalse))
+			Expect(conds[hcov1beta1.ConditionProgressing].Status).To(Equal(metav1.ConditionTrue))
+		})
+	})
+
 })
 
 func NewExpectedDeployment(_ *hcov1beta1.HyperConverged) *appsv1.Deployment {
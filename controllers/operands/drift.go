@@ -0,0 +1,106 @@
+package operands
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+const (
+	maxDriftedFields    = 10
+	maxDriftValueLength = 256
+)
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// GetDriftedFields compares two versions of the same operand object, e.g. as it was found in the cluster and after HCO
+// reverted it to its desired state, and returns the modified fields.
+//
+// Only the object content is compared. The status and the metadata fields, except for the labels and the
+// annotations, are ignored.
+func GetDriftedFields(found, reverted runtime.Object) ([]hcov1beta1.DriftedField, error) {
+	foundMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(found)
+	if err != nil {
+		return nil, err
+	}
+
+	revertedMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(reverted)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []hcov1beta1.DriftedField
+	diffValues("", trimNonContentFields(foundMap), trimNonContentFields(revertedMap), &fields)
+
+	return fields, nil
+}
+
+func trimNonContentFields(obj map[string]interface{}) map[string]interface{} {
+	delete(obj, "status")
+
+	if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+		trimmed := map[string]interface{}{}
+		for _, field := range []string{"labels", "annotations"} {
+			if value, exists := metadata[field]; exists {
+				trimmed[field] = value
+			}
+		}
+		obj["metadata"] = trimmed
+	}
+
+	return obj
+}
+
+func diffValues(path string, previous, desired interface{}, fields *[]hcov1beta1.DriftedField) {
+	if len(*fields) >= maxDriftedFields || reflect.DeepEqual(previous, desired) {
+		return
+	}
+
+	previousMap, previousIsMap := previous.(map[string]interface{})
+	desiredMap, desiredIsMap := desired.(map[string]interface{})
+	if previousIsMap && desiredIsMap {
+		keys := make([]string, 0, len(previousMap)+len(desiredMap))
+		for key := range previousMap {
+			keys = append(keys, key)
+		}
+		for key := range desiredMap {
+			if _, exists := previousMap[key]; !exists {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			diffValues(path+"/"+jsonPointerEscaper.Replace(key), previousMap[key], desiredMap[key], fields)
+		}
+		return
+	}
+
+	*fields = append(*fields, hcov1beta1.DriftedField{
+		Path:     path,
+		Previous: driftValueToString(previous),
+		Desired:  driftValueToString(desired),
+	})
+}
+
+func driftValueToString(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	if len(valueBytes) > maxDriftValueLength {
+		return string(valueBytes[:maxDriftValueLength]) + "..."
+	}
+
+	return string(valueBytes)
+}
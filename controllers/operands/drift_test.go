@@ -0,0 +1,83 @@
+package operands
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+var _ = Describe("Test GetDriftedFields", func() {
+	var required *corev1.ConfigMap
+
+	BeforeEach(func() {
+		required = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cm",
+				Namespace: "ns",
+				Labels: map[string]string{
+					"app": "hco",
+				},
+			},
+			Data: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		}
+	})
+
+	It("should return an empty list if nothing was modified", func() {
+		found := required.DeepCopy()
+		found.ResourceVersion = "12345"
+		found.Generation = 5
+
+		fields, err := GetDriftedFields(found, required)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fields).To(BeEmpty())
+	})
+
+	It("should return the modified, added and removed fields as JSON pointers", func() {
+		found := required.DeepCopy()
+		found.Data["key1"] = "modified"
+		delete(found.Data, "key2")
+		found.Data["my/key"] = "added"
+		found.Labels["app"] = "other"
+
+		fields, err := GetDriftedFields(found, required)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fields).To(Equal([]hcov1beta1.DriftedField{
+			{Path: "/data/key1", Previous: `"modified"`, Desired: `"value1"`},
+			{Path: "/data/key2", Previous: "", Desired: `"value2"`},
+			{Path: "/data/my~1key", Previous: `"added"`, Desired: ""},
+			{Path: "/metadata/labels/app", Previous: `"other"`, Desired: `"hco"`},
+		}))
+	})
+
+	It("should limit the number of the fields", func() {
+		found := required.DeepCopy()
+		for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"} {
+			found.Data[key] = key
+		}
+
+		fields, err := GetDriftedFields(found, required)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fields).To(HaveLen(maxDriftedFields))
+	})
+
+	It("should truncate long values", func() {
+		found := required.DeepCopy()
+		longValue := make([]byte, maxDriftValueLength*2)
+		for i := range longValue {
+			longValue[i] = 'x'
+		}
+		found.Data["key1"] = string(longValue)
+
+		fields, err := GetDriftedFields(found, required)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fields).To(HaveLen(1))
+		Expect(fields[0].Previous).To(HaveLen(maxDriftValueLength + len("...")))
+		Expect(fields[0].Previous).To(HaveSuffix("..."))
+	})
+})
@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 )
 
 type EnsureResult struct {
@@ -16,6 +18,8 @@ type EnsureResult struct {
 	Err         error
 	Type        string
 	Name        string
+	// DriftedFields are the fields that were modified out-of-band and then reverted. Only set if Overwritten is true.
+	DriftedFields []hcov1beta1.DriftedField
 }
 
 func NewEnsureResult(resource runtime.Object) *EnsureResult {
@@ -44,6 +48,11 @@ func (r *EnsureResult) SetOverwritten(overwritten bool) *EnsureResult {
 	return r
 }
 
+func (r *EnsureResult) SetDriftedFields(fields []hcov1beta1.DriftedField) *EnsureResult {
+	r.DriftedFields = fields
+	return r
+}
+
 func (r *EnsureResult) SetUpgradeDone(upgradeDone bool) *EnsureResult {
 	r.UpgradeDone = upgradeDone
 	return r
@@ -7,6 +7,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	kubevirtv1 "kubevirt.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 )
 
 var _ = Describe("HyperConverged Ensure Result", func() {
@@ -38,6 +40,16 @@ var _ = Describe("HyperConverged Ensure Result", func() {
 			Expect(er.Err).ToNot(HaveOccurred())
 		})
 
+		It("Should update DriftedFields", func() {
+			er := NewEnsureResult(kv)
+			er.SetUpdated().SetOverwritten(true).SetDriftedFields([]hcov1beta1.DriftedField{{Path: "/spec/a", Previous: "1", Desired: "2"}})
+
+			Expect(er.Updated).To(BeTrue())
+			Expect(er.Overwritten).To(BeTrue())
+			Expect(er.DriftedFields).To(HaveLen(1))
+			Expect(er.DriftedFields[0].Path).To(Equal("/spec/a"))
+		})
+
 		It("Should update UpgradeDone", func() {
 			er := NewEnsureResult(kv)
 			er.SetUpgradeDone(true)
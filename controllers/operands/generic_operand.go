@@ -24,6 +24,9 @@ type GenericOperand struct {
 
 	// printable resource name
 	crType string
+	// the name of the component in the componentsHealth status field. The operand CRs are reported by their kind,
+	// if not set. The other resources are reported only if it is set.
+	component string
 	// Should the handler add the controller reference
 	setControllerReference bool
 	// Set of resource handler hooks, to be implemented in each handler
@@ -40,6 +43,13 @@ func NewGenericOperand(client client.Client, scheme *runtime.Scheme, crType stri
 	}
 }
 
+// WithComponent sets the name of the component that the resource is part of, to report its health in the
+// componentsHealth status field
+func (h *GenericOperand) WithComponent(component string) *GenericOperand {
+	h.component = component
+	return h
+}
+
 func (h *GenericOperand) Ensure(req *common.HcoRequest) *EnsureResult {
 	cr, err := h.hooks.GetFullCr(req.Instance)
 	if err != nil {
@@ -61,6 +71,10 @@ func (h *GenericOperand) Ensure(req *common.HcoRequest) *EnsureResult {
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			res = h.createNewCr(req, cr, res)
+			if res.Err == nil {
+				// the new resource has no status yet
+				h.reportComponentHealth(req, nil)
+			}
 			if apierrors.IsAlreadyExists(res.Err) {
 				// we failed trying to create it due to a caching error
 				// or we neither tried because we know that the object is already there for sure,
@@ -82,9 +96,54 @@ func (h *GenericOperand) Ensure(req *common.HcoRequest) *EnsureResult {
 	return res
 }
 
+func (h *GenericOperand) GetOperandKind() hcov1beta1.OperandKind {
+	return hcov1beta1.OperandKind(h.crType)
+}
+
+// EnsureUnmanaged only reads the operand CR, and reports its conditions. The CR is not created if it is missing,
+// and it is not updated if it is different from the required CR. The upgrade of an unmanaged operand is never done,
+// so HCO upgrade can't be completed while an operand is unmanaged.
+func (h *GenericOperand) EnsureUnmanaged(req *common.HcoRequest) *EnsureResult {
+	cr, err := h.hooks.GetFullCr(req.Instance)
+	if err != nil {
+		return &EnsureResult{
+			Err: err,
+		}
+	}
+
+	res := NewEnsureResult(cr)
+
+	key := client.ObjectKeyFromObject(cr)
+	res.SetName(key.Name)
+	found := h.hooks.GetEmptyCr()
+	if err = h.Get(req.Ctx, key, found); err != nil {
+		if apierrors.IsNotFound(err) {
+			req.Logger.Info(h.crType+" is unmanaged, and does not exist; skipping it", h.crType+".Name", key.Name)
+			return res.SetUpgradeDone(false)
+		}
+		return res.Error(err)
+	}
+
+	req.Logger.Info(h.crType+" is unmanaged; skipping its reconciliation", h.crType+".Name", key.Name)
+
+	if err = h.addCrToTheRelatedObjectList(req, found); err != nil {
+		return res.Error(err)
+	}
+
+	h.reportComponentHealth(req, found)
+
+	if opr, ok := h.hooks.(HCOOperandHooks); ok {
+		// only report the conditions; the upgrade is not done even if the operand is already upgraded
+		h.completeEnsureOperands(req, opr, found, res)
+	}
+	return res.SetUpgradeDone(false)
+}
+
 func (h *GenericOperand) handleExistingCr(req *common.HcoRequest, key client.ObjectKey, found client.Object, cr client.Object, res *EnsureResult) *EnsureResult {
 	req.Logger.Info(h.crType+" already exists", h.crType+".Namespace", key.Namespace, h.crType+".Name", key.Name)
 
+	// UpdateCR modifies the found object, so keep its original state for the drift report
+	foundBeforeUpdate := found.DeepCopyObject()
 	updated, overwritten, err := h.hooks.UpdateCR(req, h.Client, found, cr)
 	if err != nil {
 		return res.Error(err)
@@ -102,8 +161,13 @@ func (h *GenericOperand) handleExistingCr(req *common.HcoRequest, key client.Obj
 		return res.Error(err)
 	}
 
+	h.reportComponentHealth(req, found)
+
 	if updated {
 		req.StatusDirty = true
+		if overwritten {
+			h.setDriftedFields(req, foundBeforeUpdate, found, res)
+		}
 		return res.SetUpdated().SetOverwritten(overwritten)
 	}
 
@@ -114,6 +178,16 @@ func (h *GenericOperand) handleExistingCr(req *common.HcoRequest, key client.Obj
 	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
 }
 
+func (h *GenericOperand) setDriftedFields(req *common.HcoRequest, foundBeforeUpdate, found runtime.Object, res *EnsureResult) {
+	fields, err := GetDriftedFields(foundBeforeUpdate, found)
+	if err != nil {
+		// the drift report is informative only; don't fail the reconciliation because of it
+		req.Logger.Error(err, "failed to compute the out-of-band modifications of "+h.crType)
+		return
+	}
+	res.SetDriftedFields(fields)
+}
+
 func (h *GenericOperand) handleExistingCrSkipCache(req *common.HcoRequest, key client.ObjectKey, found client.Object, cr client.Object, res *EnsureResult) *EnsureResult {
 	cfg, configerr := config.GetConfig()
 	if configerr != nil {
@@ -161,6 +235,36 @@ func (h *GenericOperand) completeEnsureOperands(req *common.HcoRequest, opr HCOO
 	return res.SetUpgradeDone(upgradeDone)
 }
 
+// reportComponentHealth records the conditions of the component in the request, for the componentsHealth status
+// field. found is nil if the resource was just created, and so it has no status yet.
+func (h *GenericOperand) reportComponentHealth(req *common.HcoRequest, found client.Object) {
+	var conditions []metav1.Condition
+
+	switch hooks := h.hooks.(type) {
+	case HCOOperandHooks:
+		if found != nil {
+			conditions = hooks.GetConditions(found)
+		}
+		req.ComponentConditions.SetComponentConditions(h.getComponentName(), conditions)
+
+	case HCOWorkloadHooks:
+		if h.component == "" {
+			return
+		}
+		if found != nil {
+			conditions = hooks.GetWorkloadConditions(found)
+		}
+		req.ComponentConditions.SetComponentConditions(h.component, conditions)
+	}
+}
+
+func (h *GenericOperand) getComponentName() string {
+	if h.component != "" {
+		return h.component
+	}
+	return h.crType
+}
+
 func (h *GenericOperand) addCrToTheRelatedObjectList(req *common.HcoRequest, found client.Object) error {
 
 	changed, err := hcoutil.AddCrToTheRelatedObjectList(&req.Instance.Status.RelatedObjects, found, h.Scheme)
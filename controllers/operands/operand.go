@@ -50,6 +50,23 @@ type HCOOperandHooks interface {
 	CheckComponentVersion(runtime.Object) bool
 }
 
+// HCOWorkloadHooks Set of hooks of workloads that HCO deploys directly, like deployments and daemon sets. Unlike the
+// operand CRs, the workloads have no conditions, so they are computed from the workload status.
+type HCOWorkloadHooks interface {
+	HCOResourceHooks
+	// GetWorkloadConditions computes the workload conditions from its status
+	GetWorkloadConditions(runtime.Object) []metav1.Condition
+}
+
+// Unmanageable is an operand that can be left unmanaged by HCO, using the spec.unmanagedOperands field of the
+// HyperConverged CR.
+type Unmanageable interface {
+	// GetOperandKind returns the kind of the operand CR
+	GetOperandKind() hcov1beta1.OperandKind
+	// EnsureUnmanaged reads the operand CR and reports its conditions, without modifying it.
+	EnsureUnmanaged(req *common.HcoRequest) *EnsureResult
+}
+
 type Reseter interface {
 	// Reset handler cached, if exists
 	Reset()
@@ -0,0 +1,97 @@
+package operands
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+const (
+	workloadAvailableReason      = "AllReplicasAvailable"
+	workloadNotAvailableReason   = "ReplicasNotAvailable"
+	workloadRolloutReason        = "RolloutInProgress"
+	workloadRolloutDoneReason    = "RolloutCompleted"
+	workloadNotDegradedReason    = "AsExpected"
+	workloadNotDegradedMessage   = "the workload is not degraded"
+	workloadReplicasMessageFmt   = "%s %s: %d of %d replicas are available"
+	workloadRolloutMessageFmt    = "%s %s: %d of %d replicas are updated"
+	workloadGenerationMessageFmt = "%s %s: the latest generation was not observed yet"
+)
+
+func (*deploymentHooks) GetWorkloadConditions(obj runtime.Object) []metav1.Condition {
+	deployment, ok := obj.(*appsv1.Deployment)
+	if !ok {
+		return nil
+	}
+
+	return getWorkloadConditions(
+		"Deployment",
+		deployment.Name,
+		deployment.Generation == deployment.Status.ObservedGeneration,
+		ptr.Deref(deployment.Spec.Replicas, 1),
+		deployment.Status.AvailableReplicas,
+		deployment.Status.UpdatedReplicas,
+	)
+}
+
+func (*daemonSetHooks) GetWorkloadConditions(obj runtime.Object) []metav1.Condition {
+	daemonSet, ok := obj.(*appsv1.DaemonSet)
+	if !ok {
+		return nil
+	}
+
+	return getWorkloadConditions(
+		"DaemonSet",
+		daemonSet.Name,
+		daemonSet.Generation == daemonSet.Status.ObservedGeneration,
+		daemonSet.Status.DesiredNumberScheduled,
+		daemonSet.Status.NumberAvailable,
+		daemonSet.Status.UpdatedNumberScheduled,
+	)
+}
+
+// getWorkloadConditions computes the Available, Progressing and Degraded conditions of a workload, from its replica
+// counters. A workload is never reported as degraded; a workload with missing replicas is reported as not available.
+func getWorkloadConditions(kind, name string, generationObserved bool, desired, available, updated int32) []metav1.Condition {
+	availableCond := metav1.Condition{
+		Type:    hcov1beta1.ConditionAvailable,
+		Status:  metav1.ConditionTrue,
+		Reason:  workloadAvailableReason,
+		Message: fmt.Sprintf(workloadReplicasMessageFmt, kind, name, available, desired),
+	}
+	if available < desired {
+		availableCond.Status = metav1.ConditionFalse
+		availableCond.Reason = workloadNotAvailableReason
+	}
+
+	progressingCond := metav1.Condition{
+		Type:    hcov1beta1.ConditionProgressing,
+		Status:  metav1.ConditionFalse,
+		Reason:  workloadRolloutDoneReason,
+		Message: fmt.Sprintf(workloadRolloutMessageFmt, kind, name, updated, desired),
+	}
+	if !generationObserved {
+		progressingCond.Status = metav1.ConditionTrue
+		progressingCond.Reason = workloadRolloutReason
+		progressingCond.Message = fmt.Sprintf(workloadGenerationMessageFmt, kind, name)
+	} else if updated < desired {
+		progressingCond.Status = metav1.ConditionTrue
+		progressingCond.Reason = workloadRolloutReason
+	}
+
+	return []metav1.Condition{
+		availableCond,
+		progressingCond,
+		{
+			Type:    hcov1beta1.ConditionDegraded,
+			Status:  metav1.ConditionFalse,
+			Reason:  workloadNotDegradedReason,
+			Message: workloadNotDegradedMessage,
+		},
+	}
+}
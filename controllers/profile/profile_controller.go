@@ -0,0 +1,258 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/go-logr/logr"
+	operatorhandler "github.com/operator-framework/operator-lib/handler"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// We cannot set owner reference of the cluster-wide MigrationPolicy to the namespaced HyperConvergedProfile
+	// object. Therefore, use finalizers to manage the cleanup.
+	FinalizerName = "kubevirt.io/hyperconvergedprofile"
+
+	// ProfileNamespaceLabel is the label of the MigrationPolicy, that holds the namespace of its HyperConvergedProfile
+	ProfileNamespaceLabel = "hco.kubevirt.io/profile-namespace"
+
+	migrationPolicyNamePrefix = "hco-profile-"
+
+	reconciledReason       = "ReconcileCompleted"
+	reconciledMessage      = "Reconcile completed successfully"
+	reconcileFailedReason  = "ReconcileFailed"
+	reconcileFailedMessage = "failed to reconcile the HyperConvergedProfile: %v"
+)
+
+var (
+	log = logf.Log.WithName("controller_profile")
+)
+
+// RegisterReconciler creates a new HyperConvergedProfile Reconciler and registers it into manager.
+func RegisterReconciler(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) reconcile.Reconciler {
+	r := &ReconcileProfile{
+		client: mgr.GetClient(),
+	}
+
+	return r
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("hyperconvergedprofile-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the HyperConvergedProfiles
+	err = c.Watch(
+		source.Kind[*hcov1beta1.HyperConvergedProfile](
+			mgr.GetCache(), &hcov1beta1.HyperConvergedProfile{},
+			&operatorhandler.InstrumentedEnqueueRequestForObject[*hcov1beta1.HyperConvergedProfile]{},
+		))
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the MigrationPolicies that were created from the HyperConvergedProfiles, to revert any
+	// out-of-band modification
+	return c.Watch(
+		source.Kind[*migrationsv1alpha1.MigrationPolicy](
+			mgr.GetCache(), &migrationsv1alpha1.MigrationPolicy{},
+			handler.TypedEnqueueRequestsFromMapFunc[*migrationsv1alpha1.MigrationPolicy](mapMigrationPolicyToProfile),
+		))
+}
+
+func mapMigrationPolicyToProfile(_ context.Context, mp *migrationsv1alpha1.MigrationPolicy) []reconcile.Request {
+	namespace, ok := mp.Labels[ProfileNamespaceLabel]
+	if !ok {
+		return nil
+	}
+
+	return []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: namespace, Name: hcov1beta1.HyperConvergedProfileName}},
+	}
+}
+
+// ReconcileProfile reconciles a HyperConvergedProfile object
+type ReconcileProfile struct {
+	// This client, initialized using mgr.Client() above, is a split client
+	// that reads objects from the cache and writes to the apiserver
+	client client.Client
+}
+
+// Reconcile translates a HyperConvergedProfile to a KubeVirt MigrationPolicy, that selects the namespace of the
+// profile. The other profile fields are applied by the HCO mutating webhook, when the VMIs are created.
+func (r *ReconcileProfile) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, err := logr.FromContext(ctx)
+	if err != nil {
+		logger = log
+	}
+	logger = logger.WithValues("Request.Namespace", req.Namespace, "Request.Name", req.Name)
+	logger.Info("Reconciling HyperConvergedProfile")
+
+	profile := &hcov1beta1.HyperConvergedProfile{}
+	err = r.client.Get(ctx, req.NamespacedName, profile)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// the finalizer makes sure the MigrationPolicy is already deleted, but just in case
+			return reconcile.Result{}, r.deleteMigrationPolicy(ctx, logger, req.Namespace)
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !profile.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, r.finalize(ctx, logger, profile)
+	}
+
+	if !slices.Contains(profile.Finalizers, FinalizerName) {
+		logger.Info("setting a finalizer")
+		profile.Finalizers = append(profile.Finalizers, FinalizerName)
+		if err = r.client.Update(ctx, profile); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	orig := profile.Status.DeepCopy()
+
+	mpName, reconcileErr := r.ensureMigrationPolicy(ctx, logger, profile)
+	if reconcileErr != nil {
+		meta.SetStatusCondition(&profile.Status.Conditions, metav1.Condition{
+			Type:               hcov1beta1.ConditionProfileReconciled,
+			Status:             metav1.ConditionFalse,
+			Reason:             reconcileFailedReason,
+			Message:            fmt.Sprintf(reconcileFailedMessage, reconcileErr),
+			ObservedGeneration: profile.Generation,
+		})
+	} else {
+		profile.Status.MigrationPolicy = mpName
+		meta.SetStatusCondition(&profile.Status.Conditions, metav1.Condition{
+			Type:               hcov1beta1.ConditionProfileReconciled,
+			Status:             metav1.ConditionTrue,
+			Reason:             reconciledReason,
+			Message:            reconciledMessage,
+			ObservedGeneration: profile.Generation,
+		})
+	}
+	profile.Status.ObservedGeneration = profile.Generation
+
+	if !reflect.DeepEqual(orig, &profile.Status) {
+		if err = r.client.Status().Update(ctx, profile); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{}, reconcileErr
+}
+
+func (r *ReconcileProfile) finalize(ctx context.Context, logger logr.Logger, profile *hcov1beta1.HyperConvergedProfile) error {
+	idx := slices.Index(profile.Finalizers, FinalizerName)
+	if idx < 0 {
+		return nil
+	}
+
+	if err := r.deleteMigrationPolicy(ctx, logger, profile.Namespace); err != nil {
+		return err
+	}
+
+	logger.Info("removing the finalizer")
+	profile.Finalizers = slices.Delete(profile.Finalizers, idx, idx+1)
+	return r.client.Update(ctx, profile)
+}
+
+func (r *ReconcileProfile) ensureMigrationPolicy(ctx context.Context, logger logr.Logger, profile *hcov1beta1.HyperConvergedProfile) (string, error) {
+	if profile.Spec.LiveMigrationPolicy == nil {
+		return "", r.deleteMigrationPolicy(ctx, logger, profile.Namespace)
+	}
+
+	required := NewMigrationPolicy(profile)
+
+	found := &migrationsv1alpha1.MigrationPolicy{}
+	err := r.client.Get(ctx, client.ObjectKeyFromObject(required), found)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", err
+		}
+
+		logger.Info("creating the MigrationPolicy", "name", required.Name)
+		return required.Name, r.client.Create(ctx, required)
+	}
+
+	// use the semantic comparison, because of the bandwidthPerMigration quantity
+	if equality.Semantic.DeepEqual(found.Spec, required.Spec) && hcoutil.CompareLabels(required, found) {
+		return required.Name, nil
+	}
+
+	logger.Info("updating the MigrationPolicy", "name", required.Name)
+	required.Spec.DeepCopyInto(&found.Spec)
+	hcoutil.MergeLabels(&required.ObjectMeta, &found.ObjectMeta)
+
+	return required.Name, r.client.Update(ctx, found)
+}
+
+func (r *ReconcileProfile) deleteMigrationPolicy(ctx context.Context, logger logr.Logger, namespace string) error {
+	mp := &migrationsv1alpha1.MigrationPolicy{}
+	err := r.client.Get(ctx, client.ObjectKey{Name: migrationPolicyName(namespace)}, mp)
+	if err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	logger.Info("deleting the MigrationPolicy", "name", mp.Name)
+	return client.IgnoreNotFound(r.client.Delete(ctx, mp))
+}
+
+// NewMigrationPolicy returns the MigrationPolicy that applies the live migration policy of the HyperConvergedProfile
+// to the VMIs in its namespace
+func NewMigrationPolicy(profile *hcov1beta1.HyperConvergedProfile) *migrationsv1alpha1.MigrationPolicy {
+	labels := hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentCompute)
+	labels[ProfileNamespaceLabel] = profile.Namespace
+
+	policy := profile.Spec.LiveMigrationPolicy
+
+	return &migrationsv1alpha1.MigrationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   migrationPolicyName(profile.Namespace),
+			Labels: labels,
+		},
+		Spec: migrationsv1alpha1.MigrationPolicySpec{
+			Selectors: &migrationsv1alpha1.Selectors{
+				NamespaceSelector: migrationsv1alpha1.LabelSelector{
+					hcoutil.KubernetesMetadataName: profile.Namespace,
+				},
+			},
+			AllowAutoConverge:       policy.AllowAutoConverge,
+			AllowPostCopy:           policy.AllowPostCopy,
+			BandwidthPerMigration:   policy.BandwidthPerMigration,
+			CompletionTimeoutPerGiB: policy.CompletionTimeoutPerGiB,
+		},
+	}
+}
+
+func migrationPolicyName(namespace string) string {
+	return migrationPolicyNamePrefix + namespace
+}
@@ -0,0 +1,157 @@
+package profile
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+const testNamespace = "test-namespace"
+
+var _ = Describe("HyperConvergedProfile controller", func() {
+	var (
+		request = reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: hcov1beta1.HyperConvergedProfileName},
+		}
+		mpKey = client.ObjectKey{Name: migrationPolicyNamePrefix + testNamespace}
+	)
+
+	newProfile := func() *hcov1beta1.HyperConvergedProfile {
+		return &hcov1beta1.HyperConvergedProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       hcov1beta1.HyperConvergedProfileName,
+				Namespace:  testNamespace,
+				Generation: 1,
+			},
+			Spec: hcov1beta1.HyperConvergedProfileSpec{
+				LiveMigrationPolicy: &hcov1beta1.ProfileLiveMigrationPolicy{
+					AllowPostCopy:           ptr.To(true),
+					BandwidthPerMigration:   ptr.To(resource.MustParse("64Mi")),
+					CompletionTimeoutPerGiB: ptr.To[int64](300),
+				},
+			},
+		}
+	}
+
+	getProfile := func(cl client.Client) *hcov1beta1.HyperConvergedProfile {
+		GinkgoHelper()
+		profile := &hcov1beta1.HyperConvergedProfile{}
+		Expect(cl.Get(context.Background(), request.NamespacedName, profile)).To(Succeed())
+		return profile
+	}
+
+	Context("Reconcile", func() {
+		It("should create the MigrationPolicy, set the finalizer and update the status", func() {
+			cl := commontestutils.InitClient([]client.Object{newProfile()})
+			r := &ReconcileProfile{client: cl}
+
+			Expect(r.Reconcile(context.Background(), request)).To(Equal(reconcile.Result{}))
+
+			mp := &migrationsv1alpha1.MigrationPolicy{}
+			Expect(cl.Get(context.Background(), mpKey, mp)).To(Succeed())
+			Expect(mp.Labels).To(HaveKeyWithValue(ProfileNamespaceLabel, testNamespace))
+			Expect(mp.Spec.Selectors.NamespaceSelector).To(HaveKeyWithValue("kubernetes.io/metadata.name", testNamespace))
+			Expect(mp.Spec.AllowPostCopy).To(HaveValue(BeTrue()))
+			Expect(mp.Spec.AllowAutoConverge).To(BeNil())
+			Expect(mp.Spec.BandwidthPerMigration.Cmp(resource.MustParse("64Mi"))).To(BeZero())
+			Expect(mp.Spec.CompletionTimeoutPerGiB).To(HaveValue(Equal(int64(300))))
+
+			profile := getProfile(cl)
+			Expect(profile.Finalizers).To(ContainElement(FinalizerName))
+			Expect(profile.Status.MigrationPolicy).To(Equal(mpKey.Name))
+			Expect(profile.Status.ObservedGeneration).To(Equal(int64(1)))
+			cond := meta.FindStatusCondition(profile.Status.Conditions, hcov1beta1.ConditionProfileReconciled)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(reconciledReason))
+		})
+
+		It("should revert a modified MigrationPolicy", func() {
+			profile := newProfile()
+			modified := NewMigrationPolicy(profile)
+			modified.Spec.AllowPostCopy = ptr.To(false)
+			modified.Spec.AllowAutoConverge = ptr.To(true)
+			delete(modified.Labels, ProfileNamespaceLabel)
+
+			cl := commontestutils.InitClient([]client.Object{profile, modified})
+			r := &ReconcileProfile{client: cl}
+
+			Expect(r.Reconcile(context.Background(), request)).To(Equal(reconcile.Result{}))
+
+			mp := &migrationsv1alpha1.MigrationPolicy{}
+			Expect(cl.Get(context.Background(), mpKey, mp)).To(Succeed())
+			Expect(mp.Labels).To(HaveKeyWithValue(ProfileNamespaceLabel, testNamespace))
+			Expect(mp.Spec.AllowPostCopy).To(HaveValue(BeTrue()))
+			Expect(mp.Spec.AllowAutoConverge).To(BeNil())
+		})
+
+		It("should delete the MigrationPolicy if the liveMigrationPolicy field is removed", func() {
+			profile := newProfile()
+			mp := NewMigrationPolicy(profile)
+			profile.Spec.LiveMigrationPolicy = nil
+			profile.Status.MigrationPolicy = mp.Name
+
+			cl := commontestutils.InitClient([]client.Object{profile, mp})
+			r := &ReconcileProfile{client: cl}
+
+			Expect(r.Reconcile(context.Background(), request)).To(Equal(reconcile.Result{}))
+
+			Expect(cl.Get(context.Background(), mpKey, &migrationsv1alpha1.MigrationPolicy{})).To(MatchError(ContainSubstring("not found")))
+			Expect(getProfile(cl).Status.MigrationPolicy).To(BeEmpty())
+		})
+
+		It("should delete the MigrationPolicy and remove the finalizer, when the profile is deleted", func() {
+			profile := newProfile()
+			mp := NewMigrationPolicy(profile)
+			profile.Finalizers = []string{FinalizerName}
+			profile.DeletionTimestamp = ptr.To(metav1.NewTime(time.Now()))
+
+			cl := commontestutils.InitClient([]client.Object{profile, mp})
+			r := &ReconcileProfile{client: cl}
+
+			Expect(r.Reconcile(context.Background(), request)).To(Equal(reconcile.Result{}))
+
+			Expect(cl.Get(context.Background(), mpKey, &migrationsv1alpha1.MigrationPolicy{})).To(MatchError(ContainSubstring("not found")))
+			// the fake client removes the object, once its last finalizer was removed
+			Expect(cl.Get(context.Background(), request.NamespacedName, &hcov1beta1.HyperConvergedProfile{})).To(MatchError(ContainSubstring("not found")))
+		})
+
+		It("should delete a leftover MigrationPolicy, if the profile does not exist", func() {
+			mp := NewMigrationPolicy(newProfile())
+
+			cl := commontestutils.InitClient([]client.Object{mp})
+			r := &ReconcileProfile{client: cl}
+
+			Expect(r.Reconcile(context.Background(), request)).To(Equal(reconcile.Result{}))
+
+			Expect(cl.Get(context.Background(), mpKey, &migrationsv1alpha1.MigrationPolicy{})).To(MatchError(ContainSubstring("not found")))
+		})
+	})
+
+	Context("mapMigrationPolicyToProfile", func() {
+		It("should map a MigrationPolicy to the profile of its namespace", func() {
+			mp := NewMigrationPolicy(newProfile())
+			Expect(mapMigrationPolicyToProfile(context.Background(), mp)).To(Equal([]reconcile.Request{request}))
+		})
+
+		It("should ignore a MigrationPolicy that was not created from a profile", func() {
+			mp := &migrationsv1alpha1.MigrationPolicy{ObjectMeta: metav1.ObjectMeta{Name: "user-policy"}}
+			Expect(mapMigrationPolicyToProfile(context.Background(), mp)).To(BeEmpty())
+		})
+	})
+})
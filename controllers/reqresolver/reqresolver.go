@@ -14,6 +14,8 @@ const (
 	apiServerCRPrefix = "api-server-cr-"
 	ingressCRPrefix   = "ingress-cr-"
 	nodePrefix        = "node-"
+	dictCatalogPrefix = "dict-catalog-"
+	dictArchPrefix    = "dict-arch-"
 )
 
 var (
@@ -32,6 +34,10 @@ var (
 	ingressCRPlaceholder types.NamespacedName
 
 	nodePlaceholder types.NamespacedName
+
+	dictCatalogPlaceholder types.NamespacedName
+
+	dictArchPlaceholder types.NamespacedName
 )
 
 // ResolveReconcileRequest returns a reconcile.Request to be used throughout the reconciliation cycle,
@@ -56,6 +62,16 @@ func ResolveReconcileRequest(logger logr.Logger, originalRequest reconcile.Reque
 		// consider a change in Ingress like a change in HCO
 		triggeredByHyperConverged = true
 
+	case dictCatalogPlaceholder:
+		logger.Info("The reconciliation got triggered by a DataImportCronTemplate catalog change")
+		// consider a change in the catalog like a change in HCO, to regenerate the DataImportCronTemplates
+		triggeredByHyperConverged = true
+
+	case dictArchPlaceholder:
+		logger.Info("The reconciliation got triggered by a DataImportCronTemplate architecture resolution")
+		// consider a resolution like a change in HCO, to regenerate the DataImportCronTemplates
+		triggeredByHyperConverged = true
+
 	case secondaryCRPlaceholder:
 		logger.Info("The reconciliation got triggered by a secondary CR object")
 
@@ -101,6 +117,18 @@ func GetNodeResource() reconcile.Request {
 	}
 }
 
+func GetDictCatalogResource() reconcile.Request {
+	return reconcile.Request{
+		NamespacedName: dictCatalogPlaceholder,
+	}
+}
+
+func GetDictArchResource() reconcile.Request {
+	return reconcile.Request{
+		NamespacedName: dictArchPlaceholder,
+	}
+}
+
 func IsTriggeredByHyperConverged(nsName types.NamespacedName) bool {
 	return nsName == hyperConvergedNamespacedName
 }
@@ -137,6 +165,16 @@ func GeneratePlaceHolders() {
 		Name:      nodePrefix + randomConstSuffix,
 		Namespace: ns,
 	}
+
+	dictCatalogPlaceholder = types.NamespacedName{
+		Name:      dictCatalogPrefix + randomConstSuffix,
+		Namespace: ns,
+	}
+
+	dictArchPlaceholder = types.NamespacedName{
+		Name:      dictArchPrefix + randomConstSuffix,
+		Namespace: ns,
+	}
 }
 
 func init() {
@@ -80,6 +80,24 @@ var _ = Describe("test ResolveReconcileRequest", func() {
 		Expect(triggeredByHC).To(BeTrueBecause("should recognized as triggered by the HyperConverged CR"))
 	})
 
+	It("should return HC req and true for request triggered by a DataImportCronTemplate catalog", func() {
+		expected := reconcile.Request{
+			NamespacedName: reqresolver.GetHyperConvergedNamespacedName(),
+		}
+		requestAfter, triggeredByHC := reqresolver.ResolveReconcileRequest(GinkgoLogr, reqresolver.GetDictCatalogResource())
+		Expect(requestAfter).To(Equal(expected))
+		Expect(triggeredByHC).To(BeTrueBecause("should recognized as triggered by the HyperConverged CR"))
+	})
+
+	It("should return HC req and true for request triggered by a DataImportCronTemplate architecture resolution", func() {
+		expected := reconcile.Request{
+			NamespacedName: reqresolver.GetHyperConvergedNamespacedName(),
+		}
+		requestAfter, triggeredByHC := reqresolver.ResolveReconcileRequest(GinkgoLogr, reqresolver.GetDictArchResource())
+		Expect(requestAfter).To(Equal(expected))
+		Expect(triggeredByHC).To(BeTrueBecause("should recognized as triggered by the HyperConverged CR"))
+	})
+
 	It("should return HC req and false for request triggered by Secondary resource", func() {
 		expected := reconcile.Request{
 			NamespacedName: reqresolver.GetHyperConvergedNamespacedName(),
@@ -121,4 +139,20 @@ var _ = Describe("Check generated requests", func() {
 		Expect(reqresolver.IsTriggeredByHyperConverged(req.NamespacedName)).To(BeFalseBecause("should not be recognized as triggered by HyperConverged CR"))
 		Expect(reqresolver.IsTriggeredByAPIServerCR(req)).To(BeFalseBecause("should not be recognized as triggered by APIServer CR"))
 	})
+
+	It("test GetDictCatalogResource", func() {
+		req := reqresolver.GetDictCatalogResource()
+		Expect(req.NamespacedName.Namespace).To(Equal(namespace))
+		Expect(req.NamespacedName.Name).To(HavePrefix("dict-catalog-"))
+		Expect(reqresolver.IsTriggeredByHyperConverged(req.NamespacedName)).To(BeFalseBecause("should not be recognized as triggered by HyperConverged CR"))
+		Expect(reqresolver.IsTriggeredByAPIServerCR(req)).To(BeFalseBecause("should not be recognized as triggered by APIServer CR"))
+	})
+
+	It("test GetDictArchResource", func() {
+		req := reqresolver.GetDictArchResource()
+		Expect(req.NamespacedName.Namespace).To(Equal(namespace))
+		Expect(req.NamespacedName.Name).To(HavePrefix("dict-arch-"))
+		Expect(reqresolver.IsTriggeredByHyperConverged(req.NamespacedName)).To(BeFalseBecause("should not be recognized as triggered by HyperConverged CR"))
+		Expect(reqresolver.IsTriggeredByAPIServerCR(req)).To(BeFalseBecause("should not be recognized as triggered by APIServer CR"))
+	})
 })
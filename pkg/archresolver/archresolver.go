@@ -0,0 +1,352 @@
+package archresolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/manifest"
+	"github.com/containers/image/v5/types"
+	corev1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// dockerScheme is the scheme of the CDI registry source URLs that are pulled from a registry
+	dockerScheme = "docker://"
+
+	// unknownArch is the platform architecture of non-image manifests in a manifest list, like attestations
+	unknownArch = "unknown"
+
+	// the keys of the access credentials in the secret of the registry source, as defined by CDI
+	accessKeyIDKey = "accessKeyId"
+	secretKeyKey   = "secretKey"
+
+	// the resolved architectures are re-read from the registry after this interval, to catch tags that were moved to
+	// an image with different architectures
+	defaultResolvedTTL   = 12 * time.Hour
+	defaultRetryInterval = 5 * time.Minute
+	defaultTimeout       = 30 * time.Second
+	// cache entries of images that were not requested for this interval, are removed from the cache
+	defaultUnusedTTL = 24 * time.Hour
+)
+
+// State is the state of the architecture resolution of an image
+type State string
+
+const (
+	// StatePending means that the image manifest is being read
+	StatePending State = "Pending"
+	// StateResolved means that the architectures were read from the image manifest list
+	StateResolved State = "Resolved"
+	// StateFailed means that the architectures could not be read from the image manifest
+	StateFailed State = "Failed"
+)
+
+// ErrNotMultiArch is returned when the image manifest is a single image manifest, and not a manifest list
+var ErrNotMultiArch = errors.New("the image is not a multi-architecture image")
+
+var log = logf.Log.WithName("archresolver")
+
+// Image is an image to resolve, with the registry access configuration of its CDI registry source
+type Image struct {
+	// URL is the docker:// URL of the image
+	URL string
+	// Insecure skips the TLS verification of the registry
+	Insecure bool
+	// Namespace is the namespace of the SecretRef secret and of the CertConfigMap ConfigMap
+	Namespace string
+	// SecretRef is the name of the secret with the registry credentials, if any
+	SecretRef string
+	// CertConfigMap is the name of the ConfigMap with the CA certificates of the registry, if any
+	CertConfigMap string
+}
+
+// RegistryClient reads image manifests from a container registry
+type RegistryClient interface {
+	// GetManifest returns the raw manifest of the image, and its MIME type
+	GetManifest(ctx context.Context, image Image) ([]byte, string, error)
+}
+
+// Result is the result of the architecture resolution of an image
+type Result struct {
+	State         State
+	Architectures []string
+	Err           error
+}
+
+type cacheEntry struct {
+	result     Result
+	expires    time.Time
+	lastUsed   time.Time
+	inProgress bool
+}
+
+// Resolver reads the supported architectures of images from their manifest lists in the registry. The resolution
+// runs in the background; the results are cached, and an event is sent to the events channel when a resolution is
+// completed, to trigger a new reconciliation.
+type Resolver struct {
+	client RegistryClient
+	events chan<- event.GenericEvent
+
+	lock  sync.Mutex
+	cache map[Image]*cacheEntry
+
+	resolvedTTL   time.Duration
+	retryInterval time.Duration
+	unusedTTL     time.Duration
+	timeout       time.Duration
+	now           func() time.Time
+}
+
+// NewResolver returns a new Resolver that uses client to read the image manifests
+func NewResolver(client RegistryClient, events chan<- event.GenericEvent) *Resolver {
+	return &Resolver{
+		client:        client,
+		events:        events,
+		cache:         make(map[Image]*cacheEntry),
+		resolvedTTL:   defaultResolvedTTL,
+		retryInterval: defaultRetryInterval,
+		unusedTTL:     defaultUnusedTTL,
+		timeout:       defaultTimeout,
+		now:           time.Now,
+	}
+}
+
+// Resolve returns the cached architectures of the image. If the image is not in the cache yet, a background
+// resolution is started, and a pending result is returned. If the cached result is expired, it is returned while it
+// is refreshed in the background. Images that were not requested for a while are removed from the cache.
+func (r *Resolver) Resolve(image Image) Result {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.now()
+	r.removeUnused(now)
+
+	entry, found := r.cache[image]
+	if !found {
+		entry = &cacheEntry{result: Result{State: StatePending}}
+		r.cache[image] = entry
+	}
+	entry.lastUsed = now
+
+	if !entry.inProgress && (!found || !now.Before(entry.expires)) {
+		entry.inProgress = true
+		go r.resolve(image)
+	}
+
+	return entry.result
+}
+
+// removeUnused removes the cache entries of the images that were not requested for the unused TTL, e.g. images of
+// removed DataImportCronTemplates, or images that their registry access configuration was changed
+func (r *Resolver) removeUnused(now time.Time) {
+	for image, entry := range r.cache {
+		if !entry.inProgress && now.Sub(entry.lastUsed) > r.unusedTTL {
+			delete(r.cache, image)
+		}
+	}
+}
+
+func (r *Resolver) resolve(image Image) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	result := Result{State: StateResolved}
+	archs, err := r.getArchitectures(ctx, image)
+	if err != nil {
+		log.Error(err, "failed to resolve the image architectures", "image", image.URL)
+		result = Result{State: StateFailed, Err: err}
+	} else {
+		log.Info("resolved the image architectures", "image", image.URL, "architectures", archs)
+		result.Architectures = archs
+	}
+
+	r.lock.Lock()
+	entry := r.cache[image]
+	entry.inProgress = false
+	// keep the last successful result, if the refresh of an expired result failed
+	if result.State == StateResolved || entry.result.State != StateResolved {
+		entry.result = result
+	}
+
+	ttl := r.resolvedTTL
+	if result.State == StateFailed {
+		ttl = r.retryInterval
+	}
+	entry.expires = r.now().Add(ttl)
+	r.lock.Unlock()
+
+	r.notify()
+
+	if result.State == StateFailed {
+		// trigger a new reconciliation, to retry the resolution
+		time.AfterFunc(r.retryInterval, r.notify)
+	}
+}
+
+func (r *Resolver) notify() {
+	if r.events == nil {
+		return
+	}
+
+	select {
+	case r.events <- event.GenericEvent{}:
+	default:
+		// a reconciliation is already pending
+	}
+}
+
+func (r *Resolver) getArchitectures(ctx context.Context, image Image) ([]string, error) {
+	rawManifest, mimeType, err := r.client.GetManifest(ctx, image)
+	if err != nil {
+		return nil, fmt.Errorf("can't read the manifest of %s; %w", image.URL, err)
+	}
+
+	return ArchitecturesFromManifest(rawManifest, mimeType)
+}
+
+// ArchitecturesFromManifest returns the architectures of the images in a manifest list. The result is sorted by the
+// order of the images in the manifest list, without duplications.
+func ArchitecturesFromManifest(rawManifest []byte, mimeType string) ([]string, error) {
+	if mimeType == "" {
+		mimeType = manifest.GuessMIMEType(rawManifest)
+	}
+
+	if !manifest.MIMETypeIsMultiImage(mimeType) {
+		return nil, ErrNotMultiArch
+	}
+
+	list, err := manifest.ListFromBlob(rawManifest, mimeType)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse the manifest list; %w", err)
+	}
+
+	var archs []string
+	for _, digest := range list.Instances() {
+		instance, err := list.Instance(digest)
+		if err != nil {
+			return nil, fmt.Errorf("can't read the %s manifest from the manifest list; %w", digest, err)
+		}
+
+		platform := instance.ReadOnly.Platform
+		if platform == nil || platform.Architecture == "" || platform.Architecture == unknownArch {
+			continue
+		}
+
+		if !slices.Contains(archs, platform.Architecture) {
+			archs = append(archs, platform.Architecture)
+		}
+	}
+
+	if len(archs) == 0 {
+		return nil, errors.New("the manifest list contains no image architectures")
+	}
+
+	return archs, nil
+}
+
+type registryClient struct {
+	reader client.Reader
+}
+
+// NewRegistryClient returns a RegistryClient that reads the manifests from the image registries, using the system
+// registry configuration of the operator, and the credentials and the CA certificates of the CDI registry source,
+// that are read with reader.
+func NewRegistryClient(reader client.Reader) RegistryClient {
+	return registryClient{reader: reader}
+}
+
+func (c registryClient) GetManifest(ctx context.Context, image Image) ([]byte, string, error) {
+	ref, found := strings.CutPrefix(image.URL, dockerScheme)
+	if !found {
+		return nil, "", fmt.Errorf("unsupported image URL %q; only %s URLs are supported", image.URL, dockerScheme)
+	}
+
+	imgRef, err := docker.ParseReference("//" + ref)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sysCtx := &types.SystemContext{}
+	if image.Insecure {
+		sysCtx.DockerInsecureSkipTLSVerify = types.OptionalBoolTrue
+	}
+
+	if image.SecretRef != "" {
+		sysCtx.DockerAuthConfig, err = c.getAuthConfig(ctx, image.Namespace, image.SecretRef)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if image.CertConfigMap != "" {
+		certDir, err := c.writeCerts(ctx, image.Namespace, image.CertConfigMap)
+		if err != nil {
+			return nil, "", err
+		}
+		defer os.RemoveAll(certDir)
+
+		sysCtx.DockerCertPath = certDir
+	}
+
+	source, err := imgRef.NewImageSource(ctx, sysCtx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer source.Close()
+
+	return source.GetManifest(ctx, nil)
+}
+
+// getAuthConfig reads the registry credentials from the secret of the registry source
+func (c registryClient) getAuthConfig(ctx context.Context, namespace, name string) (*types.DockerAuthConfig, error) {
+	secret := &corev1.Secret{}
+	if err := c.reader.Get(ctx, k8stypes.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		return nil, fmt.Errorf("can't read the %s/%s registry secret; %w", namespace, name, err)
+	}
+
+	return &types.DockerAuthConfig{
+		Username: string(secret.Data[accessKeyIDKey]),
+		Password: string(secret.Data[secretKeyKey]),
+	}, nil
+}
+
+// writeCerts writes the CA certificates of the ConfigMap of the registry source to a new temporary directory, and
+// returns the directory path. The caller is responsible to remove the directory.
+func (c registryClient) writeCerts(ctx context.Context, namespace, name string) (string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.reader.Get(ctx, k8stypes.NamespacedName{Namespace: namespace, Name: name}, cm); err != nil {
+		return "", fmt.Errorf("can't read the %s/%s registry certificate ConfigMap; %w", namespace, name, err)
+	}
+
+	certDir, err := os.MkdirTemp("", "archresolver-certs-")
+	if err != nil {
+		return "", err
+	}
+
+	for key, value := range cm.Data {
+		// only the *.crt files are loaded as CA certificates
+		fileName := filepath.Base(key)
+		if !strings.HasSuffix(fileName, ".crt") {
+			fileName += ".crt"
+		}
+
+		if err = os.WriteFile(filepath.Join(certDir, fileName), []byte(value), 0600); err != nil {
+			_ = os.RemoveAll(certDir)
+			return "", err
+		}
+	}
+
+	return certDir, nil
+}
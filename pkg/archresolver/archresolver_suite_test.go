@@ -0,0 +1,13 @@
+package archresolver
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestArchResolver(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Architecture Resolver Suite")
+}
@@ -0,0 +1,409 @@
+package archresolver
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+const (
+	multiArchIndex = `{
+  "schemaVersion": 2,
+  "mediaType": "application/vnd.oci.image.index.v1+json",
+  "manifests": [
+    {
+      "mediaType": "application/vnd.oci.image.manifest.v1+json",
+      "digest": "sha256:1111111111111111111111111111111111111111111111111111111111111111",
+      "size": 100,
+      "platform": {"architecture": "amd64", "os": "linux"}
+    },
+    {
+      "mediaType": "application/vnd.oci.image.manifest.v1+json",
+      "digest": "sha256:2222222222222222222222222222222222222222222222222222222222222222",
+      "size": 100,
+      "platform": {"architecture": "arm64", "os": "linux", "variant": "v8"}
+    },
+    {
+      "mediaType": "application/vnd.oci.image.manifest.v1+json",
+      "digest": "sha256:3333333333333333333333333333333333333333333333333333333333333333",
+      "size": 100,
+      "platform": {"architecture": "arm64", "os": "linux"}
+    },
+    {
+      "mediaType": "application/vnd.oci.image.manifest.v1+json",
+      "digest": "sha256:4444444444444444444444444444444444444444444444444444444444444444",
+      "size": 100,
+      "platform": {"architecture": "unknown", "os": "unknown"}
+    },
+    {
+      "mediaType": "application/vnd.oci.image.manifest.v1+json",
+      "digest": "sha256:5555555555555555555555555555555555555555555555555555555555555555",
+      "size": 100,
+      "platform": {"architecture": "s390x", "os": "linux"}
+    }
+  ]
+}`
+
+	singleImageManifest = `{
+  "schemaVersion": 2,
+  "mediaType": "application/vnd.oci.image.manifest.v1+json",
+  "config": {
+    "mediaType": "application/vnd.oci.image.config.v1+json",
+    "digest": "sha256:1111111111111111111111111111111111111111111111111111111111111111",
+    "size": 100
+  },
+  "layers": []
+}`
+)
+
+type fakeRegistryClient struct {
+	lock      sync.Mutex
+	manifests map[string]string
+	err       error
+	calls     int
+}
+
+func (c *fakeRegistryClient) GetManifest(_ context.Context, image Image) ([]byte, string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.calls++
+	if c.err != nil {
+		return nil, "", c.err
+	}
+
+	m, found := c.manifests[image.URL]
+	if !found {
+		return nil, "", errors.New("manifest unknown")
+	}
+
+	return []byte(m), imgspecv1.MediaTypeImageIndex, nil
+}
+
+func (c *fakeRegistryClient) getCalls() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.calls
+}
+
+func (c *fakeRegistryClient) setError(err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.err = err
+}
+
+var _ = Describe("Test the architecture resolver", func() {
+	Context("ArchitecturesFromManifest", func() {
+		It("should return the architectures of a manifest list, without duplications and unknown architectures", func() {
+			archs, err := ArchitecturesFromManifest([]byte(multiArchIndex), imgspecv1.MediaTypeImageIndex)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archs).To(Equal([]string{"amd64", "arm64", "s390x"}))
+		})
+
+		It("should guess the MIME type if it is missing", func() {
+			archs, err := ArchitecturesFromManifest([]byte(multiArchIndex), "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archs).To(Equal([]string{"amd64", "arm64", "s390x"}))
+		})
+
+		It("should return ErrNotMultiArch for a single image manifest", func() {
+			_, err := ArchitecturesFromManifest([]byte(singleImageManifest), imgspecv1.MediaTypeImageManifest)
+			Expect(err).To(MatchError(ErrNotMultiArch))
+		})
+
+		It("should fail if the manifest list has no architectures", func() {
+			_, err := ArchitecturesFromManifest([]byte(`{"schemaVersion": 2, "mediaType": "application/vnd.oci.image.index.v1+json", "manifests": []}`), imgspecv1.MediaTypeImageIndex)
+			Expect(err).To(MatchError(ContainSubstring("the manifest list contains no image architectures")))
+		})
+	})
+
+	Context("Resolver", func() {
+		const imageURL = "docker://quay.io/containerdisks/fedora:latest"
+
+		image := Image{URL: imageURL}
+
+		var (
+			client *fakeRegistryClient
+			events chan event.GenericEvent
+			now    time.Time
+		)
+
+		newTestResolver := func() *Resolver {
+			r := NewResolver(client, events)
+			r.now = func() time.Time { return now }
+			r.retryInterval = 10 * time.Millisecond
+			return r
+		}
+
+		BeforeEach(func() {
+			client = &fakeRegistryClient{
+				manifests: map[string]string{imageURL: multiArchIndex},
+			}
+			events = make(chan event.GenericEvent, 10)
+			now = time.Now()
+		})
+
+		It("should resolve the architectures in the background, and cache the result", func() {
+			r := newTestResolver()
+
+			Expect(r.Resolve(image).State).To(Equal(StatePending))
+			Eventually(events).Should(Receive())
+
+			res := r.Resolve(image)
+			Expect(res.State).To(Equal(StateResolved))
+			Expect(res.Architectures).To(Equal([]string{"amd64", "arm64", "s390x"}))
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			Expect(r.Resolve(image).State).To(Equal(StateResolved))
+			Consistently(events, 50*time.Millisecond).ShouldNot(Receive())
+			Expect(client.getCalls()).To(Equal(1))
+		})
+
+		It("should report a failure, and retry after the retry interval", func() {
+			client.setError(errors.New("connection refused"))
+			r := newTestResolver()
+
+			Expect(r.Resolve(image).State).To(Equal(StatePending))
+			Eventually(events).Should(Receive())
+
+			res := r.Resolve(image)
+			Expect(res.State).To(Equal(StateFailed))
+			Expect(res.Err).To(MatchError(ContainSubstring("connection refused")))
+
+			// the retry timer triggers a new reconciliation
+			Eventually(events).Should(Receive())
+
+			client.setError(nil)
+			now = now.Add(time.Minute)
+			Expect(r.Resolve(image).State).To(Equal(StateFailed))
+			Eventually(events).Should(Receive())
+
+			res = r.Resolve(image)
+			Expect(res.State).To(Equal(StateResolved))
+			Expect(res.Architectures).To(Equal([]string{"amd64", "arm64", "s390x"}))
+		})
+
+		It("should keep the last resolved architectures if the refresh failed", func() {
+			r := newTestResolver()
+
+			r.Resolve(image)
+			Eventually(events).Should(Receive())
+			Expect(r.Resolve(image).State).To(Equal(StateResolved))
+
+			client.setError(errors.New("connection refused"))
+			now = now.Add(defaultResolvedTTL + time.Minute)
+
+			Expect(r.Resolve(image).State).To(Equal(StateResolved))
+			Eventually(events).Should(Receive())
+			Eventually(client.getCalls).Should(Equal(2))
+
+			res := r.Resolve(image)
+			Expect(res.State).To(Equal(StateResolved))
+			Expect(res.Architectures).To(Equal([]string{"amd64", "arm64", "s390x"}))
+		})
+
+		It("should resolve the same image again, if its registry access configuration was changed", func() {
+			r := newTestResolver()
+
+			r.Resolve(image)
+			Eventually(events).Should(Receive())
+			Expect(r.Resolve(image).State).To(Equal(StateResolved))
+
+			withSecret := image
+			withSecret.Namespace = "golden-images"
+			withSecret.SecretRef = "registry-secret"
+			Expect(r.Resolve(withSecret).State).To(Equal(StatePending))
+			Eventually(events).Should(Receive())
+			Expect(r.Resolve(withSecret).State).To(Equal(StateResolved))
+			Expect(client.getCalls()).To(Equal(2))
+		})
+
+		It("should remove the images that were not requested for the unused TTL from the cache", func() {
+			r := newTestResolver()
+
+			otherImage := Image{URL: "docker://quay.io/containerdisks/centos-stream:9"}
+			client.manifests[otherImage.URL] = multiArchIndex
+
+			r.Resolve(image)
+			r.Resolve(otherImage)
+			Eventually(client.getCalls).Should(Equal(2))
+			Eventually(func() State { return r.Resolve(otherImage).State }).Should(Equal(StateResolved))
+			Eventually(func() State { return r.Resolve(image).State }).Should(Equal(StateResolved))
+
+			now = now.Add(defaultUnusedTTL / 2)
+			Expect(r.Resolve(image).State).To(Equal(StateResolved))
+
+			now = now.Add(defaultUnusedTTL/2 + time.Minute)
+			Expect(r.Resolve(image).State).To(Equal(StateResolved))
+
+			r.lock.Lock()
+			defer r.lock.Unlock()
+			Expect(r.cache).To(HaveLen(1))
+			Expect(r.cache).To(HaveKey(image))
+		})
+	})
+
+	Context("registry client", func() {
+		var (
+			server   *httptest.Server
+			registry string
+		)
+
+		BeforeEach(func() {
+			// a minimal local registry stand-in, that serves the manifests of the containerdisks/fedora repository
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				switch req.URL.Path {
+				case "/v2/":
+					w.WriteHeader(http.StatusOK)
+				case "/v2/containerdisks/fedora/manifests/latest":
+					w.Header().Set("Content-Type", imgspecv1.MediaTypeImageIndex)
+					_, _ = w.Write([]byte(multiArchIndex))
+				case "/v2/containerdisks/fedora/manifests/single":
+					w.Header().Set("Content-Type", imgspecv1.MediaTypeImageManifest)
+					_, _ = w.Write([]byte(singleImageManifest))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			DeferCleanup(server.Close)
+
+			registry = strings.TrimPrefix(server.URL, "http://")
+		})
+
+		It("should resolve the architectures from the registry", func() {
+			events := make(chan event.GenericEvent, 10)
+			r := NewResolver(NewRegistryClient(fake.NewClientBuilder().Build()), events)
+
+			image := Image{URL: dockerScheme + registry + "/containerdisks/fedora:latest", Insecure: true}
+			Expect(r.Resolve(image).State).To(Equal(StatePending))
+			Eventually(events).WithTimeout(10 * time.Second).Should(Receive())
+
+			res := r.Resolve(image)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.State).To(Equal(StateResolved))
+			Expect(res.Architectures).To(Equal([]string{"amd64", "arm64", "s390x"}))
+		})
+
+		It("should fail for a single image manifest", func() {
+			events := make(chan event.GenericEvent, 10)
+			r := NewResolver(NewRegistryClient(fake.NewClientBuilder().Build()), events)
+
+			image := Image{URL: dockerScheme + registry + "/containerdisks/fedora:single", Insecure: true}
+			r.Resolve(image)
+			Eventually(events).WithTimeout(10 * time.Second).Should(Receive())
+
+			res := r.Resolve(image)
+			Expect(res.State).To(Equal(StateFailed))
+			Expect(res.Err).To(MatchError(ErrNotMultiArch))
+		})
+
+		It("should reject a non-docker URL", func() {
+			_, _, err := NewRegistryClient(fake.NewClientBuilder().Build()).GetManifest(context.Background(), Image{URL: "oci-archive:///tmp/image.tar"})
+			Expect(err).To(MatchError(ContainSubstring("only docker:// URLs are supported")))
+		})
+	})
+
+	Context("registry client with the registry source secret and CA certificate", func() {
+		const (
+			namespace = "golden-images"
+			user      = "user"
+			password  = "password"
+		)
+
+		var (
+			server *httptest.Server
+			image  Image
+		)
+
+		BeforeEach(func() {
+			// a TLS registry stand-in, that requires basic authentication
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if u, p, ok := req.BasicAuth(); !ok || u != user || p != password {
+					w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+
+				switch req.URL.Path {
+				case "/v2/":
+					w.WriteHeader(http.StatusOK)
+				case "/v2/containerdisks/fedora/manifests/latest":
+					w.Header().Set("Content-Type", imgspecv1.MediaTypeImageIndex)
+					_, _ = w.Write([]byte(multiArchIndex))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			DeferCleanup(server.Close)
+
+			image = Image{
+				URL:           dockerScheme + strings.TrimPrefix(server.URL, "https://") + "/containerdisks/fedora:latest",
+				Namespace:     namespace,
+				SecretRef:     "registry-secret",
+				CertConfigMap: "registry-certs",
+			}
+		})
+
+		caConfigMap := func() *corev1.ConfigMap {
+			caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+			return &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "registry-certs", Namespace: namespace},
+				Data:       map[string]string{"ca.pem": string(caPEM)},
+			}
+		}
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "registry-secret", Namespace: namespace},
+			Data: map[string][]byte{
+				"accessKeyId": []byte(user),
+				"secretKey":   []byte(password),
+			},
+		}
+
+		It("should read the manifest with the credentials of the secret, and trust the CA of the ConfigMap", func() {
+			cl := fake.NewClientBuilder().WithObjects(secret, caConfigMap()).Build()
+
+			rawManifest, mimeType, err := NewRegistryClient(cl).GetManifest(context.Background(), image)
+			Expect(err).ToNot(HaveOccurred())
+
+			archs, err := ArchitecturesFromManifest(rawManifest, mimeType)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archs).To(Equal([]string{"amd64", "arm64", "s390x"}))
+		})
+
+		It("should fail if the secret does not exist", func() {
+			cl := fake.NewClientBuilder().WithObjects(caConfigMap()).Build()
+
+			_, _, err := NewRegistryClient(cl).GetManifest(context.Background(), image)
+			Expect(err).To(MatchError(ContainSubstring("can't read the golden-images/registry-secret registry secret")))
+		})
+
+		It("should fail if the CA ConfigMap does not exist", func() {
+			cl := fake.NewClientBuilder().WithObjects(secret).Build()
+
+			_, _, err := NewRegistryClient(cl).GetManifest(context.Background(), image)
+			Expect(err).To(MatchError(ContainSubstring("can't read the golden-images/registry-certs registry certificate ConfigMap")))
+		})
+
+		It("should fail to verify the registry certificate without the CA ConfigMap", func() {
+			cl := fake.NewClientBuilder().WithObjects(secret).Build()
+			image.CertConfigMap = ""
+
+			_, _, err := NewRegistryClient(cl).GetManifest(context.Background(), image)
+			Expect(err).To(MatchError(ContainSubstring("certificate")))
+		})
+	})
+})
@@ -79,6 +79,7 @@ type DeploymentOperatorParams struct {
 	HppoVersion            string
 	MtqVersion             string
 	AaqVersion             string
+	MonitoringBackend      string
 	Env                    []corev1.EnvVar
 	AddNetworkPolicyLabels bool
 }
@@ -214,6 +215,10 @@ func buildEnvVars(params *DeploymentOperatorParams) []corev1.EnvVar {
 			Name:  "KVM_EMULATION",
 			Value: "",
 		},
+		{
+			Name:  util.MonitoringBackendEnvV,
+			Value: params.MonitoringBackend,
+		},
 		{
 			Name:  "OPERATOR_IMAGE",
 			Value: params.Image,
@@ -529,8 +534,29 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 			Resources: stringListToSlice("hyperconvergeds/finalizers", "hyperconvergeds/status"),
 			Verbs:     stringListToSlice("get", "list", "create", "update", "watch"),
 		},
+		{
+			APIGroups: stringListToSlice(util.APIVersionGroup),
+			Resources: stringListToSlice("hyperconvergedprofiles"),
+			Verbs:     stringListToSlice("get", "list", "update", "watch"),
+		},
+		{
+			APIGroups: stringListToSlice(util.APIVersionGroup),
+			Resources: stringListToSlice("hyperconvergedprofiles/finalizers", "hyperconvergedprofiles/status"),
+			Verbs:     stringListToSlice("get", "list", "create", "update", "watch"),
+		},
+		roleWithAllPermissions("migrations.kubevirt.io", stringListToSlice("migrationpolicies")),
+		{
+			APIGroups: stringListToSlice("node.k8s.io"),
+			Resources: stringListToSlice("runtimeclasses"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
 		roleWithAllPermissions(kvapi.GroupName, stringListToSlice("kubevirts", "kubevirts/finalizers")),
 		roleWithAllPermissions(cdiapi.GroupName, stringListToSlice("cdis", "cdis/finalizers")),
+		{
+			APIGroups: stringListToSlice(cdiapi.GroupName),
+			Resources: stringListToSlice("dataimportcrons", "datasources"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
 		roleWithAllPermissions(sspapi.GroupVersion.Group, stringListToSlice("ssps", "ssps/finalizers")),
 		roleWithAllPermissions(cnaoapi.GroupVersion.Group, stringListToSlice("networkaddonsconfigs", "networkaddonsconfigs/finalizers")),
 		roleWithAllPermissions(aaqapi.GroupName, stringListToSlice("aaqs", "aaqs/finalizers")),
@@ -591,7 +617,7 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 		},
 		{
 			APIGroups: stringListToSlice("admissionregistration.k8s.io"),
-			Resources: stringListToSlice("validatingwebhookconfigurations"),
+			Resources: stringListToSlice("validatingwebhookconfigurations", "mutatingwebhookconfigurations"),
 			Verbs:     stringListToSlice("list", "watch", "update", "patch"),
 		},
 		roleWithAllPermissions("console.openshift.io", stringListToSlice("consoleclidownloads", "consolequickstarts")),
@@ -895,6 +921,60 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 		WebhookPath: ptr.To(util.HCOMutatingWebhookPath),
 	}
 
+	// The HyperConvergedProfile webhooks only apply optional defaults; don't block the VM creation if the webhook is
+	// not available, so failurePolicy = admissionregistrationv1.Ignore
+	mutatingVMIWebhook := csvv1alpha1.WebhookDescription{
+		GenerateName:            util.HcoMutatingWebhookVMI,
+		Type:                    csvv1alpha1.MutatingAdmissionWebhook,
+		DeploymentName:          hcoWhDeploymentName,
+		ContainerPort:           util.WebhookPort,
+		AdmissionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+		FailurePolicy:           ptr.To(admissionregistrationv1.Ignore),
+		TimeoutSeconds:          ptr.To[int32](10),
+		ReinvocationPolicy:      ptr.To(admissionregistrationv1.IfNeededReinvocationPolicy),
+		Rules: []admissionregistrationv1.RuleWithOperations{
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Create,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice(kvapi.GroupName),
+					APIVersions: stringListToSlice("v1"),
+					Resources:   stringListToSlice("virtualmachineinstances"),
+				},
+			},
+		},
+		WebhookPath: ptr.To(util.HCOVMIWebhookPath),
+	}
+
+	mutatingVirtLauncherWebhook := csvv1alpha1.WebhookDescription{
+		GenerateName:            util.HcoMutatingWebhookVirtLauncher,
+		Type:                    csvv1alpha1.MutatingAdmissionWebhook,
+		DeploymentName:          hcoWhDeploymentName,
+		ContainerPort:           util.WebhookPort,
+		AdmissionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+		FailurePolicy:           ptr.To(admissionregistrationv1.Ignore),
+		TimeoutSeconds:          ptr.To[int32](10),
+		ObjectSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"kubevirt.io": "virt-launcher"},
+		},
+		Rules: []admissionregistrationv1.RuleWithOperations{
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Create,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   []string{""},
+					APIVersions: stringListToSlice("v1"),
+					Resources:   stringListToSlice("pods"),
+				},
+			},
+		},
+		WebhookPath: ptr.To(util.HCOLauncherWebhookPath),
+	}
+
 	return &csvv1alpha1.ClusterServiceVersion{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "operators.coreos.com/v1alpha1",
@@ -999,6 +1079,8 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 				validatingWebhook,
 				mutatingNamespaceWebhook,
 				mutatingHyperConvergedWebhook,
+				mutatingVMIWebhook,
+				mutatingVirtLauncherWebhook,
 			},
 			CustomResourceDefinitions: csvv1alpha1.CustomResourceDefinitions{
 				Owned: []csvv1alpha1.CRDDescription{
@@ -1070,6 +1152,13 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 						},
 						StatusDescriptors: []csvv1alpha1.StatusDescriptor{},
 					},
+					{
+						Name:        "hyperconvergedprofiles.hco.kubevirt.io",
+						Version:     util.CurrentAPIVersion,
+						Kind:        util.HyperConvergedProfileKind,
+						DisplayName: params.CrdDisplay + " Namespace Profile",
+						Description: "Overrides the VM defaults of " + params.CrdDisplay + " for a single namespace",
+					},
 				},
 				Required: []csvv1alpha1.CRDDescription{},
 			},
@@ -0,0 +1,85 @@
+package maintenancewindow
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+// stop extending an open window by overlapping activations after this many iterations; the caller re-evaluates the
+// windows when the returned transition time is reached anyway
+const maxOverlappingActivations = 1000
+
+// State is the state of a list of maintenance windows, at a specific time
+type State struct {
+	// Open is true if the time is within at least one of the windows
+	Open bool
+	// NextTransition is when the state is expected to change: when the current windows close, if Open is true,
+	// or when the next window opens, if Open is false. Zero if no window will ever open.
+	NextTransition time.Time
+}
+
+// Validate checks that all the maintenance windows have a valid schedule and a positive duration
+func Validate(windows []hcov1beta1.MaintenanceWindow) error {
+	for i, window := range windows {
+		if _, err := ParseSchedule(window.Schedule); err != nil {
+			return fmt.Errorf("maintenanceWindows[%d]: %w", i, err)
+		}
+
+		if window.Duration.Duration <= 0 {
+			return fmt.Errorf("maintenanceWindows[%d]: the duration must be positive", i)
+		}
+	}
+
+	return nil
+}
+
+// GetState returns the state of the maintenance windows at the given time. Calling GetState with an empty window list
+// is an error; the caller should treat a missing window list as "always open".
+func GetState(windows []hcov1beta1.MaintenanceWindow, now time.Time) (State, error) {
+	if len(windows) == 0 {
+		return State{}, errors.New("no maintenance window")
+	}
+
+	if err := Validate(windows); err != nil {
+		return State{}, err
+	}
+
+	state := State{}
+	for _, window := range windows {
+		schedule, _ := ParseSchedule(window.Schedule)
+		duration := window.Duration.Duration
+
+		// the last activation that is still open, if any, is the first one after now - duration
+		start := schedule.Next(now.Add(-duration))
+		if start.IsZero() {
+			continue
+		}
+
+		if start.After(now) {
+			if !state.Open && (state.NextTransition.IsZero() || start.Before(state.NextTransition)) {
+				state.NextTransition = start
+			}
+			continue
+		}
+
+		end := start.Add(duration)
+		for range maxOverlappingActivations {
+			next := schedule.Next(start)
+			if next.IsZero() || next.After(end) {
+				break
+			}
+			start = next
+			end = next.Add(duration)
+		}
+
+		if !state.Open || end.After(state.NextTransition) {
+			state.NextTransition = end
+		}
+		state.Open = true
+	}
+
+	return state, nil
+}
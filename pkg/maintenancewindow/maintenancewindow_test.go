@@ -0,0 +1,110 @@
+package maintenancewindow
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+var _ = Describe("Maintenance windows", func() {
+	window := func(schedule string, duration time.Duration) hcov1beta1.MaintenanceWindow {
+		return hcov1beta1.MaintenanceWindow{Schedule: schedule, Duration: metav1.Duration{Duration: duration}}
+	}
+
+	Context("Validate", func() {
+		It("should accept valid windows", func() {
+			Expect(Validate([]hcov1beta1.MaintenanceWindow{
+				window("0 2 * * *", 2*time.Hour),
+				window("0 0 * * 6", time.Hour),
+			})).To(Succeed())
+		})
+
+		It("should reject a window with invalid schedule", func() {
+			err := Validate([]hcov1beta1.MaintenanceWindow{
+				window("0 2 * * *", 2*time.Hour),
+				window("0 2 * *", 2*time.Hour),
+			})
+			Expect(err).To(MatchError(ContainSubstring("maintenanceWindows[1]")))
+		})
+
+		It("should reject a window with no duration", func() {
+			err := Validate([]hcov1beta1.MaintenanceWindow{window("0 2 * * *", 0)})
+			Expect(err).To(MatchError(ContainSubstring("the duration must be positive")))
+		})
+	})
+
+	Context("GetState", func() {
+		// 10:30 UTC
+		now := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+		It("should return an error for an empty list", func() {
+			_, err := GetState(nil, now)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error for an invalid window", func() {
+			_, err := GetState([]hcov1beta1.MaintenanceWindow{window("wrong", time.Hour)}, now)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should be open within a window", func() {
+			state, err := GetState([]hcov1beta1.MaintenanceWindow{window("0 10 * * *", time.Hour)}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Open).To(BeTrue())
+			Expect(state.NextTransition).To(Equal(time.Date(2025, time.January, 15, 11, 0, 0, 0, time.UTC)))
+		})
+
+		It("should be open when the window just opened", func() {
+			state, err := GetState([]hcov1beta1.MaintenanceWindow{window("30 10 * * *", time.Hour)}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Open).To(BeTrue())
+			Expect(state.NextTransition).To(Equal(time.Date(2025, time.January, 15, 11, 30, 0, 0, time.UTC)))
+		})
+
+		It("should be closed when the window just closed", func() {
+			state, err := GetState([]hcov1beta1.MaintenanceWindow{window("0 10 * * *", 30*time.Minute)}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Open).To(BeFalse())
+			Expect(state.NextTransition).To(Equal(time.Date(2025, time.January, 16, 10, 0, 0, 0, time.UTC)))
+		})
+
+		It("should be closed outside the windows, and return the closest next window", func() {
+			state, err := GetState([]hcov1beta1.MaintenanceWindow{
+				window("0 22 * * *", time.Hour),
+				window("0 12 * * *", time.Hour),
+			}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Open).To(BeFalse())
+			Expect(state.NextTransition).To(Equal(time.Date(2025, time.January, 15, 12, 0, 0, 0, time.UTC)))
+		})
+
+		It("should use the latest closing time of the open windows", func() {
+			state, err := GetState([]hcov1beta1.MaintenanceWindow{
+				window("0 12 * * *", time.Hour),
+				window("0 10 * * *", time.Hour),
+				window("0 9 * * *", 3*time.Hour),
+			}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Open).To(BeTrue())
+			Expect(state.NextTransition).To(Equal(time.Date(2025, time.January, 15, 12, 0, 0, 0, time.UTC)))
+		})
+
+		It("should extend the window by overlapping activations", func() {
+			state, err := GetState([]hcov1beta1.MaintenanceWindow{window("0 * * * *", 90*time.Minute)}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Open).To(BeTrue())
+			Expect(state.NextTransition).To(Equal(now.Add(1000 * time.Hour).Add(time.Hour)))
+		})
+
+		It("should return zero transition time if no window ever opens", func() {
+			state, err := GetState([]hcov1beta1.MaintenanceWindow{window("0 0 31 2 *", time.Hour)}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.Open).To(BeFalse())
+			Expect(state.NextTransition).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,193 @@
+package maintenancewindow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the search for the next activation gives up after this period; long enough for schedules like "0 0 29 2 *"
+const maxSearchPeriod = 30 * 366 * 24 * time.Hour
+
+type field struct {
+	name string
+	min  uint
+	max  uint
+}
+
+var (
+	minuteField     = field{name: "minute", min: 0, max: 59}
+	hourField       = field{name: "hour", min: 0, max: 23}
+	dayOfMonthField = field{name: "day of month", min: 1, max: 31}
+	monthField      = field{name: "month", min: 1, max: 12}
+	dayOfWeekField  = field{name: "day of week", min: 0, max: 7}
+)
+
+// Schedule is a parsed five-field cron expression
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	// in cron, if both the day of month and the day of week are restricted, a day matches if any of them matches
+	anyDay bool
+}
+
+// ParseSchedule parses a standard five-field cron expression: minute, hour, day of month, month and day of week.
+// Each field supports "*", single values, ranges ("1-5"), lists ("1,3,5") and steps ("*/15", "0-30/10").
+func ParseSchedule(spec string) (*Schedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q; expected 5 fields, but found %d", spec, len(fields))
+	}
+
+	s := &Schedule{}
+	var err error
+
+	for _, f := range []struct {
+		expr   string
+		field  field
+		target *uint64
+	}{
+		{expr: fields[0], field: minuteField, target: &s.minute},
+		{expr: fields[1], field: hourField, target: &s.hour},
+		{expr: fields[2], field: dayOfMonthField, target: &s.dayOfMonth},
+		{expr: fields[3], field: monthField, target: &s.month},
+		{expr: fields[4], field: dayOfWeekField, target: &s.dayOfWeek},
+	} {
+		if *f.target, err = parseField(f.expr, f.field); err != nil {
+			return nil, fmt.Errorf("invalid schedule %q; %w", spec, err)
+		}
+	}
+
+	// both 0 and 7 are Sunday
+	if s.dayOfWeek&(1<<7) != 0 {
+		s.dayOfWeek |= 1
+	}
+
+	s.anyDay = !strings.HasPrefix(fields[2], "*") && !strings.HasPrefix(fields[4], "*")
+
+	return s, nil
+}
+
+// Next returns the first activation time of the schedule, that is strictly after t. The returned time is in UTC.
+// If there is no such time within a reasonable period, Next returns the zero time.
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxSearchPeriod)
+
+	for t.Before(limit) {
+		if !isSet(s.month, uint(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !isSet(s.hour, uint(t.Hour())) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !isSet(s.minute, uint(t.Minute())) {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := isSet(s.dayOfMonth, uint(t.Day()))
+	dowMatch := isSet(s.dayOfWeek, uint(t.Weekday()))
+
+	if s.anyDay {
+		return domMatch || dowMatch
+	}
+
+	return domMatch && dowMatch
+}
+
+func parseField(expr string, f field) (uint64, error) {
+	var mask uint64
+
+	for _, part := range strings.Split(expr, ",") {
+		partMask, err := parseRange(part, f)
+		if err != nil {
+			return 0, err
+		}
+
+		mask |= partMask
+	}
+
+	return mask, nil
+}
+
+func parseRange(expr string, f field) (uint64, error) {
+	rangeExpr, stepExpr, hasStep := strings.Cut(expr, "/")
+
+	step := uint(1)
+	if hasStep {
+		val, err := strconv.ParseUint(stepExpr, 10, 8)
+		if err != nil || val == 0 {
+			return 0, fmt.Errorf("invalid step %q in the %s field", stepExpr, f.name)
+		}
+		step = uint(val)
+	}
+
+	var start, end uint
+	switch {
+	case rangeExpr == "*":
+		start, end = f.min, f.max
+	case strings.Contains(rangeExpr, "-"):
+		startExpr, endExpr, _ := strings.Cut(rangeExpr, "-")
+		var err error
+		if start, err = parseValue(startExpr, f); err != nil {
+			return 0, err
+		}
+		if end, err = parseValue(endExpr, f); err != nil {
+			return 0, err
+		}
+		if start > end {
+			return 0, fmt.Errorf("invalid range %q in the %s field", rangeExpr, f.name)
+		}
+	default:
+		val, err := parseValue(rangeExpr, f)
+		if err != nil {
+			return 0, err
+		}
+		start, end = val, val
+		if hasStep {
+			end = f.max
+		}
+	}
+
+	var mask uint64
+	for i := start; i <= end; i += step {
+		mask |= 1 << i
+	}
+
+	return mask, nil
+}
+
+func parseValue(expr string, f field) (uint, error) {
+	val, err := strconv.ParseUint(expr, 10, 8)
+	if err != nil || uint(val) < f.min || uint(val) > f.max {
+		return 0, fmt.Errorf("invalid value %q in the %s field; must be between %d and %d", expr, f.name, f.min, f.max)
+	}
+
+	return uint(val), nil
+}
+
+func isSet(mask uint64, i uint) bool {
+	return mask&(1<<i) != 0
+}
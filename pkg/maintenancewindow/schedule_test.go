@@ -0,0 +1,58 @@
+package maintenancewindow
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	// Wednesday
+	base := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	DescribeTable("should find the next activation", func(spec string, from, expected time.Time) {
+		schedule, err := ParseSchedule(spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(from)).To(Equal(expected))
+	},
+		Entry("every minute", "* * * * *", base, base.Add(time.Minute)),
+		Entry("every minute, from a time with seconds", "* * * * *", base.Add(20*time.Second), base.Add(time.Minute)),
+		Entry("every 15 minutes", "*/15 * * * *", base, time.Date(2025, time.January, 15, 10, 45, 0, 0, time.UTC)),
+		Entry("daily, later today", "0 22 * * *", base, time.Date(2025, time.January, 15, 22, 0, 0, 0, time.UTC)),
+		Entry("daily, tomorrow", "0 2 * * *", base, time.Date(2025, time.January, 16, 2, 0, 0, 0, time.UTC)),
+		Entry("list of hours", "0 1,12,23 * * *", base, time.Date(2025, time.January, 15, 12, 0, 0, 0, time.UTC)),
+		Entry("weekend", "0 1 * * 6-7", base, time.Date(2025, time.January, 18, 1, 0, 0, 0, time.UTC)),
+		Entry("sunday as 7", "0 1 * * 7", base, time.Date(2025, time.January, 19, 1, 0, 0, 0, time.UTC)),
+		Entry("sunday as 0", "0 1 * * 0", base, time.Date(2025, time.January, 19, 1, 0, 0, 0, time.UTC)),
+		Entry("monthly", "0 0 1 * *", base, time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("next year", "0 0 1 1 *", base, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("leap day", "0 0 29 2 *", base, time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("day of month or day of week", "0 0 20 * 5", base, time.Date(2025, time.January, 17, 0, 0, 0, 0, time.UTC)),
+		Entry("non-UTC input", "0 12 * * *", time.Date(2025, time.January, 15, 10, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)), time.Date(2025, time.January, 15, 12, 0, 0, 0, time.UTC)),
+	)
+
+	It("should return zero time for a schedule that never activates", func() {
+		schedule, err := ParseSchedule("0 0 31 2 *")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(base)).To(BeZero())
+	})
+
+	DescribeTable("should reject invalid schedules", func(spec string) {
+		_, err := ParseSchedule(spec)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("empty", ""),
+		Entry("too few fields", "* * * *"),
+		Entry("too many fields", "* * * * * *"),
+		Entry("minute out of range", "60 * * * *"),
+		Entry("hour out of range", "0 24 * * *"),
+		Entry("day of month out of range", "0 0 0 * *"),
+		Entry("month out of range", "0 0 * 13 *"),
+		Entry("day of week out of range", "0 0 * * 8"),
+		Entry("reversed range", "0 5-1 * * *"),
+		Entry("zero step", "*/0 * * * *"),
+		Entry("not a number", "a * * * *"),
+		Entry("names are not supported", "0 0 * * MON"),
+	)
+})
@@ -0,0 +1,143 @@
+package metrics
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+)
+
+const (
+	labelFeatureGate   = "feature_gate"
+	labelProfileType   = "profile_type"
+	labelMinTLSVersion = "min_tls_version"
+
+	infoValue = 1.0
+)
+
+// EffectiveConfig is the effective operand configuration, as derived from the HyperConverged CR
+type EffectiveConfig struct {
+	KubeVirtFeatureGates              []string
+	VMICPUAllocationRatio             int
+	MemoryOvercommitPercentage        int
+	ParallelMigrationsPerCluster      uint32
+	ParallelOutboundMigrationsPerNode uint32
+	TLSProfileType                    string
+	TLSMinVersion                     string
+}
+
+var (
+	configMetrics = []operatormetrics.Metric{
+		kubevirtFeatureGateInfo,
+		vmiCPUAllocationRatio,
+		memoryOvercommitPercentage,
+		parallelMigrationsPerCluster,
+		parallelOutboundMigrationsPerNode,
+		tlsSecurityProfileInfo,
+	}
+
+	kubevirtFeatureGateInfo = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_kubevirt_feature_gate_info",
+			Help: "The feature gates that HCO enables in the KubeVirt CR; the value is always 1",
+		},
+		[]string{labelFeatureGate},
+	)
+
+	vmiCPUAllocationRatio = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_vmi_cpu_allocation_ratio",
+			Help: "The VMI CPU allocation ratio that HCO sets in the KubeVirt CR",
+		},
+	)
+
+	memoryOvercommitPercentage = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_memory_overcommit_percentage",
+			Help: "The memory overcommit percentage that HCO sets in the KubeVirt CR",
+		},
+	)
+
+	parallelMigrationsPerCluster = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_parallel_migrations_per_cluster",
+			Help: "The maximum number of parallel live migrations in the cluster, that HCO sets in the KubeVirt CR",
+		},
+	)
+
+	parallelOutboundMigrationsPerNode = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_parallel_outbound_migrations_per_node",
+			Help: "The maximum number of parallel outbound live migrations per node, that HCO sets in the KubeVirt CR",
+		},
+	)
+
+	tlsSecurityProfileInfo = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_tls_security_profile_info",
+			Help: "The TLS security profile that HCO propagates to the operands; the value is always 1",
+		},
+		[]string{labelProfileType, labelMinTLSVersion},
+	)
+)
+
+// SetEffectiveConfig refreshes the effective configuration metrics. The info metrics are reset first, to remove the
+// series of values that are no longer in use.
+func SetEffectiveConfig(cfg EffectiveConfig) {
+	kubevirtFeatureGateInfo.Reset()
+	for _, fg := range cfg.KubeVirtFeatureGates {
+		kubevirtFeatureGateInfo.WithLabelValues(fg).Set(infoValue)
+	}
+
+	vmiCPUAllocationRatio.Set(float64(cfg.VMICPUAllocationRatio))
+	memoryOvercommitPercentage.Set(float64(cfg.MemoryOvercommitPercentage))
+	parallelMigrationsPerCluster.Set(float64(cfg.ParallelMigrationsPerCluster))
+	parallelOutboundMigrationsPerNode.Set(float64(cfg.ParallelOutboundMigrationsPerNode))
+
+	tlsSecurityProfileInfo.Reset()
+	tlsSecurityProfileInfo.WithLabelValues(cfg.TLSProfileType, cfg.TLSMinVersion).Set(infoValue)
+}
+
+// IsKubeVirtFeatureGateEnabled returns true if the feature gate is reported as enabled in the KubeVirt CR
+func IsKubeVirtFeatureGateEnabled(fg string) (bool, error) {
+	return isInfoSet(kubevirtFeatureGateInfo, fg)
+}
+
+// IsTLSSecurityProfileInUse returns true if the TLS security profile is reported as in use
+func IsTLSSecurityProfileInUse(profileType, minTLSVersion string) (bool, error) {
+	return isInfoSet(tlsSecurityProfileInfo, profileType, minTLSVersion)
+}
+
+func GetVMICPUAllocationRatio() (float64, error) {
+	return getGaugeValue(vmiCPUAllocationRatio)
+}
+
+func GetMemoryOvercommitPercentage() (float64, error) {
+	return getGaugeValue(memoryOvercommitPercentage)
+}
+
+func GetParallelMigrationsPerCluster() (float64, error) {
+	return getGaugeValue(parallelMigrationsPerCluster)
+}
+
+func GetParallelOutboundMigrationsPerNode() (float64, error) {
+	return getGaugeValue(parallelOutboundMigrationsPerNode)
+}
+
+func isInfoSet(gaugeVec *operatormetrics.GaugeVec, labelValues ...string) (bool, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := gaugeVec.WithLabelValues(labelValues...).Write(dto)
+	if err != nil {
+		return false, err
+	}
+
+	return dto.Gauge.GetValue() == infoValue, nil
+}
+
+func getGaugeValue(gauge *operatormetrics.Gauge) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := gauge.Write(dto)
+	if err != nil {
+		return 0, err
+	}
+
+	return dto.Gauge.GetValue(), nil
+}
@@ -0,0 +1,49 @@
+package metrics_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+var _ = Describe("Effective Configuration Metrics", func() {
+	It("should set the effective configuration metrics", func() {
+		metrics.SetEffectiveConfig(metrics.EffectiveConfig{
+			KubeVirtFeatureGates:              []string{"Snapshot", "HotplugVolumes"},
+			VMICPUAllocationRatio:             8,
+			MemoryOvercommitPercentage:        150,
+			ParallelMigrationsPerCluster:      10,
+			ParallelOutboundMigrationsPerNode: 3,
+			TLSProfileType:                    "Intermediate",
+			TLSMinVersion:                     "VersionTLS12",
+		})
+
+		Expect(metrics.IsKubeVirtFeatureGateEnabled("Snapshot")).To(BeTrue())
+		Expect(metrics.IsKubeVirtFeatureGateEnabled("HotplugVolumes")).To(BeTrue())
+		Expect(metrics.GetVMICPUAllocationRatio()).To(BeEquivalentTo(8))
+		Expect(metrics.GetMemoryOvercommitPercentage()).To(BeEquivalentTo(150))
+		Expect(metrics.GetParallelMigrationsPerCluster()).To(BeEquivalentTo(10))
+		Expect(metrics.GetParallelOutboundMigrationsPerNode()).To(BeEquivalentTo(3))
+		Expect(metrics.IsTLSSecurityProfileInUse("Intermediate", "VersionTLS12")).To(BeTrue())
+	})
+
+	It("should remove the series of values that are no longer in use", func() {
+		metrics.SetEffectiveConfig(metrics.EffectiveConfig{
+			KubeVirtFeatureGates: []string{"Snapshot", "HotplugVolumes"},
+			TLSProfileType:       "Intermediate",
+			TLSMinVersion:        "VersionTLS12",
+		})
+
+		metrics.SetEffectiveConfig(metrics.EffectiveConfig{
+			KubeVirtFeatureGates: []string{"Snapshot"},
+			TLSProfileType:       "Modern",
+			TLSMinVersion:        "VersionTLS13",
+		})
+
+		Expect(metrics.IsKubeVirtFeatureGateEnabled("Snapshot")).To(BeTrue())
+		Expect(metrics.IsKubeVirtFeatureGateEnabled("HotplugVolumes")).To(BeFalse())
+		Expect(metrics.IsTLSSecurityProfileInUse("Modern", "VersionTLS13")).To(BeTrue())
+		Expect(metrics.IsTLSSecurityProfileInUse("Intermediate", "VersionTLS12")).To(BeFalse())
+	})
+})
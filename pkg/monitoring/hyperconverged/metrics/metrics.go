@@ -6,6 +6,8 @@ func SetupMetrics() error {
 	return operatormetrics.RegisterMetrics(
 		operatorMetrics,
 		infrastructureMetrics,
+		configMetrics,
+		reconcileMetrics,
 	)
 }
 
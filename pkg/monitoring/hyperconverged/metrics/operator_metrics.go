@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"strings"
+	"time"
 
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
 	ioprometheusclient "github.com/prometheus/client_model/go"
@@ -10,6 +11,7 @@ import (
 const (
 	counterLabelCompName = "component_name"
 	counterLabelAnnName  = "annotation_name"
+	labelComponent       = "component"
 
 	hyperConvergedExists    = 1.0
 	hyperConvergedNotExists = 0.0
@@ -30,6 +32,12 @@ const (
 
 	hasArchitectureAnnotation   = float64(1)
 	hasNoArchitectureAnnotation = float64(0)
+
+	dictUpToDate    = float64(1)
+	dictNotUpToDate = float64(0)
+
+	dictSourceReady    = float64(1)
+	dictSourceNotReady = float64(0)
 )
 
 var (
@@ -38,8 +46,12 @@ var (
 		unsafeModifications,
 		hyperConvergedCRExists,
 		systemHealthStatus,
+		componentHealthStatus,
 		dictWithSupportedArchitectures,
 		dictWithArchitectureAnnotation,
+		dictLastImportTimestamp,
+		dictIsUpToDate,
+		dictIsSourceReady,
 	}
 
 	overwrittenModifications = operatormetrics.NewCounterVec(
@@ -72,6 +84,14 @@ var (
 		},
 	)
 
+	componentHealthStatus = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_component_health_status",
+			Help: "Indicates whether the health status of a component that HCO deploys is healthy (0), warning (1), or error (2), based on the conditions of the component",
+		},
+		[]string{labelComponent},
+	)
+
 	dictWithSupportedArchitectures = operatormetrics.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_hco_dataimportcrontemplate_with_supported_architectures",
@@ -87,6 +107,30 @@ var (
 		},
 		[]string{counterLabelDICTName, counterLabelDSName},
 	)
+
+	dictLastImportTimestamp = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_dataimportcrontemplate_last_import_timestamp_seconds",
+			Help: "The time of the last successful import of the DataImportCronTemplate image, in seconds since the epoch; 0 if the image was never imported",
+		},
+		[]string{counterLabelDICTName, counterLabelDSName},
+	)
+
+	dictIsUpToDate = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_dataimportcrontemplate_up_to_date",
+			Help: "Indicates whether the last imported image of the DataImportCronTemplate is the latest image in the source registry (1) or not (0)",
+		},
+		[]string{counterLabelDICTName, counterLabelDSName},
+	)
+
+	dictIsSourceReady = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_dataimportcrontemplate_source_ready",
+			Help: "Indicates whether the DataSource of the DataImportCronTemplate is ready to be used (1) or not (0)",
+		},
+		[]string{counterLabelDICTName, counterLabelDSName},
+	)
 )
 
 // IncOverwrittenModifications increments counter by 1
@@ -161,6 +205,25 @@ func GetHCOMetricSystemHealthStatus() (float64, error) {
 	return value, nil
 }
 
+// SetHCOMetricComponentsHealthStatus sets the health status of each component, by the component name. The metric is
+// reset first, to remove the components that are no longer deployed.
+func SetHCOMetricComponentsHealthStatus(statuses map[string]float64) {
+	componentHealthStatus.Reset()
+	for component, status := range statuses {
+		componentHealthStatus.WithLabelValues(component).Set(status)
+	}
+}
+
+func GetHCOMetricComponentHealthStatus(component string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := componentHealthStatus.WithLabelValues(component).Write(dto)
+	if err != nil {
+		return 0, err
+	}
+
+	return dto.Gauge.GetValue(), nil
+}
+
 func SetDICTWithSupportedArchitectures(dictName, dsName string) {
 	dictWithSupportedArchitectures.WithLabelValues(getLabelsForDataImportCron(dictName, dsName)).Set(hasSupportedArchitectures)
 }
@@ -208,6 +271,72 @@ func IsDICTWithArchitectureAnnotation(dictName, dsName string) (bool, error) {
 	return value == hasArchitectureAnnotation, nil
 }
 
+// SetDICTImportStatus sets the import status metrics of the DataImportCronTemplate
+func SetDICTImportStatus(dictName, dsName string, lastImportTimestamp time.Time, upToDate, sourceReady bool) {
+	dictName, dsName = getLabelsForDataImportCron(dictName, dsName)
+
+	timestamp := float64(0)
+	if !lastImportTimestamp.IsZero() {
+		timestamp = float64(lastImportTimestamp.Unix())
+	}
+	dictLastImportTimestamp.WithLabelValues(dictName, dsName).Set(timestamp)
+
+	if upToDate {
+		dictIsUpToDate.WithLabelValues(dictName, dsName).Set(dictUpToDate)
+	} else {
+		dictIsUpToDate.WithLabelValues(dictName, dsName).Set(dictNotUpToDate)
+	}
+
+	if sourceReady {
+		dictIsSourceReady.WithLabelValues(dictName, dsName).Set(dictSourceReady)
+	} else {
+		dictIsSourceReady.WithLabelValues(dictName, dsName).Set(dictSourceNotReady)
+	}
+}
+
+// ResetDICTImportStatus removes the import status metrics of all the DataImportCronTemplates
+func ResetDICTImportStatus() {
+	dictLastImportTimestamp.Reset()
+	dictIsUpToDate.Reset()
+	dictIsSourceReady.Reset()
+}
+
+func GetDICTLastImportTimestamp(dictName, dsName string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := dictLastImportTimestamp.WithLabelValues(getLabelsForDataImportCron(dictName, dsName)).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return value, nil
+}
+
+func IsDICTUpToDate(dictName, dsName string) (bool, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := dictIsUpToDate.WithLabelValues(getLabelsForDataImportCron(dictName, dsName)).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return false, err
+	}
+
+	return value == dictUpToDate, nil
+}
+
+func IsDICTSourceReady(dictName, dsName string) (bool, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := dictIsSourceReady.WithLabelValues(getLabelsForDataImportCron(dictName, dsName)).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return false, err
+	}
+
+	return value == dictSourceReady, nil
+}
+
 func getLabelsForObj(kind string, name string) string {
 	return strings.ToLower(kind + "/" + name)
 }
@@ -0,0 +1,120 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/prometheus/client_golang/prometheus"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+)
+
+const (
+	labelResult  = "result"
+	labelHandler = "handler"
+	labelReason  = "reason"
+)
+
+// The results of a reconciliation, for the kubevirt_hco_reconcile_duration_seconds metric
+const (
+	ReconcileResultSuccess = "success"
+	ReconcileResultRequeue = "requeue"
+	ReconcileResultError   = "error"
+)
+
+// The results of an operand reconciliation, for the kubevirt_hco_operand_ensure_duration_seconds metric
+const (
+	EnsureResultCreated     = "created"
+	EnsureResultUpdated     = "updated"
+	EnsureResultOverwritten = "overwritten"
+	EnsureResultDeleted     = "deleted"
+	EnsureResultUnchanged   = "unchanged"
+	EnsureResultError       = "error"
+)
+
+var (
+	// from 5ms to ~41s
+	durationBuckets = prometheus.ExponentialBuckets(0.005, 2, 14)
+
+	reconcileMetrics = []operatormetrics.Metric{
+		reconcileDuration,
+		operandEnsureDuration,
+		reconcileRequeues,
+	}
+
+	reconcileDuration = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_reconcile_duration_seconds",
+			Help: "The duration of the HyperConverged reconciliation, in seconds, by its result (success, requeue or error)",
+		},
+		prometheus.HistogramOpts{Buckets: durationBuckets},
+		[]string{labelResult},
+	)
+
+	operandEnsureDuration = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_operand_ensure_duration_seconds",
+			Help: "The duration of a single operand reconciliation, in seconds, by the operand kind and the reconciliation result (created, updated, overwritten, deleted, unchanged or error)",
+		},
+		prometheus.HistogramOpts{Buckets: durationBuckets},
+		[]string{labelHandler, labelResult},
+	)
+
+	reconcileRequeues = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_reconcile_requeues_total",
+			Help: "Count of the HyperConverged reconciliations that were requeued, by the requeue reason",
+		},
+		[]string{labelReason},
+	)
+)
+
+// ObserveReconcileDuration records the duration of a HyperConverged reconciliation
+func ObserveReconcileDuration(duration time.Duration, result string) {
+	reconcileDuration.WithLabelValues(result).Observe(duration.Seconds())
+}
+
+// ObserveOperandEnsureDuration records the duration of a single operand reconciliation
+func ObserveOperandEnsureDuration(handler string, duration time.Duration, result string) {
+	operandEnsureDuration.WithLabelValues(handler, result).Observe(duration.Seconds())
+}
+
+// IncReconcileRequeues increments the requeue counter of the reason by 1
+func IncReconcileRequeues(reason string) {
+	reconcileRequeues.WithLabelValues(reason).Inc()
+}
+
+// GetReconcileDurationCount returns the number of the recorded reconciliations with the result
+func GetReconcileDurationCount(result string) (uint64, error) {
+	return getHistogramCount(reconcileDuration, result)
+}
+
+// GetOperandEnsureDurationCount returns the number of the recorded reconciliations of the operand, with the result
+func GetOperandEnsureDurationCount(handler, result string) (uint64, error) {
+	return getHistogramCount(operandEnsureDuration, handler, result)
+}
+
+// GetReconcileRequeuesCount returns current value of the requeue counter of the reason
+func GetReconcileRequeuesCount(reason string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := reconcileRequeues.WithLabelValues(reason).Write(dto)
+	if err != nil {
+		return 0, err
+	}
+
+	return dto.Counter.GetValue(), nil
+}
+
+func getHistogramCount(histogramVec *operatormetrics.HistogramVec, labelValues ...string) (uint64, error) {
+	dto := &ioprometheusclient.Metric{}
+	histogram, ok := histogramVec.WithLabelValues(labelValues...).(prometheus.Metric)
+	if !ok {
+		return 0, errors.New("can't read the histogram")
+	}
+
+	if err := histogram.Write(dto); err != nil {
+		return 0, err
+	}
+
+	return dto.Histogram.GetSampleCount(), nil
+}
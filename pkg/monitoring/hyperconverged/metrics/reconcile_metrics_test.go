@@ -0,0 +1,47 @@
+package metrics_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+var _ = Describe("Reconcile Metrics", func() {
+	It("should record the reconcile duration by the result", func() {
+		successBefore, err := metrics.GetReconcileDurationCount(metrics.ReconcileResultSuccess)
+		Expect(err).ToNot(HaveOccurred())
+		errorBefore, err := metrics.GetReconcileDurationCount(metrics.ReconcileResultError)
+		Expect(err).ToNot(HaveOccurred())
+
+		metrics.ObserveReconcileDuration(20*time.Millisecond, metrics.ReconcileResultSuccess)
+		metrics.ObserveReconcileDuration(2*time.Second, metrics.ReconcileResultSuccess)
+
+		Expect(metrics.GetReconcileDurationCount(metrics.ReconcileResultSuccess)).To(Equal(successBefore + 2))
+		Expect(metrics.GetReconcileDurationCount(metrics.ReconcileResultError)).To(Equal(errorBefore))
+	})
+
+	It("should record the operand ensure duration by the handler and the result", func() {
+		before, err := metrics.GetOperandEnsureDurationCount("KubeVirt", metrics.EnsureResultUpdated)
+		Expect(err).ToNot(HaveOccurred())
+		otherBefore, err := metrics.GetOperandEnsureDurationCount("CDI", metrics.EnsureResultUpdated)
+		Expect(err).ToNot(HaveOccurred())
+
+		metrics.ObserveOperandEnsureDuration("KubeVirt", 100*time.Millisecond, metrics.EnsureResultUpdated)
+
+		Expect(metrics.GetOperandEnsureDurationCount("KubeVirt", metrics.EnsureResultUpdated)).To(Equal(before + 1))
+		Expect(metrics.GetOperandEnsureDurationCount("CDI", metrics.EnsureResultUpdated)).To(Equal(otherBefore))
+	})
+
+	It("should count the requeues by the reason", func() {
+		before, err := metrics.GetReconcileRequeuesCount("upgrade")
+		Expect(err).ToNot(HaveOccurred())
+
+		metrics.IncReconcileRequeues("upgrade")
+		metrics.IncReconcileRequeues("upgrade")
+
+		Expect(metrics.GetReconcileRequeuesCount("upgrade")).To(Equal(before + 2))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/machadovilaca/operator-observability/pkg/operatorrules"
@@ -29,9 +30,7 @@ func Register(operatorRegistry *operatorrules.Registry) error {
 	runbookURLTemplate := getRunbookURLTemplate()
 	for _, alertGroup := range alerts {
 		for _, alert := range alertGroup {
-			alert.Labels[partOfAlertLabelKey] = partOfAlertLabelValue
-			alert.Labels[componentAlertLabelKey] = componentAlertLabelValue
-			alert.Annotations[prometheusRunbookAnnotationKey] = fmt.Sprintf(runbookURLTemplate, alert.Alert)
+			setCommonDetails(&alert, runbookURLTemplate)
 		}
 
 	}
@@ -39,6 +38,28 @@ func Register(operatorRegistry *operatorrules.Registry) error {
 	return operatorRegistry.RegisterAlerts(alerts...)
 }
 
+// AlertNames returns the names of the HCO alerts
+func AlertNames() []string {
+	var names []string
+	for _, alert := range slices.Concat(operatorAlerts(), healthAlerts()) {
+		names = append(names, alert.Alert)
+	}
+
+	return names
+}
+
+// SetCommonLabels sets the labels that all the HCO alerts have. Unlike the static HCO alerts, it does not set the
+// runbook URL annotation, so it may be used for generated alerts that have no runbook.
+func SetCommonLabels(alert *promv1.Rule) {
+	alert.Labels[partOfAlertLabelKey] = partOfAlertLabelValue
+	alert.Labels[componentAlertLabelKey] = componentAlertLabelValue
+}
+
+func setCommonDetails(alert *promv1.Rule, runbookURLTemplate string) {
+	SetCommonLabels(alert)
+	alert.Annotations[prometheusRunbookAnnotationKey] = fmt.Sprintf(runbookURLTemplate, alert.Alert)
+}
+
 func getRunbookURLTemplate() string {
 	runbookURLTemplate, exists := os.LookupEnv(runbookURLTemplateEnv)
 	if !exists {
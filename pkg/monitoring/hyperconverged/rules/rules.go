@@ -1,12 +1,17 @@
 package rules
 
 import (
+	"slices"
+
 	"github.com/machadovilaca/operator-observability/pkg/operatorrules"
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules/alerts"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules/recordingrules"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules/slo"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/overrides"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -45,6 +50,32 @@ func BuildPrometheusRule(namespace string, owner metav1.OwnerReference) (*promv1
 	return rules, nil
 }
 
+// ApplyOverrides modifies the alerts of the PrometheusRule according to the alert rule overrides of the
+// HyperConverged CR. None of the HCO alerts has a configurable threshold.
+func ApplyOverrides(rule *promv1.PrometheusRule, alertOverrides []hcov1beta1.AlertRuleOverride) error {
+	return overrides.Apply(rule, alertOverrides, nil)
+}
+
+// AddServiceLevelObjectives adds the recording rules and the alerts of the service level objectives of the
+// HyperConverged CR to the PrometheusRule. The objectives that are not valid are skipped, and reported by the returned
+// error.
+func AddServiceLevelObjectives(rule *promv1.PrometheusRule, slos []hcov1beta1.ServiceLevelObjective) error {
+	groups, err := slo.BuildRuleGroups(slos)
+	rule.Spec.Groups = append(rule.Spec.Groups, groups...)
+	return err
+}
+
+// ValidateServiceLevelObjectives checks the service level objectives of the HyperConverged CR
+func ValidateServiceLevelObjectives(slos []hcov1beta1.ServiceLevelObjective) error {
+	return slo.Validate(slos)
+}
+
+// AlertNames returns the names of all the alerts that may be part of the PrometheusRule, including the alerts of the
+// service level objectives
+func AlertNames() []string {
+	return slices.Concat(alerts.AlertNames(), slo.AlertNames())
+}
+
 func ListRecordingRules() []operatorrules.RecordingRule {
 	return operatorRegistry.ListRecordingRules()
 }
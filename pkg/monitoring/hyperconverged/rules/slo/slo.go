@@ -0,0 +1,266 @@
+package slo
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules/alerts"
+)
+
+const (
+	errorRatioRecordPrefix     = "kubevirt_hco_slo:sli_error:ratio_rate"
+	windowErrorRatioRecord     = "kubevirt_hco_slo:sli_error:ratio_rate_window"
+	objectiveRecord            = "kubevirt_hco_slo:objective:ratio"
+	errorBudgetRemainingRecord = "kubevirt_hco_slo:error_budget_remaining:ratio"
+
+	sloLabelKey       = "slo"
+	indicatorLabelKey = "indicator"
+
+	severityLabelKey     = "severity"
+	healthImpactLabelKey = "operator_health_impact"
+
+	defaultWindow = 30 * 24 * time.Hour
+
+	// the error ratio over the SLO window is the average of this recorded ratio, rather than a range query over the
+	// raw metrics of the whole window
+	windowBaseRatioWindow = 5 * time.Minute
+)
+
+// burnRateAlert is a multi-window burn-rate alert: it fires if both the long window and the short window burn the
+// error budget faster than the burn rate, that consumes the budgetConsumed share of the error budget within the long
+// window.
+type burnRateAlert struct {
+	longWindow     time.Duration
+	shortWindow    time.Duration
+	budgetConsumed float64
+}
+
+var (
+	// fast burn: 2% of the error budget within 1 hour, or 5% within 6 hours
+	fastBurnAlerts = []burnRateAlert{
+		{longWindow: time.Hour, shortWindow: 5 * time.Minute, budgetConsumed: 0.02},
+		{longWindow: 6 * time.Hour, shortWindow: 30 * time.Minute, budgetConsumed: 0.05},
+	}
+
+	// slow burn: 10% of the error budget within 1 day, or 10% within 3 days
+	slowBurnAlerts = []burnRateAlert{
+		{longWindow: 24 * time.Hour, shortWindow: 2 * time.Hour, budgetConsumed: 0.1},
+		{longWindow: 72 * time.Hour, shortWindow: 6 * time.Hour, budgetConsumed: 0.1},
+	}
+)
+
+// errorRatioExprs are the expressions of the ratio of the bad events of each indicator; each one gets the range
+// of the ratio
+var errorRatioExprs = map[hcov1beta1.ServiceLevelIndicator]func(rng string) string{
+	hcov1beta1.VMAvailabilityIndicator: func(rng string) string {
+		return fmt.Sprintf(`sum(avg_over_time(kubevirt_vmi_phase_count{phase=~"(?i)failed|unknown"}[%[1]s])) / sum(avg_over_time(kubevirt_vmi_phase_count[%[1]s]))`, rng)
+	},
+	hcov1beta1.LiveMigrationSuccessIndicator: func(rng string) string {
+		return fmt.Sprintf(`(sum(increase(kubevirt_vmi_migration_phase_transition_time_from_creation_seconds_count{phase="Failed"}[%[1]s])) or vector(0)) / sum(increase(kubevirt_vmi_migration_phase_transition_time_from_creation_seconds_count{phase=~"Succeeded|Failed"}[%[1]s]))`, rng)
+	},
+	hcov1beta1.BootImageFreshnessIndicator: func(rng string) string {
+		return fmt.Sprintf(`1 - avg(avg_over_time(kubevirt_hco_dataimportcrontemplate_up_to_date[%s]))`, rng)
+	},
+}
+
+// AlertNames returns the names of the burn-rate alerts of all the service level indicators
+func AlertNames() []string {
+	names := make([]string, 0, len(errorRatioExprs))
+	for indicator := range errorRatioExprs {
+		names = append(names, alertName(indicator))
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+func alertName(indicator hcov1beta1.ServiceLevelIndicator) string {
+	return string(indicator) + "ErrorBudgetBurn"
+}
+
+// BuildRuleGroups builds a rule group with the recording rules and the burn-rate alerts of each service level
+// objective. An invalid objective is skipped; the returned error reports all the invalid objectives, while the groups
+// of the valid ones are still returned.
+func BuildRuleGroups(slos []hcov1beta1.ServiceLevelObjective) ([]promv1.RuleGroup, error) {
+	groups := make([]promv1.RuleGroup, 0, len(slos))
+	var errs []error
+
+	for _, slo := range slos {
+		group, err := buildRuleGroup(slo)
+		if err != nil {
+			errs = append(errs, invalidObjectiveError(slo, err))
+			continue
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, errors.Join(errs...)
+}
+
+// Validate checks the service level objectives of the HyperConverged CR, without building their rules
+func Validate(slos []hcov1beta1.ServiceLevelObjective) error {
+	var errs []error
+	for _, slo := range slos {
+		if _, _, err := validateObjective(slo); err != nil {
+			errs = append(errs, invalidObjectiveError(slo, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func invalidObjectiveError(slo hcov1beta1.ServiceLevelObjective, err error) error {
+	return fmt.Errorf("invalid service level objective %s: %w", slo.Name, err)
+}
+
+// validateObjective returns the objective, as a percentage, and the window of a valid service level objective
+func validateObjective(slo hcov1beta1.ServiceLevelObjective) (float64, time.Duration, error) {
+	if _, ok := errorRatioExprs[slo.Indicator]; !ok {
+		return 0, 0, fmt.Errorf("unknown indicator %q", slo.Indicator)
+	}
+
+	objective, err := strconv.ParseFloat(slo.Objective, 64)
+	if err != nil || objective <= 0 || objective >= 100 {
+		return 0, 0, fmt.Errorf("objective must be greater than 0 and less than 100; got %q", slo.Objective)
+	}
+
+	window := defaultWindow
+	if slo.Window != nil {
+		window = slo.Window.Duration
+	}
+
+	if window < 24*time.Hour {
+		return 0, 0, fmt.Errorf("window must be at least 24h; got %s", window)
+	}
+
+	return objective, window, nil
+}
+
+func buildRuleGroup(slo hcov1beta1.ServiceLevelObjective) (promv1.RuleGroup, error) {
+	objective, window, err := validateObjective(slo)
+	if err != nil {
+		return promv1.RuleGroup{}, err
+	}
+	errorRatioExpr := errorRatioExprs[slo.Indicator]
+
+	labels := map[string]string{
+		sloLabelKey:       slo.Name,
+		indicatorLabelKey: string(slo.Indicator),
+	}
+	selector := fmt.Sprintf(`{%s="%s"}`, sloLabelKey, slo.Name)
+	errorBudget := 1 - objective/100
+
+	var rules []promv1.Rule
+	for _, w := range getRecordedWindows(window) {
+		rules = append(rules, promv1.Rule{
+			Record: errorRatioRecordPrefix + formatDuration(w),
+			Expr:   intstr.FromString(errorRatioExpr(formatDuration(w))),
+			Labels: maps.Clone(labels),
+		})
+	}
+
+	rules = append(rules,
+		promv1.Rule{
+			Record: windowErrorRatioRecord,
+			Expr: intstr.FromString(fmt.Sprintf("avg_over_time(%s%s%s[%s])",
+				errorRatioRecordPrefix, formatDuration(windowBaseRatioWindow), selector, formatDuration(window))),
+			Labels: maps.Clone(labels),
+		},
+		promv1.Rule{
+			Record: objectiveRecord,
+			Expr:   intstr.FromString(fmt.Sprintf("vector(%s)", formatFloat(objective/100))),
+			Labels: maps.Clone(labels),
+		},
+		promv1.Rule{
+			Record: errorBudgetRemainingRecord,
+			Expr:   intstr.FromString(fmt.Sprintf("1 - %s%s / %s", windowErrorRatioRecord, selector, formatFloat(errorBudget))),
+			Labels: maps.Clone(labels),
+		},
+	)
+
+	name := alertName(slo.Indicator)
+	if alert, ok := buildBurnRateAlert(name, "critical", fastBurnAlerts, window, errorBudget, selector, labels); ok {
+		rules = append(rules, alert)
+	}
+	if alert, ok := buildBurnRateAlert(name, "warning", slowBurnAlerts, window, errorBudget, selector, labels); ok {
+		rules = append(rules, alert)
+	}
+
+	return promv1.RuleGroup{
+		Name:  "kubevirt-hco-slo-" + slo.Name + ".rules",
+		Rules: rules,
+	}, nil
+}
+
+// buildBurnRateAlert builds an alert that fires if any of the burn-rate windows burns the error budget too fast. The
+// burn-rate windows that are longer than the SLO window are skipped.
+func buildBurnRateAlert(name, severity string, burnRateAlerts []burnRateAlert, window time.Duration, errorBudget float64, selector string, labels map[string]string) (promv1.Rule, bool) {
+	var conditions []string
+	for _, bra := range burnRateAlerts {
+		if bra.longWindow > window {
+			continue
+		}
+
+		burnRate := bra.budgetConsumed * window.Hours() / bra.longWindow.Hours()
+		threshold := formatFloat(burnRate * errorBudget)
+		conditions = append(conditions, fmt.Sprintf("(%s%s%s > %s and %s%s%s > %s)",
+			errorRatioRecordPrefix, formatDuration(bra.longWindow), selector, threshold,
+			errorRatioRecordPrefix, formatDuration(bra.shortWindow), selector, threshold,
+		))
+	}
+
+	if len(conditions) == 0 {
+		return promv1.Rule{}, false
+	}
+
+	alertLabels := maps.Clone(labels)
+	alertLabels[severityLabelKey] = severity
+	alertLabels[healthImpactLabelKey] = "none"
+
+	alert := promv1.Rule{
+		Alert: name,
+		Expr:  intstr.FromString(strings.Join(conditions, " or ")),
+		Annotations: map[string]string{
+			"summary":     "The {{ $labels.slo }} service level objective burns its error budget too fast.",
+			"description": "The {{ $labels.indicator }} service level objective {{ $labels.slo }} burns its error budget at a rate that would exhaust it before the end of its window.",
+		},
+		Labels: alertLabels,
+	}
+	// there are no runbooks for the generated SLO alerts
+	alerts.SetCommonLabels(&alert)
+
+	return alert, true
+}
+
+// getRecordedWindows returns the windows of the burn-rate alerts that are not longer than the SLO window
+func getRecordedWindows(window time.Duration) []time.Duration {
+	var windows []time.Duration
+	for _, bra := range slices.Concat(fastBurnAlerts, slowBurnAlerts) {
+		for _, w := range []time.Duration{bra.shortWindow, bra.longWindow} {
+			if bra.longWindow <= window && !slices.Contains(windows, w) {
+				windows = append(windows, w)
+			}
+		}
+	}
+
+	slices.Sort(windows)
+	return windows
+}
+
+func formatDuration(d time.Duration) string {
+	return model.Duration(d).String()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', 6, 64)
+}
@@ -0,0 +1,13 @@
+package slo_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSLO(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Service Level Objectives Suite")
+}
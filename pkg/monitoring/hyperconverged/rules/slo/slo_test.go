@@ -0,0 +1,138 @@
+package slo_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/machadovilaca/operator-observability/pkg/testutil"
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules/slo"
+)
+
+var _ = Describe("Service Level Objectives", func() {
+	getRules := func(group promv1.RuleGroup) (map[string]promv1.Rule, map[string]promv1.Rule) {
+		records := make(map[string]promv1.Rule)
+		alerts := make(map[string]promv1.Rule)
+		for _, r := range group.Rules {
+			if r.Alert != "" {
+				alerts[r.Labels["severity"]] = r
+			} else {
+				records[r.Record] = r
+			}
+		}
+		return records, alerts
+	}
+
+	It("should build no groups if there are no objectives", func() {
+		groups, err := slo.BuildRuleGroups(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(groups).To(BeEmpty())
+	})
+
+	It("should build the recording rules and the burn-rate alerts of each objective", func() {
+		groups, err := slo.BuildRuleGroups([]hcov1beta1.ServiceLevelObjective{
+			{Name: "vm-availability", Indicator: hcov1beta1.VMAvailabilityIndicator, Objective: "99.9"},
+			{Name: "migrations", Indicator: hcov1beta1.LiveMigrationSuccessIndicator, Objective: "99", Window: &metav1.Duration{Duration: 7 * 24 * time.Hour}},
+			{Name: "boot-images", Indicator: hcov1beta1.BootImageFreshnessIndicator, Objective: "95"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(groups).To(HaveLen(3))
+		Expect(groups[0].Name).To(Equal("kubevirt-hco-slo-vm-availability.rules"))
+		Expect(groups[1].Name).To(Equal("kubevirt-hco-slo-migrations.rules"))
+		Expect(groups[2].Name).To(Equal("kubevirt-hco-slo-boot-images.rules"))
+
+		records, alerts := getRules(groups[0])
+		Expect(records).To(HaveLen(10))
+		for _, w := range []string{"5m", "30m", "1h", "2h", "6h", "1d", "3d", "_window"} {
+			Expect(records).To(HaveKey("kubevirt_hco_slo:sli_error:ratio_rate" + w))
+		}
+		Expect(records["kubevirt_hco_slo:sli_error:ratio_rate_window"].Expr.StrVal).To(Equal(`avg_over_time(kubevirt_hco_slo:sli_error:ratio_rate5m{slo="vm-availability"}[30d])`))
+		Expect(records["kubevirt_hco_slo:objective:ratio"].Expr.StrVal).To(Equal("vector(0.999)"))
+		Expect(records["kubevirt_hco_slo:error_budget_remaining:ratio"].Expr.StrVal).To(Equal(`1 - kubevirt_hco_slo:sli_error:ratio_rate_window{slo="vm-availability"} / 0.001`))
+		for _, r := range records {
+			Expect(r.Labels).To(Equal(map[string]string{"slo": "vm-availability", "indicator": "VMAvailability"}))
+		}
+
+		Expect(alerts).To(HaveLen(2))
+		Expect(alerts["critical"].Alert).To(Equal("VMAvailabilityErrorBudgetBurn"))
+		Expect(alerts["critical"].Expr.StrVal).To(Equal(
+			`(kubevirt_hco_slo:sli_error:ratio_rate1h{slo="vm-availability"} > 0.0144 and kubevirt_hco_slo:sli_error:ratio_rate5m{slo="vm-availability"} > 0.0144)` +
+				` or (kubevirt_hco_slo:sli_error:ratio_rate6h{slo="vm-availability"} > 0.006 and kubevirt_hco_slo:sli_error:ratio_rate30m{slo="vm-availability"} > 0.006)`,
+		))
+		Expect(alerts["warning"].Expr.StrVal).To(Equal(
+			`(kubevirt_hco_slo:sli_error:ratio_rate1d{slo="vm-availability"} > 0.003 and kubevirt_hco_slo:sli_error:ratio_rate2h{slo="vm-availability"} > 0.003)` +
+				` or (kubevirt_hco_slo:sli_error:ratio_rate3d{slo="vm-availability"} > 0.001 and kubevirt_hco_slo:sli_error:ratio_rate6h{slo="vm-availability"} > 0.001)`,
+		))
+		Expect(alerts["warning"].Labels).To(HaveKeyWithValue("operator_health_impact", "none"))
+		Expect(alerts["warning"].Annotations).ToNot(HaveKey("runbook_url"))
+		Expect(alerts["warning"].Labels).To(HaveKeyWithValue("kubernetes_operator_part_of", "kubevirt"))
+
+		_, alerts = getRules(groups[1])
+		Expect(alerts).To(HaveKey("critical"))
+		Expect(alerts["critical"].Alert).To(Equal("LiveMigrationSuccessErrorBudgetBurn"))
+	})
+
+	It("should skip the burn-rate windows that are longer than the objective window", func() {
+		groups, err := slo.BuildRuleGroups([]hcov1beta1.ServiceLevelObjective{
+			{Name: "vm-availability", Indicator: hcov1beta1.VMAvailabilityIndicator, Objective: "99", Window: &metav1.Duration{Duration: 24 * time.Hour}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(groups).To(HaveLen(1))
+
+		records, alerts := getRules(groups[0])
+		Expect(records).ToNot(HaveKey("kubevirt_hco_slo:sli_error:ratio_rate3d"))
+		Expect(records).To(HaveKey("kubevirt_hco_slo:sli_error:ratio_rate1d"))
+		Expect(alerts["warning"].Expr.StrVal).ToNot(ContainSubstring("ratio_rate3d"))
+	})
+
+	DescribeTable("should skip invalid objectives", func(objective hcov1beta1.ServiceLevelObjective, errMsg string) {
+		groups, err := slo.BuildRuleGroups([]hcov1beta1.ServiceLevelObjective{
+			objective,
+			{Name: "valid", Indicator: hcov1beta1.VMAvailabilityIndicator, Objective: "99"},
+		})
+		Expect(err).To(MatchError(ContainSubstring(errMsg)))
+		Expect(groups).To(HaveLen(1))
+		Expect(groups[0].Name).To(Equal("kubevirt-hco-slo-valid.rules"))
+
+		Expect(slo.Validate([]hcov1beta1.ServiceLevelObjective{objective})).To(MatchError(ContainSubstring(errMsg)))
+	},
+		Entry("unknown indicator", hcov1beta1.ServiceLevelObjective{Name: "slo", Indicator: "Unknown", Objective: "99"}, `unknown indicator "Unknown"`),
+		Entry("malformed objective", hcov1beta1.ServiceLevelObjective{Name: "slo", Indicator: hcov1beta1.VMAvailabilityIndicator, Objective: "high"}, "objective must be greater than 0 and less than 100"),
+		Entry("zero objective", hcov1beta1.ServiceLevelObjective{Name: "slo", Indicator: hcov1beta1.VMAvailabilityIndicator, Objective: "0"}, "objective must be greater than 0 and less than 100"),
+		Entry("short window", hcov1beta1.ServiceLevelObjective{Name: "slo", Indicator: hcov1beta1.VMAvailabilityIndicator, Objective: "99", Window: &metav1.Duration{Duration: time.Hour}}, "window must be at least 24h"),
+	)
+
+	It("should accept valid objectives", func() {
+		Expect(slo.Validate([]hcov1beta1.ServiceLevelObjective{
+			{Name: "vm-availability", Indicator: hcov1beta1.VMAvailabilityIndicator, Objective: "99.9"},
+			{Name: "boot-images", Indicator: hcov1beta1.BootImageFreshnessIndicator, Objective: "95", Window: &metav1.Duration{Duration: 24 * time.Hour}},
+		})).To(Succeed())
+	})
+
+	It("should build valid alerts", func() {
+		groups, err := slo.BuildRuleGroups([]hcov1beta1.ServiceLevelObjective{
+			{Name: "vm-availability", Indicator: hcov1beta1.VMAvailabilityIndicator, Objective: "99.9"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		var alerts []promv1.Rule
+		for _, r := range groups[0].Rules {
+			if r.Alert != "" {
+				alerts = append(alerts, r)
+			}
+		}
+
+		linter := testutil.New()
+		linter.AddCustomAlertValidations(
+			testutil.ValidateAlertNameLength,
+			testutil.ValidateAlertHealthImpactLabel,
+			testutil.ValidateAlertPartOfAndComponentLabels)
+
+		Expect(linter.LintAlerts(alerts)).To(BeEmpty())
+	})
+})
@@ -37,6 +37,16 @@ func Register(operatorRegistry *operatorrules.Registry) error {
 	return operatorRegistry.RegisterAlerts(alerts...)
 }
 
+// AlertNames returns the names of the observability alerts
+func AlertNames() []string {
+	var names []string
+	for _, alert := range clusterAlerts() {
+		names = append(names, alert.Alert)
+	}
+
+	return names
+}
+
 func getRunbookURLTemplate() (string, error) {
 	runbookURLTemplate, exists := os.LookupEnv(runbookURLTemplateEnv)
 	if !exists {
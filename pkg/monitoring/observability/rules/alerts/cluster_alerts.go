@@ -2,11 +2,28 @@ package alerts
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/overrides"
+)
+
+const (
+	highCPUWorkloadAlert           = "HighCPUWorkload"
+	persistentVolumeFillingUpAlert = "PersistentVolumeFillingUp"
+	highNodeCPUFrequencyAlert      = "HighNodeCPUFrequency"
+
+	// the CPU utilization ratio
+	defaultHighCPUWorkloadThreshold = 0.9
+	// the ratio of the available space of the volume
+	defaultPersistentVolumeFillingUpThreshold = 0.1
+	// the ratio of the maximum CPU frequency
+	defaultHighNodeCPUFrequencyThreshold = 0.8
 )
 
 // Network interface flag bitmasks for Linux interface flags
@@ -29,12 +46,12 @@ var ignoredInterfacesForNetworkDown = []string{
 func clusterAlerts() []promv1.Rule {
 	return []promv1.Rule{
 		{
-			Alert: "HighCPUWorkload",
-			Expr:  intstr.FromString("instance:node_cpu_utilisation:rate1m >= 0.9"),
+			Alert: highCPUWorkloadAlert,
+			Expr:  highCPUWorkloadExpr(defaultHighCPUWorkloadThreshold),
 			For:   ptr.To[promv1.Duration]("5m"),
 			Annotations: map[string]string{
 				"summary":     "High CPU usage on host {{ $labels.instance }}",
-				"description": "CPU utilization for {{ $labels.instance }} has been above 90% for more than 5 minutes.",
+				"description": highCPUWorkloadDescription(defaultHighCPUWorkloadThreshold),
 			},
 			Labels: map[string]string{
 				"severity":               "warning",
@@ -78,18 +95,9 @@ func clusterAlerts() []promv1.Rule {
 			},
 		},
 		{
-			Alert: "PersistentVolumeFillingUp",
-			Expr: intstr.FromString(`
-				(
-					kubelet_volume_stats_available_bytes{job="kubelet",metrics_path="/metrics"}
-					/
-					kubelet_volume_stats_capacity_bytes{job="kubelet",metrics_path="/metrics"}
-				) < 0.10
-				and kubelet_volume_stats_used_bytes{job="kubelet",metrics_path="/metrics"} > 0
-				and predict_linear(kubelet_volume_stats_available_bytes{job="kubelet",metrics_path="/metrics"}[6h], 4 * 24 * 3600) < 0
-				unless on (cluster, namespace, persistentvolumeclaim) kube_persistentvolumeclaim_access_mode{access_mode="ReadOnlyMany"} == 1
-			`),
-			For: ptr.To[promv1.Duration]("5m"),
+			Alert: persistentVolumeFillingUpAlert,
+			Expr:  persistentVolumeFillingUpExpr(defaultPersistentVolumeFillingUpThreshold),
+			For:   ptr.To[promv1.Duration]("5m"),
 			Annotations: map[string]string{
 				"summary":     "PersistentVolume is filling up",
 				"description": "Based on recent sampling, the PersistentVolume claimed by {{ $labels.persistentvolumeclaim }} in Namespace {{ $labels.namespace }} is expected to fill up within four days. Currently {{ $value | humanizePercentage }} is available.",
@@ -100,16 +108,12 @@ func clusterAlerts() []promv1.Rule {
 			},
 		},
 		{
-			Alert: "HighNodeCPUFrequency",
-			Expr: intstr.FromString(`
-				node_cpu_frequency_hertz > 0
-				and on(instance, cpu)
-				node_cpu_frequency_hertz - on(instance, cpu) group_left() node_cpu_frequency_max_hertz * 0.8 > 0
-			`),
-			For: ptr.To[promv1.Duration]("5m"),
+			Alert: highNodeCPUFrequencyAlert,
+			Expr:  highNodeCPUFrequencyExpr(defaultHighNodeCPUFrequencyThreshold),
+			For:   ptr.To[promv1.Duration]("5m"),
 			Annotations: map[string]string{
 				"summary":     "High CPU frequency detected on node {{ $labels.instance }}",
-				"description": "CPU frequency on node {{ $labels.instance }} (CPU {{ $labels.cpu }}) is {{ $value | humanize }}Hz, which is above 80% of the maximum frequency. This may indicate high CPU utilization or thermal throttling.",
+				"description": highNodeCPUFrequencyDescription(defaultHighNodeCPUFrequencyThreshold),
 			},
 			Labels: map[string]string{
 				"severity":               "warning",
@@ -118,3 +122,62 @@ func clusterAlerts() []promv1.Rule {
 		},
 	}
 }
+
+// ThresholdFuncs returns the cluster alerts with a configurable threshold
+func ThresholdFuncs() map[string]overrides.ThresholdFunc {
+	return map[string]overrides.ThresholdFunc{
+		highCPUWorkloadAlert: func(alert *promv1.Rule, threshold float64) {
+			alert.Expr = highCPUWorkloadExpr(threshold)
+			alert.Annotations["description"] = highCPUWorkloadDescription(threshold)
+		},
+		persistentVolumeFillingUpAlert: func(alert *promv1.Rule, threshold float64) {
+			alert.Expr = persistentVolumeFillingUpExpr(threshold)
+		},
+		highNodeCPUFrequencyAlert: func(alert *promv1.Rule, threshold float64) {
+			alert.Expr = highNodeCPUFrequencyExpr(threshold)
+			alert.Annotations["description"] = highNodeCPUFrequencyDescription(threshold)
+		},
+	}
+}
+
+func highCPUWorkloadExpr(threshold float64) intstr.IntOrString {
+	return intstr.FromString(fmt.Sprintf("instance:node_cpu_utilisation:rate1m >= %s", formatThreshold(threshold)))
+}
+
+func highCPUWorkloadDescription(threshold float64) string {
+	return fmt.Sprintf("CPU utilization for {{ $labels.instance }} has been above %s%% for more than 5 minutes.", formatPercentage(threshold))
+}
+
+func persistentVolumeFillingUpExpr(threshold float64) intstr.IntOrString {
+	return intstr.FromString(fmt.Sprintf(`
+				(
+					kubelet_volume_stats_available_bytes{job="kubelet",metrics_path="/metrics"}
+					/
+					kubelet_volume_stats_capacity_bytes{job="kubelet",metrics_path="/metrics"}
+				) < %s
+				and kubelet_volume_stats_used_bytes{job="kubelet",metrics_path="/metrics"} > 0
+				and predict_linear(kubelet_volume_stats_available_bytes{job="kubelet",metrics_path="/metrics"}[6h], 4 * 24 * 3600) < 0
+				unless on (cluster, namespace, persistentvolumeclaim) kube_persistentvolumeclaim_access_mode{access_mode="ReadOnlyMany"} == 1
+			`, formatThreshold(threshold)))
+}
+
+func highNodeCPUFrequencyExpr(threshold float64) intstr.IntOrString {
+	return intstr.FromString(fmt.Sprintf(`
+				node_cpu_frequency_hertz > 0
+				and on(instance, cpu)
+				node_cpu_frequency_hertz - on(instance, cpu) group_left() node_cpu_frequency_max_hertz * %s > 0
+			`, formatThreshold(threshold)))
+}
+
+func highNodeCPUFrequencyDescription(threshold float64) string {
+	return fmt.Sprintf("CPU frequency on node {{ $labels.instance }} (CPU {{ $labels.cpu }}) is {{ $value | humanize }}Hz, which is above %s%% of the maximum frequency. This may indicate high CPU utilization or thermal throttling.", formatPercentage(threshold))
+}
+
+func formatThreshold(threshold float64) string {
+	return strconv.FormatFloat(threshold, 'f', -1, 64)
+}
+
+// formatPercentage formats a ratio as a percentage, with up to two decimal places
+func formatPercentage(ratio float64) string {
+	return strconv.FormatFloat(math.Round(ratio*10000)/100, 'f', -1, 64)
+}
@@ -2,16 +2,21 @@ package rules
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/machadovilaca/operator-observability/pkg/operatorrules"
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules/alerts"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/overrides"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
-const prometheusRuleName = "kubevirt-cnv-prometheus-rules"
+// PrometheusRuleName is the name of the PrometheusRule of the observability alerts
+const PrometheusRuleName = "kubevirt-cnv-prometheus-rules"
 
 var operatorRegistry = operatorrules.NewRegistry()
 
@@ -21,7 +26,7 @@ func SetupRules() error {
 
 func BuildPrometheusRule(namespace string, owner *metav1.OwnerReference) (*promv1.PrometheusRule, error) {
 	rules, err := operatorRegistry.BuildPrometheusRule(
-		prometheusRuleName,
+		PrometheusRuleName,
 		namespace,
 		hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring),
 	)
@@ -34,6 +39,22 @@ func BuildPrometheusRule(namespace string, owner *metav1.OwnerReference) (*promv
 	return rules, nil
 }
 
+// ApplyOverrides modifies the alerts of the PrometheusRule according to the alert rule overrides of the
+// HyperConverged CR
+func ApplyOverrides(rule *promv1.PrometheusRule, alertOverrides []hcov1beta1.AlertRuleOverride) error {
+	return overrides.Apply(rule, alertOverrides, alerts.ThresholdFuncs())
+}
+
+// AlertNames returns the names of the observability alerts
+func AlertNames() []string {
+	return alerts.AlertNames()
+}
+
+// ThresholdAlertNames returns the names of the observability alerts with a configurable threshold
+func ThresholdAlertNames() []string {
+	return slices.Sorted(maps.Keys(alerts.ThresholdFuncs()))
+}
+
 func ListAlerts() []promv1.Rule {
 	return operatorRegistry.ListAlerts()
 }
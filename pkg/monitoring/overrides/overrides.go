@@ -0,0 +1,197 @@
+package overrides
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+const (
+	severityLabelKey     = "severity"
+	healthImpactLabelKey = "operator_health_impact"
+	partOfLabelKey       = "kubernetes_operator_part_of"
+	componentLabelKey    = "kubernetes_operator_component"
+)
+
+var (
+	// the labels that HCO uses to evaluate its own health, and to identify its alerts
+	reservedLabels = []string{severityLabelKey, healthImpactLabelKey, partOfLabelKey, componentLabelKey}
+
+	validSeverities = []string{"critical", "warning", "info"}
+)
+
+// ThresholdFunc sets the threshold of an alert, by modifying its expression and its annotations
+type ThresholdFunc func(alert *promv1.Rule, threshold float64)
+
+// Apply modifies the alerts of the PrometheusRule according to the overrides. Overrides of alerts that are not part
+// of the PrometheusRule are ignored; they may belong to another PrometheusRule. The thresholdFuncs are the alerts
+// with a configurable threshold.
+//
+// An invalid override is skipped, and its alert is kept unmodified; the other overrides are still applied. The
+// returned error lists the skipped overrides.
+func Apply(rule *promv1.PrometheusRule, alertOverrides []hcov1beta1.AlertRuleOverride, thresholdFuncs map[string]ThresholdFunc) error {
+	if len(alertOverrides) == 0 {
+		return nil
+	}
+
+	groups := make([]promv1.RuleGroup, 0, len(rule.Spec.Groups))
+	var errs []error
+
+	for _, group := range rule.Spec.Groups {
+		newGroup := *group.DeepCopy()
+		newGroup.Rules = make([]promv1.Rule, 0, len(group.Rules))
+
+		for _, r := range group.Rules {
+			idx := slices.IndexFunc(alertOverrides, func(override hcov1beta1.AlertRuleOverride) bool {
+				return r.Alert != "" && override.Alert == r.Alert
+			})
+
+			if idx < 0 {
+				newGroup.Rules = append(newGroup.Rules, *r.DeepCopy())
+				continue
+			}
+
+			override := alertOverrides[idx]
+			if override.Disabled {
+				continue
+			}
+
+			alert := r.DeepCopy()
+			if err := applyOverride(alert, override, thresholdFuncs[override.Alert]); err != nil {
+				errs = append(errs, fmt.Errorf("invalid override of the %s alert: %w", override.Alert, err))
+				alert = r.DeepCopy()
+			}
+
+			newGroup.Rules = append(newGroup.Rules, *alert)
+		}
+
+		if len(newGroup.Rules) > 0 {
+			groups = append(groups, newGroup)
+		}
+	}
+
+	rule.Spec.Groups = groups
+	return errors.Join(errs...)
+}
+
+// Validate validates the overrides, without applying them. knownAlerts are the names of all the alerts that can be
+// overridden, and thresholdAlerts are the names of the alerts with a configurable threshold.
+func Validate(alertOverrides []hcov1beta1.AlertRuleOverride, knownAlerts, thresholdAlerts []string) error {
+	var errs []error
+	for _, override := range alertOverrides {
+		if !slices.Contains(knownAlerts, override.Alert) {
+			errs = append(errs, fmt.Errorf("unknown alert %q", override.Alert))
+			continue
+		}
+
+		if err := validateOverride(override, slices.Contains(thresholdAlerts, override.Alert)); err != nil {
+			errs = append(errs, fmt.Errorf("invalid override of the %s alert: %w", override.Alert, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func applyOverride(alert *promv1.Rule, override hcov1beta1.AlertRuleOverride, thresholdFunc ThresholdFunc) error {
+	if err := validateOverride(override, thresholdFunc != nil); err != nil {
+		return err
+	}
+
+	if override.Threshold != nil {
+		threshold, _ := parseThreshold(*override.Threshold)
+		thresholdFunc(alert, threshold)
+	}
+
+	if override.For != nil {
+		alert.For = ptr.To(promv1.Duration(model.Duration(override.For.Duration).String()))
+	}
+
+	if alert.Labels == nil && (override.Severity != nil || len(override.Labels) > 0) {
+		alert.Labels = make(map[string]string)
+	}
+
+	if override.Severity != nil {
+		alert.Labels[severityLabelKey] = *override.Severity
+	}
+
+	maps.Copy(alert.Labels, override.Labels)
+
+	return validateAlert(*alert)
+}
+
+// validateOverride validates the fields of the override, that don't depend on the alert
+func validateOverride(override hcov1beta1.AlertRuleOverride, hasThreshold bool) error {
+	if override.Threshold != nil {
+		if !hasThreshold {
+			return errors.New("the alert has no configurable threshold")
+		}
+
+		if _, err := parseThreshold(*override.Threshold); err != nil {
+			return err
+		}
+	}
+
+	if override.For != nil && override.For.Duration < 0 {
+		return fmt.Errorf("negative duration %s", override.For.Duration)
+	}
+
+	if override.Severity != nil && !slices.Contains(validSeverities, *override.Severity) {
+		return fmt.Errorf("invalid severity %q", *override.Severity)
+	}
+
+	for name := range override.Labels {
+		if slices.Contains(reservedLabels, name) {
+			return fmt.Errorf("the %s label can't be modified", name)
+		}
+
+		if !model.LabelName(name).IsValidLegacy() {
+			return fmt.Errorf("invalid label name %q", name)
+		}
+	}
+
+	return nil
+}
+
+// parseThreshold parses a threshold; all the configurable thresholds are ratios between 0 and 1
+func parseThreshold(value string) (float64, error) {
+	threshold, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(threshold) || threshold < 0 || threshold > 1 {
+		return 0, fmt.Errorf("invalid threshold %q; the threshold must be a ratio between 0 and 1", value)
+	}
+
+	return threshold, nil
+}
+
+// validateAlert validates the alert, after the override was applied
+func validateAlert(alert promv1.Rule) error {
+	if alert.Expr.String() == "" {
+		return errors.New("empty expression")
+	}
+
+	if alert.For != nil {
+		if _, err := model.ParseDuration(string(*alert.For)); err != nil {
+			return fmt.Errorf("invalid duration %q: %w", *alert.For, err)
+		}
+	}
+
+	if !slices.Contains(validSeverities, alert.Labels[severityLabelKey]) {
+		return fmt.Errorf("invalid severity %q", alert.Labels[severityLabelKey])
+	}
+
+	for name := range alert.Labels {
+		if !model.LabelName(name).IsValidLegacy() {
+			return fmt.Errorf("invalid label name %q", name)
+		}
+	}
+
+	return nil
+}
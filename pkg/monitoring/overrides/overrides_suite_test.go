@@ -0,0 +1,13 @@
+package overrides_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOverrides(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Alert Rule Overrides Suite")
+}
@@ -0,0 +1,198 @@
+package overrides_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/overrides"
+)
+
+var _ = Describe("Alert Rule Overrides", func() {
+	var (
+		rule           *promv1.PrometheusRule
+		thresholdFuncs map[string]overrides.ThresholdFunc
+	)
+
+	newAlert := func(name, expr string) promv1.Rule {
+		return promv1.Rule{
+			Alert: name,
+			Expr:  intstr.FromString(expr),
+			For:   ptr.To[promv1.Duration]("5m"),
+			Labels: map[string]string{
+				"severity":               "warning",
+				"operator_health_impact": "none",
+			},
+			Annotations: map[string]string{
+				"summary": name,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		rule = &promv1.PrometheusRule{
+			Spec: promv1.PrometheusRuleSpec{
+				Groups: []promv1.RuleGroup{
+					{
+						Name: "alerts.rules",
+						Rules: []promv1.Rule{
+							newAlert("HighLoad", "load > 0.9"),
+							newAlert("Down", "up == 0"),
+						},
+					},
+					{
+						Name: "recordingRules.rules",
+						Rules: []promv1.Rule{
+							{Record: "load", Expr: intstr.FromString("sum(cpu)")},
+						},
+					},
+				},
+			},
+		}
+
+		thresholdFuncs = map[string]overrides.ThresholdFunc{
+			"HighLoad": func(alert *promv1.Rule, threshold float64) {
+				alert.Expr = intstr.FromString(fmt.Sprintf("load > %v", threshold))
+			},
+		}
+	})
+
+	It("should not modify the rule if there are no overrides", func() {
+		orig := rule.DeepCopy()
+		Expect(overrides.Apply(rule, nil, thresholdFuncs)).To(Succeed())
+		Expect(rule).To(Equal(orig))
+	})
+
+	It("should modify the threshold, the duration, the severity and the labels of an alert", func() {
+		Expect(overrides.Apply(rule, []hcov1beta1.AlertRuleOverride{
+			{
+				Alert:     "HighLoad",
+				Threshold: ptr.To("0.75"),
+				For:       &metav1.Duration{Duration: 15 * time.Minute},
+				Severity:  ptr.To("critical"),
+				Labels:    map[string]string{"team": "virt"},
+			},
+		}, thresholdFuncs)).To(Succeed())
+
+		alert := rule.Spec.Groups[0].Rules[0]
+		Expect(alert.Expr.String()).To(Equal("load > 0.75"))
+		Expect(alert.For).To(HaveValue(Equal(promv1.Duration("15m"))))
+		Expect(alert.Labels).To(HaveKeyWithValue("severity", "critical"))
+		Expect(alert.Labels).To(HaveKeyWithValue("team", "virt"))
+		Expect(alert.Labels).To(HaveKeyWithValue("operator_health_impact", "none"))
+
+		Expect(rule.Spec.Groups[0].Rules[1]).To(Equal(newAlert("Down", "up == 0")))
+	})
+
+	It("should remove a disabled alert, and the group if it becomes empty", func() {
+		Expect(overrides.Apply(rule, []hcov1beta1.AlertRuleOverride{
+			{Alert: "HighLoad", Disabled: true},
+			{Alert: "Down", Disabled: true},
+		}, thresholdFuncs)).To(Succeed())
+
+		Expect(rule.Spec.Groups).To(HaveLen(1))
+		Expect(rule.Spec.Groups[0].Name).To(Equal("recordingRules.rules"))
+	})
+
+	It("should ignore the overrides of alerts that are not in the rule", func() {
+		orig := rule.DeepCopy()
+		Expect(overrides.Apply(rule, []hcov1beta1.AlertRuleOverride{
+			{Alert: "AnotherAlert", Threshold: ptr.To("1")},
+		}, thresholdFuncs)).To(Succeed())
+		Expect(rule).To(Equal(orig))
+	})
+
+	DescribeTable("should skip an invalid override, and apply the other overrides", func(override hcov1beta1.AlertRuleOverride, errMsg string) {
+		err := overrides.Apply(rule, []hcov1beta1.AlertRuleOverride{
+			{Alert: "HighLoad", Severity: ptr.To("info")},
+			override,
+		}, thresholdFuncs)
+
+		Expect(err).To(MatchError(ContainSubstring(errMsg)))
+		Expect(rule.Spec.Groups[0].Rules[0].Labels).To(HaveKeyWithValue("severity", "info"))
+		Expect(rule.Spec.Groups[0].Rules[1]).To(Equal(newAlert("Down", "up == 0")))
+	},
+		Entry("threshold of an alert with no configurable threshold",
+			hcov1beta1.AlertRuleOverride{Alert: "Down", Threshold: ptr.To("1")},
+			"the alert has no configurable threshold",
+		),
+		Entry("negative duration",
+			hcov1beta1.AlertRuleOverride{Alert: "Down", For: &metav1.Duration{Duration: -time.Minute}},
+			"negative duration",
+		),
+		Entry("unknown severity",
+			hcov1beta1.AlertRuleOverride{Alert: "Down", Severity: ptr.To("fatal")},
+			`invalid severity "fatal"`,
+		),
+		Entry("reserved label",
+			hcov1beta1.AlertRuleOverride{Alert: "Down", Labels: map[string]string{"operator_health_impact": "critical"}},
+			"the operator_health_impact label can't be modified",
+		),
+		Entry("invalid label name",
+			hcov1beta1.AlertRuleOverride{Alert: "Down", Labels: map[string]string{"my-label": "value"}},
+			`invalid label name "my-label"`,
+		),
+	)
+
+	DescribeTable("should reject a threshold that is not a ratio between 0 and 1", func(threshold string) {
+		err := overrides.Apply(rule, []hcov1beta1.AlertRuleOverride{
+			{Alert: "HighLoad", Threshold: ptr.To(threshold)},
+		}, thresholdFuncs)
+
+		Expect(err).To(MatchError(ContainSubstring("the threshold must be a ratio between 0 and 1")))
+		Expect(rule.Spec.Groups[0].Rules[0]).To(Equal(newAlert("HighLoad", "load > 0.9")))
+	},
+		Entry("percentage", "90"),
+		Entry("negative", "-0.5"),
+		Entry("not a number", "high"),
+		Entry("NaN", "NaN"),
+	)
+
+	It("should keep disabling the alerts, if another override is invalid", func() {
+		err := overrides.Apply(rule, []hcov1beta1.AlertRuleOverride{
+			{Alert: "HighLoad", Disabled: true},
+			{Alert: "Down", Severity: ptr.To("fatal")},
+		}, thresholdFuncs)
+
+		Expect(err).To(MatchError(ContainSubstring(`invalid severity "fatal"`)))
+		Expect(rule.Spec.Groups[0].Rules).To(Equal([]promv1.Rule{newAlert("Down", "up == 0")}))
+	})
+
+	Context("Validate", func() {
+		knownAlerts := []string{"HighLoad", "Down"}
+		thresholdAlerts := []string{"HighLoad"}
+
+		It("should accept valid overrides", func() {
+			Expect(overrides.Validate([]hcov1beta1.AlertRuleOverride{
+				{Alert: "HighLoad", Threshold: ptr.To("0.75"), Severity: ptr.To("critical")},
+				{Alert: "Down", Disabled: true},
+			}, knownAlerts, thresholdAlerts)).To(Succeed())
+		})
+
+		It("should reject an unknown alert", func() {
+			Expect(overrides.Validate([]hcov1beta1.AlertRuleOverride{
+				{Alert: "HighLod", Disabled: true},
+			}, knownAlerts, thresholdAlerts)).To(MatchError(`unknown alert "HighLod"`))
+		})
+
+		It("should reject a malformed threshold", func() {
+			Expect(overrides.Validate([]hcov1beta1.AlertRuleOverride{
+				{Alert: "HighLoad", Threshold: ptr.To("90")},
+			}, knownAlerts, thresholdAlerts)).To(MatchError(ContainSubstring("the threshold must be a ratio between 0 and 1")))
+		})
+
+		It("should reject a threshold of an alert with no configurable threshold", func() {
+			Expect(overrides.Validate([]hcov1beta1.AlertRuleOverride{
+				{Alert: "Down", Threshold: ptr.To("0.5")},
+			}, knownAlerts, thresholdAlerts)).To(MatchError(ContainSubstring("the alert has no configurable threshold")))
+		})
+	})
+})
@@ -0,0 +1,130 @@
+package registrymirror
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/image/v5/docker/reference"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+// dockerScheme is the scheme of the CDI registry source URLs that are pulled from a registry
+const dockerScheme = "docker://"
+
+// Validate checks that the sources and the mirrors are valid registry or repository names, without a scheme, a tag
+// or a digest, and that each source is mapped only once
+func Validate(mirrors []hcov1beta1.RegistryMirror) error {
+	sources := make(map[string]bool, len(mirrors))
+
+	for i, mirror := range mirrors {
+		if err := validatePrefix(mirror.Source); err != nil {
+			return fmt.Errorf("bootImageRegistryMirrors[%d].source: %w", i, err)
+		}
+
+		if err := validatePrefix(mirror.Mirror); err != nil {
+			return fmt.Errorf("bootImageRegistryMirrors[%d].mirror: %w", i, err)
+		}
+
+		if mirror.Source == mirror.Mirror {
+			return fmt.Errorf("bootImageRegistryMirrors[%d]: the mirror must be different than the source", i)
+		}
+
+		if sources[mirror.Source] {
+			return fmt.Errorf("bootImageRegistryMirrors[%d]: the %q source is mapped more than once", i, mirror.Source)
+		}
+		sources[mirror.Source] = true
+	}
+
+	return nil
+}
+
+func validatePrefix(prefix string) error {
+	if strings.Contains(prefix, "://") {
+		return fmt.Errorf("%q must not contain a scheme", prefix)
+	}
+
+	if !strings.Contains(prefix, "/") {
+		// a registry name, possibly with a port, that can't be parsed as a reference by itself
+		named, err := reference.ParseNormalizedNamed(prefix + "/image")
+		if err != nil || reference.Domain(named) != prefix {
+			return fmt.Errorf("%q is not a valid registry name", prefix)
+		}
+		return nil
+	}
+
+	named, err := reference.ParseNormalizedNamed(prefix)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid registry or repository name; %w", prefix, err)
+	}
+
+	if !reference.IsNameOnly(named) {
+		return fmt.Errorf("%q must not contain a tag or a digest", prefix)
+	}
+
+	return nil
+}
+
+// Host returns the registry host, possibly with a port, of a valid registry or repository name
+func Host(prefix string) string {
+	if !strings.Contains(prefix, "/") {
+		return prefix
+	}
+
+	named, err := reference.ParseNormalizedNamed(prefix)
+	if err != nil {
+		return prefix
+	}
+
+	return reference.Domain(named)
+}
+
+// RewriteURL replaces the registry prefix of a CDI registry source URL with its mirror. Only "docker://" URLs are
+// rewritten. It returns the URL and true if it was rewritten, or the original URL and false if no source matches.
+func RewriteURL(url string, mirrors []hcov1beta1.RegistryMirror) (string, bool) {
+	ref, found := strings.CutPrefix(url, dockerScheme)
+	if !found {
+		return url, false
+	}
+
+	mirrored, rewritten := Rewrite(ref, mirrors)
+	if !rewritten {
+		return url, false
+	}
+
+	return dockerScheme + mirrored, true
+}
+
+// Rewrite replaces the registry prefix of an image reference, or of a registry name, with its mirror, using the
+// longest matching source. It returns the reference and true if it was rewritten, or the original reference and false
+// if no source matches.
+func Rewrite(ref string, mirrors []hcov1beta1.RegistryMirror) (string, bool) {
+	var match *hcov1beta1.RegistryMirror
+	for i := range mirrors {
+		if matchPrefix(ref, mirrors[i].Source) && (match == nil || len(mirrors[i].Source) > len(match.Source)) {
+			match = &mirrors[i]
+		}
+	}
+
+	if match == nil {
+		return ref, false
+	}
+
+	return match.Mirror + strings.TrimPrefix(ref, match.Source), true
+}
+
+// matchPrefix checks if the prefix matches the reference at a name boundary. A registry-only prefix must be followed
+// by a path, to not match a registry with the same host and a port; a repository prefix may also be followed by a
+// tag or a digest.
+func matchPrefix(ref, prefix string) bool {
+	rest, found := strings.CutPrefix(ref, prefix)
+	if !found {
+		return false
+	}
+
+	if rest == "" || rest[0] == '/' {
+		return true
+	}
+
+	return strings.Contains(prefix, "/") && (rest[0] == ':' || rest[0] == '@')
+}
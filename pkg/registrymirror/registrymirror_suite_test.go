@@ -0,0 +1,13 @@
+package registrymirror
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRegistryMirror(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Registry Mirror Suite")
+}
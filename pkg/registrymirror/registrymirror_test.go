@@ -0,0 +1,92 @@
+package registrymirror
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+var _ = Describe("Registry mirrors", func() {
+	mirrors := []hcov1beta1.RegistryMirror{
+		{Source: "quay.io", Mirror: "mirror.example.com:5000"},
+		{Source: "quay.io/containerdisks", Mirror: "mirror.example.com:5000/golden-images"},
+		{Source: "registry.example.com/os/fedora", Mirror: "mirror.example.com:5000/fedora"},
+	}
+
+	Context("Validate", func() {
+		It("should accept valid mappings", func() {
+			Expect(Validate(mirrors)).To(Succeed())
+			Expect(Validate(nil)).To(Succeed())
+		})
+
+		DescribeTable("should reject invalid mappings", func(mirrors []hcov1beta1.RegistryMirror, expectedErr string) {
+			Expect(Validate(mirrors)).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("scheme in the source",
+				[]hcov1beta1.RegistryMirror{{Source: "docker://quay.io", Mirror: "mirror.example.com"}},
+				`bootImageRegistryMirrors[0].source: "docker://quay.io" must not contain a scheme`,
+			),
+			Entry("invalid mirror",
+				[]hcov1beta1.RegistryMirror{{Source: "quay.io", Mirror: "Mirror.example.com/UPPER"}},
+				`bootImageRegistryMirrors[0].mirror: "Mirror.example.com/UPPER" is not a valid registry or repository name`,
+			),
+			Entry("invalid registry name",
+				[]hcov1beta1.RegistryMirror{{Source: "quay", Mirror: "mirror.example.com"}},
+				`bootImageRegistryMirrors[0].source: "quay" is not a valid registry name`,
+			),
+			Entry("tag in the source",
+				[]hcov1beta1.RegistryMirror{{Source: "quay.io/containerdisks/fedora:latest", Mirror: "mirror.example.com"}},
+				`bootImageRegistryMirrors[0].source: "quay.io/containerdisks/fedora:latest" must not contain a tag or a digest`,
+			),
+			Entry("same source and mirror",
+				[]hcov1beta1.RegistryMirror{{Source: "quay.io", Mirror: "quay.io"}},
+				"bootImageRegistryMirrors[0]: the mirror must be different than the source",
+			),
+			Entry("duplicate source",
+				[]hcov1beta1.RegistryMirror{{Source: "quay.io", Mirror: "mirror1.example.com"}, {Source: "quay.io", Mirror: "mirror2.example.com"}},
+				`bootImageRegistryMirrors[1]: the "quay.io" source is mapped more than once`,
+			),
+		)
+	})
+
+	Context("Rewrite", func() {
+		DescribeTable("should use the longest matching prefix", func(ref, expected string, expectedRewritten bool) {
+			result, rewritten := Rewrite(ref, mirrors)
+			Expect(result).To(Equal(expected))
+			Expect(rewritten).To(Equal(expectedRewritten))
+		},
+			Entry("registry only", "quay.io/kubevirt/virt-launcher:v1", "mirror.example.com:5000/kubevirt/virt-launcher:v1", true),
+			Entry("registry name", "quay.io", "mirror.example.com:5000", true),
+			Entry("repository prefix", "quay.io/containerdisks/fedora:latest", "mirror.example.com:5000/golden-images/fedora:latest", true),
+			Entry("full repository with a tag", "registry.example.com/os/fedora:41", "mirror.example.com:5000/fedora:41", true),
+			Entry("full repository with a digest", "registry.example.com/os/fedora@sha256:1234", "mirror.example.com:5000/fedora@sha256:1234", true),
+			Entry("not at a name boundary", "registry.example.com/os/fedora-coreos:41", "registry.example.com/os/fedora-coreos:41", false),
+			Entry("registry with a port", "quay.io:443/containerdisks/fedora", "quay.io:443/containerdisks/fedora", false),
+			Entry("another registry", "docker.io/library/fedora", "docker.io/library/fedora", false),
+		)
+
+		It("should rewrite only docker URLs", func() {
+			url, rewritten := RewriteURL("docker://quay.io/containerdisks/centos-stream:10", mirrors)
+			Expect(rewritten).To(BeTrue())
+			Expect(url).To(Equal("docker://mirror.example.com:5000/golden-images/centos-stream:10"))
+
+			url, rewritten = RewriteURL("oci-archive://quay.io/containerdisks/centos-stream:10", mirrors)
+			Expect(rewritten).To(BeFalse())
+			Expect(url).To(Equal("oci-archive://quay.io/containerdisks/centos-stream:10"))
+
+			url, rewritten = RewriteURL("docker://quay.io/containerdisks/centos-stream:10", nil)
+			Expect(rewritten).To(BeFalse())
+			Expect(url).To(Equal("docker://quay.io/containerdisks/centos-stream:10"))
+		})
+	})
+
+	DescribeTable("Host should return the registry host", func(prefix, expected string) {
+		Expect(Host(prefix)).To(Equal(expected))
+	},
+		Entry("registry", "quay.io", "quay.io"),
+		Entry("registry with a port", "mirror.example.com:5000", "mirror.example.com:5000"),
+		Entry("repository prefix", "mirror.example.com:5000/golden-images", "mirror.example.com:5000"),
+		Entry("nested repository prefix", "registry.example.com/os/fedora", "registry.example.com"),
+	)
+})
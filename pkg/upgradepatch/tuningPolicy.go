@@ -0,0 +1,70 @@
+package upgradepatch
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"github.com/blang/semver/v4"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// the source versions that support the annotation tuning policy, but not the custom tuning policy
+const tuningPolicyMigrationRange = "<1.16.0"
+
+var tuningPolicyMigrationSemverRange = semver.MustParseRange(tuningPolicyMigrationRange)
+
+// migrateTuningPolicyAnnotation replaces the deprecated annotation tuning policy with the custom tuning policy, on
+// upgrade from a version that does not support the custom tuning policy.
+//
+// The qps and burst values are stored in the hco.kubevirt.io/tuningPolicy annotation as a json document within a
+// string, that can't be parsed by a json patch. That's why this migration is done here, and not by the upgrade patches
+// file.
+//
+// If the annotation can't be migrated, e.g. if the burst value is not a positive whole number, the annotation tuning
+// policy is kept as is.
+func migrateTuningPolicyAnnotation(logger logr.Logger, hc *v1beta1.HyperConverged, knownHcoSV semver.Version) {
+	if !tuningPolicyMigrationSemverRange(knownHcoSV) || hc.Spec.TuningPolicy != v1beta1.HyperConvergedAnnotationTuningPolicy {
+		return
+	}
+
+	annotation, ok := hc.Annotations[common.TuningPolicyAnnotationName]
+	if !ok {
+		return
+	}
+
+	rates := struct {
+		QPS   float64 `json:"qps"`
+		Burst int64   `json:"burst"`
+	}{}
+
+	if err := json.Unmarshal([]byte(annotation), &rates); err != nil {
+		logger.Error(err, "can't parse the tuningPolicy annotation; keeping the annotation tuning policy", "annotation", annotation)
+		return
+	}
+
+	if rates.QPS <= 0 || rates.Burst < 1 || rates.Burst > math.MaxUint32 {
+		logger.Info("can't migrate the tuningPolicy annotation; keeping the annotation tuning policy", "annotation", annotation)
+		return
+	}
+
+	qps, err := resource.ParseQuantity(strconv.FormatFloat(rates.QPS, 'f', -1, 64))
+	if err != nil {
+		logger.Error(err, "can't parse the qps value of the tuningPolicy annotation; keeping the annotation tuning policy", "annotation", annotation)
+		return
+	}
+
+	logger.Info("migrating the tuningPolicy annotation to the custom tuning policy", "annotation", annotation)
+	hc.Spec.TuningPolicy = v1beta1.HyperConvergedCustomTuningPolicy
+	hc.Spec.CustomTuningPolicy = &v1beta1.CustomTuningPolicy{
+		Default: &v1beta1.ComponentRateLimiter{
+			QPS:   qps,
+			Burst: uint32(rates.Burst),
+		},
+	}
+	delete(hc.Annotations, common.TuningPolicyAnnotationName)
+}
@@ -39,34 +39,51 @@ type hcoCRPatch struct {
 	JSONPatchApplyOptions *jsonpatch.ApplyOptions `json:"jsonPatchApplyOptions,omitempty"`
 }
 
-func (p hcoCRPatch) applyUpgradePatch(logger logr.Logger, hcoJSON []byte, knownHcoSV semver.Version) ([]byte, error) {
-	if p.IsAffectedRange(knownHcoSV) {
-		buff := &bytes.Buffer{}
-		err := json.NewEncoder(buff).Encode(p.JSONPatch)
-		if err != nil {
-			buff = bytes.NewBuffer([]byte("<unknown>"))
-		}
+// PatchResult is the result of applying a single upgrade patch
+type PatchResult string
 
-		logger.Info("applying upgrade patch", "knownHcoSV", knownHcoSV, "affectedRange", p.SemverRange.ver, "patches", buff.String(), "applyOptions", p.JSONPatchApplyOptions)
-		var (
-			patchedBytes []byte
-		)
-		if p.JSONPatchApplyOptions != nil {
-			patchedBytes, err = p.JSONPatch.ApplyWithOptions(hcoJSON, p.JSONPatchApplyOptions)
-		} else {
-			patchedBytes, err = p.JSONPatch.Apply(hcoJSON)
-		}
-		if err != nil {
-			// tolerate jsonpatch test failures
-			if errors.Is(err, jsonpatch.ErrTestFailed) {
-				return hcoJSON, nil
-			}
+const (
+	// PatchApplied means that the upgrade patch was applied to the HyperConverged CR
+	PatchApplied PatchResult = "Applied"
+	// PatchTestFailed means that the upgrade patch was skipped, because one of its test operations failed
+	PatchTestFailed PatchResult = "TestFailed"
+)
 
-			return hcoJSON, err
+// PatchReport describes the result of an upgrade patch that affects the source version
+type PatchReport struct {
+	// Index is the index of the patch in the hcoCRPatchList
+	Index int
+	// SemverRange is the semver range of affected source versions of the patch
+	SemverRange string
+	// Result is the result of applying the patch
+	Result PatchResult
+}
+
+func (p hcoCRPatch) applyUpgradePatch(logger logr.Logger, hcoJSON []byte, knownHcoSV semver.Version) ([]byte, PatchResult, error) {
+	buff := &bytes.Buffer{}
+	err := json.NewEncoder(buff).Encode(p.JSONPatch)
+	if err != nil {
+		buff = bytes.NewBuffer([]byte("<unknown>"))
+	}
+
+	logger.Info("applying upgrade patch", "knownHcoSV", knownHcoSV, "affectedRange", p.SemverRange.ver, "patches", buff.String(), "applyOptions", p.JSONPatchApplyOptions)
+	var (
+		patchedBytes []byte
+	)
+	if p.JSONPatchApplyOptions != nil {
+		patchedBytes, err = p.JSONPatch.ApplyWithOptions(hcoJSON, p.JSONPatchApplyOptions)
+	} else {
+		patchedBytes, err = p.JSONPatch.Apply(hcoJSON)
+	}
+	if err != nil {
+		// tolerate jsonpatch test failures
+		if errors.Is(err, jsonpatch.ErrTestFailed) {
+			return hcoJSON, PatchTestFailed, nil
 		}
-		return patchedBytes, nil
+
+		return hcoJSON, "", err
 	}
-	return hcoJSON, nil
+	return patchedBytes, PatchApplied, nil
 }
 
 func (p hcoCRPatch) IsAffectedRange(ver semver.Version) bool {
@@ -144,26 +161,63 @@ type UpgradePatches struct {
 	ObjectsToBeRemoved []ObjectToBeRemoved `json:"objectsToBeRemoved"`
 }
 
-func (up UpgradePatches) applyUpgradePatch(logger logr.Logger, hc *v1beta1.HyperConverged, knownHcoSV semver.Version) (*v1beta1.HyperConverged, error) {
+// Apply returns a copy of the HyperConverged CR, after applying all the upgrade patches that affect the source
+// version, with a report for each one of these patches.
+func (up UpgradePatches) Apply(logger logr.Logger, hc *v1beta1.HyperConverged, knownHcoSV semver.Version) (*v1beta1.HyperConverged, []PatchReport, error) {
 	hcoJSON, err := json.Marshal(hc)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	for _, patch := range up.HCOCRPatchList {
-		hcoJSON, err = patch.applyUpgradePatch(logger, hcoJSON, knownHcoSV)
+	var reports []PatchReport
+	for i, patch := range up.HCOCRPatchList {
+		if !patch.IsAffectedRange(knownHcoSV) {
+			continue
+		}
+
+		var result PatchResult
+		hcoJSON, result, err = patch.applyUpgradePatch(logger, hcoJSON, knownHcoSV)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+
+		reports = append(reports, PatchReport{Index: i, SemverRange: patch.SemverRange.ver, Result: result})
 	}
 
 	tmpInstance := &v1beta1.HyperConverged{}
 	err = json.Unmarshal(hcoJSON, tmpInstance)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	migrateTuningPolicyAnnotation(logger, tmpInstance, knownHcoSV)
+
+	return tmpInstance, reports, nil
+}
+
+// GetObjectsToBeRemoved returns the objects to be removed on upgrade from the source version
+func (up UpgradePatches) GetObjectsToBeRemoved(knownHcoSV semver.Version) []ObjectToBeRemoved {
+	var objects []ObjectToBeRemoved
+	for _, obj := range up.ObjectsToBeRemoved {
+		if obj.IsAffectedRange(knownHcoSV) {
+			objects = append(objects, obj)
+		}
 	}
+	return objects
+}
 
-	return tmpInstance, nil
+func (up UpgradePatches) validate() error {
+	for _, p := range up.HCOCRPatchList {
+		if err := validateUpgradePatch(p); err != nil {
+			return err
+		}
+	}
+	for _, r := range up.ObjectsToBeRemoved {
+		if err := validateUpgradeLeftover(r); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 var (
@@ -172,8 +226,8 @@ var (
 	onceErr           error
 )
 
-func ApplyUpgradePatch(logger logr.Logger, hc *v1beta1.HyperConverged, knownHcoSV semver.Version) (*v1beta1.HyperConverged, error) {
-	return hcoUpgradeChanges.applyUpgradePatch(logger, hc, knownHcoSV)
+func ApplyUpgradePatch(logger logr.Logger, hc *v1beta1.HyperConverged, knownHcoSV semver.Version) (*v1beta1.HyperConverged, []PatchReport, error) {
+	return hcoUpgradeChanges.Apply(logger, hc, knownHcoSV)
 }
 
 func GetObjectsToBeRemoved() []ObjectToBeRemoved {
@@ -214,17 +268,28 @@ func Init(logger logr.Logger) error {
 		return onceErr
 	}
 
-	for _, p := range hcoUpgradeChanges.HCOCRPatchList {
-		if err := validateUpgradePatch(p); err != nil {
-			return err
-		}
+	return hcoUpgradeChanges.validate()
+}
+
+// ReadUpgradePatches reads and validates the upgrade patches from the given file, without changing the upgrade patches
+// used by the operator.
+func ReadUpgradePatches(fileName string) (UpgradePatches, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return UpgradePatches{}, err
 	}
-	for _, r := range hcoUpgradeChanges.ObjectsToBeRemoved {
-		if err := validateUpgradeLeftover(r); err != nil {
-			return err
-		}
+	defer file.Close()
+
+	up := UpgradePatches{}
+	if err = json.NewDecoder(file).Decode(&up); err != nil {
+		return UpgradePatches{}, err
 	}
-	return nil
+
+	if err = up.validate(); err != nil {
+		return UpgradePatches{}, err
+	}
+
+	return up, nil
 }
 
 func validateUpgradePatch(p hcoCRPatch) error {
@@ -12,8 +12,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/ptr"
 
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/components"
 )
@@ -191,6 +194,63 @@ var _ = Describe("upgradePatches", func() {
 
 	})
 
+	Context("ReadUpgradePatches", func() {
+		It("should read and validate the upgrade patches from a file", func() {
+			up, err := ReadUpgradePatches(origFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(up.HCOCRPatchList).ToNot(BeEmpty())
+			Expect(up.ObjectsToBeRemoved).ToNot(BeEmpty())
+		})
+
+		It("should fail reading invalid upgrade patches", func() {
+			_, err := ReadUpgradePatches(path.Join(getTestFilesLocation(), "badPatches1.json"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail reading a missing file", func() {
+			_, err := ReadUpgradePatches(path.Join(getTestFilesLocation(), "notExist.json"))
+			Expect(err).To(MatchError(os.ErrNotExist))
+		})
+	})
+
+	Context("Apply", func() {
+		It("should report the applied and the skipped patches", func() {
+			up, err := ReadUpgradePatches(origFile)
+			Expect(err).ToNot(HaveOccurred())
+
+			hc := components.GetOperatorCR()
+			hc.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(false)
+
+			newHc, reports, err := up.Apply(GinkgoLogr, hc, semver.MustParse("1.14.0"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newHc.Spec.EnableCommonBootImageImport).To(HaveValue(BeFalse()))
+
+			Expect(reports).ToNot(BeEmpty())
+			for _, report := range reports {
+				Expect(up.HCOCRPatchList[report.Index].IsAffectedRange(semver.MustParse("1.14.0"))).To(BeTrue())
+			}
+
+			Expect(reports).To(ContainElement(PatchReport{Index: 4, SemverRange: "<1.15.0", Result: PatchApplied}))
+			Expect(reports).To(ContainElement(PatchReport{Index: 5, SemverRange: "<1.15.0", Result: PatchTestFailed}))
+		})
+
+		It("should return the objects to be removed for the source version", func() {
+			up, err := ReadUpgradePatches(origFile)
+			Expect(err).ToNot(HaveOccurred())
+
+			objects := up.GetObjectsToBeRemoved(semver.MustParse("1.20.0"))
+			Expect(objects).To(HaveLen(1))
+			Expect(objects[0].ObjectKey.Name).To(Equal("mtqs.mtq.kubevirt.io"))
+
+			objects = up.GetObjectsToBeRemoved(semver.MustParse("1.6.0"))
+			Expect(objects).ToNot(BeEmpty())
+			Expect(len(objects)).To(BeNumerically("<", len(up.ObjectsToBeRemoved)))
+			for _, obj := range objects {
+				Expect(obj.IsAffectedRange(semver.MustParse("1.6.0"))).To(BeTrue())
+			}
+		})
+	})
+
 	Context("check semverRange type", func() {
 		DescribeTable("check isAffectedRange", func(verRange, ver string, m types.GomegaMatcher) {
 			vr, err := newSemverRange(verRange)
@@ -228,7 +288,7 @@ var _ = Describe("upgradePatches", func() {
 			ver, err := semver.Parse("1.13.9")
 			Expect(err).NotTo(HaveOccurred())
 
-			newHc, err := ApplyUpgradePatch(GinkgoLogr, hc, ver)
+			newHc, _, err := ApplyUpgradePatch(GinkgoLogr, hc, ver)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(newHc.Spec.FeatureGates.DeployKubevirtIpamController).To(BeNil())
@@ -245,7 +305,7 @@ var _ = Describe("upgradePatches", func() {
 				hc.Spec.FeatureGates.EnableCommonBootImageImport = oldFG
 				hc.Spec.EnableCommonBootImageImport = newFG
 
-				newHc, err := ApplyUpgradePatch(GinkgoLogr, hc, ver)
+				newHc, _, err := ApplyUpgradePatch(GinkgoLogr, hc, ver)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(newHc.Spec.EnableCommonBootImageImport).To(assertField)
@@ -280,6 +340,84 @@ var _ = Describe("upgradePatches", func() {
 				BeNil(),
 			),
 		)
+
+		Context("Migrating the tuningPolicy annotation to the custom tuning policy", func() {
+			It("should migrate the annotation values", func() {
+				hc := components.GetOperatorCR()
+				hc.Spec.TuningPolicy = v1beta1.HyperConvergedAnnotationTuningPolicy
+				hc.Annotations = map[string]string{
+					common.TuningPolicyAnnotationName: `{"qps": 100, "burst": 200}`,
+					"other-annotation":                "value",
+				}
+
+				newHc, _, err := ApplyUpgradePatch(GinkgoLogr, hc, semver.MustParse("1.15.0"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(newHc.Spec.TuningPolicy).To(Equal(v1beta1.HyperConvergedCustomTuningPolicy))
+				Expect(newHc.Spec.CustomTuningPolicy).To(Equal(&v1beta1.CustomTuningPolicy{
+					Default: &v1beta1.ComponentRateLimiter{QPS: resource.MustParse("100"), Burst: 200},
+				}))
+				Expect(newHc.Annotations).To(Equal(map[string]string{"other-annotation": "value"}))
+			})
+
+			DescribeTable("should keep the annotation tuning policy if the annotation can't be migrated", func(annotation string) {
+				hc := components.GetOperatorCR()
+				hc.Spec.TuningPolicy = v1beta1.HyperConvergedAnnotationTuningPolicy
+				hc.Annotations = map[string]string{common.TuningPolicyAnnotationName: annotation}
+
+				newHc, _, err := ApplyUpgradePatch(GinkgoLogr, hc, semver.MustParse("1.15.0"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(newHc.Spec.TuningPolicy).To(Equal(v1beta1.HyperConvergedAnnotationTuningPolicy))
+				Expect(newHc.Spec.CustomTuningPolicy).To(BeNil())
+				Expect(newHc.Annotations).To(HaveKeyWithValue(common.TuningPolicyAnnotationName, annotation))
+			},
+				Entry("qps is not positive", `{"qps": 0, "burst": 200}`),
+				Entry("burst is missing", `{"qps": 100}`),
+				Entry("burst is not a whole number", `{"qps": 100, "burst": 5.5}`),
+				Entry("corrupted json", `{"qps: 100, "burst": 200}`),
+			)
+
+			It("should migrate a fractional qps value", func() {
+				hc := components.GetOperatorCR()
+				hc.Spec.TuningPolicy = v1beta1.HyperConvergedAnnotationTuningPolicy
+				hc.Annotations = map[string]string{common.TuningPolicyAnnotationName: `{"qps": 5.5, "burst": 200}`}
+
+				newHc, _, err := ApplyUpgradePatch(GinkgoLogr, hc, semver.MustParse("1.15.0"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(newHc.Spec.TuningPolicy).To(Equal(v1beta1.HyperConvergedCustomTuningPolicy))
+				Expect(newHc.Spec.CustomTuningPolicy.Default.QPS.AsApproximateFloat64()).To(Equal(5.5))
+				Expect(newHc.Spec.CustomTuningPolicy.Default.Burst).To(BeEquivalentTo(200))
+				Expect(newHc.Annotations).ToNot(HaveKey(common.TuningPolicyAnnotationName))
+			})
+
+			It("should not migrate the annotation on upgrade from a version that supports the custom tuning policy", func() {
+				hc := components.GetOperatorCR()
+				hc.Spec.TuningPolicy = v1beta1.HyperConvergedAnnotationTuningPolicy
+				hc.Annotations = map[string]string{common.TuningPolicyAnnotationName: `{"qps": 100, "burst": 200}`}
+
+				newHc, _, err := ApplyUpgradePatch(GinkgoLogr, hc, semver.MustParse("1.16.0"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(newHc.Spec.TuningPolicy).To(Equal(v1beta1.HyperConvergedAnnotationTuningPolicy))
+				Expect(newHc.Spec.CustomTuningPolicy).To(BeNil())
+				Expect(newHc.Annotations).To(HaveKey(common.TuningPolicyAnnotationName))
+			})
+
+			It("should not migrate the annotation if the tuning policy is not annotation", func() {
+				hc := components.GetOperatorCR()
+				hc.Spec.TuningPolicy = v1beta1.HyperConvergedHighBurstProfile
+				hc.Annotations = map[string]string{common.TuningPolicyAnnotationName: `{"qps": 100, "burst": 200}`}
+
+				newHc, _, err := ApplyUpgradePatch(GinkgoLogr, hc, semver.MustParse("1.15.0"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(newHc.Spec.TuningPolicy).To(Equal(v1beta1.HyperConvergedHighBurstProfile))
+				Expect(newHc.Spec.CustomTuningPolicy).To(BeNil())
+				Expect(newHc.Annotations).To(HaveKey(common.TuningPolicyAnnotationName))
+			})
+		})
 	})
 })
 
@@ -32,7 +32,9 @@ type ClusterInfo interface {
 	IsMonitoringAvailable() bool
 	IsDeschedulerAvailable() bool
 	IsNADAvailable() bool
+	IsMigrationPolicyAvailable() bool
 	IsDeschedulerCRDDeployed(ctx context.Context, cl client.Client) bool
+	IsMigrationPolicyCRDDeployed(ctx context.Context, cl client.Client) bool
 	IsSingleStackIPv6() bool
 	GetTLSSecurityProfile(hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *openshiftconfigv1.TLSSecurityProfile
 	RefreshAPIServerCR(ctx context.Context, c client.Client) error
@@ -49,6 +51,7 @@ type ClusterInfoImp struct {
 	monitoringAvailable        bool
 	deschedulerAvailable       bool
 	nadAvailable               bool
+	migrationPolicyAvailable   bool
 	singlestackipv6            bool
 	baseDomain                 string
 	ownResources               *OwnResources
@@ -94,10 +97,12 @@ func (c *ClusterInfoImp) Init(ctx context.Context, cl client.Client, logger logr
 	c.monitoringAvailable = isPrometheusExists(ctx, cl)
 	c.deschedulerAvailable = isDeschedulerExists(ctx, cl)
 	c.nadAvailable = isNADExists(ctx, cl)
+	c.migrationPolicyAvailable = isCRDExists(ctx, cl, MigrationPolicyCRDName)
 	c.logger.Info("addOns ",
 		"monitoring", c.monitoringAvailable,
 		"kubeDescheduler", c.deschedulerAvailable,
 		"networkAttachmentDefinition", c.nadAvailable,
+		"migrationPolicy", c.migrationPolicyAvailable,
 	)
 
 	err = c.RefreshAPIServerCR(ctx, cl)
@@ -170,10 +175,18 @@ func (c *ClusterInfoImp) IsNADAvailable() bool {
 	return c.nadAvailable
 }
 
+func (c *ClusterInfoImp) IsMigrationPolicyAvailable() bool {
+	return c.migrationPolicyAvailable
+}
+
 func (c *ClusterInfoImp) IsDeschedulerCRDDeployed(ctx context.Context, cl client.Client) bool {
 	return isCRDExists(ctx, cl, DeschedulerCRDName)
 }
 
+func (c *ClusterInfoImp) IsMigrationPolicyCRDDeployed(ctx context.Context, cl client.Client) bool {
+	return isCRDExists(ctx, cl, MigrationPolicyCRDName)
+}
+
 func (c *ClusterInfoImp) IsRunningLocally() bool {
 	return c.runningLocally
 }
@@ -21,13 +21,25 @@ const (
 	PasstCNIImageEnvV                  = "PASST_CNI_IMAGE"
 	WaspAgentImageEnvV                 = "WASP_AGENT_IMAGE"
 	DeployNetworkPoliciesEnvV          = "DEPLOY_NETWORK_POLICIES"
+	DictCatalogOCILayoutDirEnvV        = "DICT_CATALOG_OCI_LAYOUT_DIR"
+	AlertmanagerURLEnvV                = "ALERTMANAGER_URL"
+	AlertmanagerCAFileEnvV             = "ALERTMANAGER_CA_FILE"
+	AlertmanagerCAConfigMapEnvV        = "ALERTMANAGER_CA_CONFIGMAP"
+	MonitoringBackendEnvV              = "MONITORING_BACKEND"
+	MonitoringNamespaceEnvV            = "MONITORING_NAMESPACE"
+	PrometheusServiceAccountEnvV       = "PROMETHEUS_SERVICE_ACCOUNT"
+	PrometheusSelectorLabelsEnvV       = "PROMETHEUS_SELECTOR_LABELS"
+	SilencePollIntervalEnvV            = "SILENCE_POLL_INTERVAL"
 	HcoValidatingWebhook               = "validate-hco.kubevirt.io"
 	HcoMutatingWebhookNS               = "mutate-ns-hco.kubevirt.io"
 	PrometheusRuleCRDName              = "prometheusrules.monitoring.coreos.com"
 	ServiceMonitorCRDName              = "servicemonitors.monitoring.coreos.com"
 	DeschedulerCRDName                 = "kubedeschedulers.operator.openshift.io"
 	NetworkAttachmentDefinitionCRDName = "network-attachment-definitions.k8s.cni.cncf.io"
+	MigrationPolicyCRDName             = "migrationpolicies.migrations.kubevirt.io"
 	HcoMutatingWebhookHyperConverged   = "mutate-hyperconverged-hco.kubevirt.io"
+	HcoMutatingWebhookVMI              = "mutate-vmi-hco.kubevirt.io"
+	HcoMutatingWebhookVirtLauncher     = "mutate-virt-launcher-hco.kubevirt.io"
 	AppLabel                           = "app"
 	UndefinedNamespace                 = ""
 	OpenshiftNamespace                 = "openshift"
@@ -37,6 +49,7 @@ const (
 	APIVersionGroup                    = "hco.kubevirt.io"
 	APIVersion                         = APIVersionGroup + "/" + CurrentAPIVersion
 	HyperConvergedKind                 = "HyperConverged"
+	HyperConvergedProfileKind          = "HyperConvergedProfile"
 	// Recommended labels by Kubernetes. See
 	// https://kubernetes.io/docs/concepts/overview/working-with-objects/common-labels/
 	AppLabelPrefix    = "app.kubernetes.io"
@@ -66,6 +79,8 @@ const (
 	HCOWebhookPath               = "/validate-hco-kubevirt-io-v1beta1-hyperconverged"
 	HCOMutatingWebhookPath       = "/mutate-hco-kubevirt-io-v1beta1-hyperconverged"
 	HCONSWebhookPath             = "/mutate-ns-hco-kubevirt-io"
+	HCOVMIWebhookPath            = "/mutate-vmi-hco-kubevirt-io"
+	HCOLauncherWebhookPath       = "/mutate-virt-launcher-hco-kubevirt-io"
 	WebhookPort                  = 4343
 	WebhookPortName              = "webhook"
 
@@ -32,3 +32,17 @@ func getHcoObject(ctx context.Context, cli client.Client, namespace string) (*v1
 
 	return hco, nil
 }
+
+// getHyperConvergedProfile returns the HyperConvergedProfile of the namespace, or nil if the namespace has no profile
+func getHyperConvergedProfile(ctx context.Context, cli client.Client, namespace string) (*v1beta1.HyperConvergedProfile, error) {
+	profile := &v1beta1.HyperConvergedProfile{}
+	err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: v1beta1.HyperConvergedProfileName}, profile)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,178 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+)
+
+const virtLauncherLabelValue = "virt-launcher"
+
+var (
+	virtLauncherMutatorLogger = logf.Log.WithName("virt-launcher mutator")
+
+	_ admission.Handler = &VirtLauncherMutator{}
+
+	jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+)
+
+// VirtLauncherMutator sets the default RuntimeClass of the HyperConvergedProfile of the namespace, to new virt-launcher
+// pods.
+//
+// KubeVirt does not support a per-VMI RuntimeClass; it only sets the cluster-wide default RuntimeClass to the
+// virt-launcher pods. Therefore, the RuntimeClass of the profile is applied to the pods.
+type VirtLauncherMutator struct {
+	decoder admission.Decoder
+	cli     client.Client
+}
+
+func NewVirtLauncherMutator(cli client.Client, decoder admission.Decoder) *VirtLauncherMutator {
+	return &VirtLauncherMutator{
+		cli:     cli,
+		decoder: decoder,
+	}
+}
+
+func (lm *VirtLauncherMutator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation == admissionv1.Create {
+		return lm.mutatePod(ctx, req)
+	}
+
+	// ignoring other operations
+	return admission.Allowed(ignoreOperationMessage)
+}
+
+func (lm *VirtLauncherMutator) mutatePod(ctx context.Context, req admission.Request) admission.Response {
+	pod := &corev1.Pod{}
+	err := lm.decoder.Decode(req, pod)
+	if err != nil {
+		virtLauncherMutatorLogger.Error(err, "failed to read the pod")
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to parse the pod"))
+	}
+
+	// the cluster-wide RuntimeClass, or a RuntimeClass that was set by someone else, takes precedence
+	if pod.Labels[kubevirtcorev1.AppLabel] != virtLauncherLabelValue || ptr.Deref(pod.Spec.RuntimeClassName, "") != "" {
+		return admission.Allowed("")
+	}
+
+	profile, err := getHyperConvergedProfile(ctx, lm.cli, req.Namespace)
+	if err != nil {
+		virtLauncherMutatorLogger.Error(err, "failed to read the HyperConvergedProfile", "namespace", req.Namespace)
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to read the HyperConvergedProfile"))
+	}
+
+	if profile == nil || profile.Spec.DefaultRuntimeClass == nil {
+		return admission.Allowed(noProfileMessage)
+	}
+
+	runtimeClass := &nodev1.RuntimeClass{}
+	err = lm.cli.Get(ctx, client.ObjectKey{Name: *profile.Spec.DefaultRuntimeClass}, runtimeClass)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// setting a missing RuntimeClass would fail the pod creation
+			virtLauncherMutatorLogger.Info("the RuntimeClass of the HyperConvergedProfile does not exist; ignoring it", "namespace", req.Namespace, "runtimeClass", *profile.Spec.DefaultRuntimeClass)
+			return admission.Allowed("the RuntimeClass of the HyperConvergedProfile does not exist")
+		}
+
+		virtLauncherMutatorLogger.Error(err, "failed to read the RuntimeClass", "runtimeClass", *profile.Spec.DefaultRuntimeClass)
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to read the RuntimeClass"))
+	}
+
+	patches, ok := getRuntimeClassPatches(pod, runtimeClass)
+	if !ok {
+		virtLauncherMutatorLogger.Info("the node selector of the RuntimeClass conflicts with the pod node selector; ignoring it", "namespace", req.Namespace, "runtimeClass", runtimeClass.Name)
+		return admission.Allowed("the RuntimeClass of the HyperConvergedProfile conflicts with the pod")
+	}
+
+	virtLauncherMutatorLogger.Info("setting the RuntimeClass of the HyperConvergedProfile", "namespace", req.Namespace, "runtimeClass", runtimeClass.Name)
+	return admission.Patched("mutated", patches...)
+}
+
+// getRuntimeClassPatches returns the patches that set the RuntimeClass to the pod.
+//
+// The RuntimeClass admission controller of the API server applies the overhead and the scheduling of the RuntimeClass
+// before the mutating webhooks are called, so they must be applied here as well.
+func getRuntimeClassPatches(pod *corev1.Pod, runtimeClass *nodev1.RuntimeClass) ([]jsonpatch.JsonPatchOperation, bool) {
+	patches := []jsonpatch.JsonPatchOperation{
+		{
+			Operation: "add",
+			Path:      "/spec/runtimeClassName",
+			Value:     runtimeClass.Name,
+		},
+	}
+
+	if runtimeClass.Overhead != nil && len(runtimeClass.Overhead.PodFixed) > 0 {
+		patches = append(patches, jsonpatch.JsonPatchOperation{
+			Operation: "add",
+			Path:      "/spec/overhead",
+			Value:     runtimeClass.Overhead.PodFixed,
+		})
+	}
+
+	if runtimeClass.Scheduling == nil {
+		return patches, true
+	}
+
+	if len(runtimeClass.Scheduling.NodeSelector) > 0 {
+		if pod.Spec.NodeSelector == nil {
+			patches = append(patches, jsonpatch.JsonPatchOperation{
+				Operation: "add",
+				Path:      "/spec/nodeSelector",
+				Value:     runtimeClass.Scheduling.NodeSelector,
+			})
+		} else {
+			for key, value := range runtimeClass.Scheduling.NodeSelector {
+				podValue, found := pod.Spec.NodeSelector[key]
+				if !found {
+					patches = append(patches, jsonpatch.JsonPatchOperation{
+						Operation: "add",
+						Path:      "/spec/nodeSelector/" + jsonPointerEscaper.Replace(key),
+						Value:     value,
+					})
+				} else if podValue != value {
+					return nil, false
+				}
+			}
+		}
+	}
+
+	for _, toleration := range runtimeClass.Scheduling.Tolerations {
+		if slices.ContainsFunc(pod.Spec.Tolerations, func(podToleration corev1.Toleration) bool {
+			return podToleration.MatchToleration(&toleration)
+		}) {
+			continue
+		}
+
+		if pod.Spec.Tolerations == nil {
+			pod.Spec.Tolerations = []corev1.Toleration{}
+			patches = append(patches, jsonpatch.JsonPatchOperation{
+				Operation: "add",
+				Path:      "/spec/tolerations",
+				Value:     []corev1.Toleration{},
+			})
+		}
+
+		pod.Spec.Tolerations = append(pod.Spec.Tolerations, toleration)
+		patches = append(patches, jsonpatch.JsonPatchOperation{
+			Operation: "add",
+			Path:      "/spec/tolerations/-",
+			Value:     toleration,
+		})
+	}
+
+	return patches, true
+}
@@ -0,0 +1,152 @@
+package mutator
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gomodules.xyz/jsonpatch/v2"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("test virt-launcher mutator", func() {
+	s := scheme.Scheme
+
+	codecFactory := serializer.NewCodecFactory(s)
+	podCodec := codecFactory.LegacyCodec(corev1.SchemeGroupVersion)
+
+	const runtimeClassName = "profile-runtime-class"
+
+	var (
+		pod          *corev1.Pod
+		runtimeClass *nodev1.RuntimeClass
+		profile      *v1beta1.HyperConvergedProfile
+	)
+
+	BeforeEach(func() {
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virt-launcher-vmi-abcde",
+				Namespace: profileNamespace,
+				Labels:    map[string]string{"kubevirt.io": "virt-launcher"},
+			},
+		}
+
+		runtimeClass = &nodev1.RuntimeClass{
+			ObjectMeta: metav1.ObjectMeta{Name: runtimeClassName},
+			Handler:    "handler",
+		}
+
+		profile = &v1beta1.HyperConvergedProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      v1beta1.HyperConvergedProfileName,
+				Namespace: profileNamespace,
+			},
+			Spec: v1beta1.HyperConvergedProfileSpec{
+				DefaultRuntimeClass: ptr.To(runtimeClassName),
+			},
+		}
+	})
+
+	handle := func(objects ...client.Object) admission.Response {
+		cli := commontestutils.InitClient(objects)
+		mutator := NewVirtLauncherMutator(cli, admission.NewDecoder(s))
+
+		req := admission.Request{AdmissionRequest: newCreateRequest(pod, podCodec)}
+		req.Namespace = profileNamespace
+
+		return mutator.Handle(context.TODO(), req)
+	}
+
+	runtimeClassPatch := jsonpatch.JsonPatchOperation{
+		Operation: "add",
+		Path:      "/spec/runtimeClassName",
+		Value:     runtimeClassName,
+	}
+
+	It("should set the RuntimeClass of the profile", func() {
+		res := handle(profile, runtimeClass)
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(ConsistOf(runtimeClassPatch))
+	})
+
+	It("should not mutate the pod if the namespace has no profile", func() {
+		res := handle(runtimeClass)
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(BeEmpty())
+	})
+
+	It("should not mutate the pod if the profile does not set a RuntimeClass", func() {
+		profile.Spec.DefaultRuntimeClass = nil
+
+		res := handle(profile, runtimeClass)
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(BeEmpty())
+	})
+
+	It("should not override the RuntimeClass of the pod", func() {
+		pod.Spec.RuntimeClassName = ptr.To("cluster-wide")
+
+		res := handle(profile, runtimeClass)
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(BeEmpty())
+	})
+
+	It("should not mutate pods that are not virt-launcher pods", func() {
+		pod.Labels = nil
+
+		res := handle(profile, runtimeClass)
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(BeEmpty())
+	})
+
+	It("should not set a missing RuntimeClass", func() {
+		res := handle(profile)
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(BeEmpty())
+	})
+
+	It("should apply the overhead and the scheduling of the RuntimeClass", func() {
+		overhead := corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("100Mi")}
+		toleration := corev1.Toleration{Key: "runtime", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+
+		runtimeClass.Overhead = &nodev1.Overhead{PodFixed: overhead}
+		runtimeClass.Scheduling = &nodev1.Scheduling{
+			NodeSelector: map[string]string{"example.com/runtime": "true", "existing": "value"},
+			Tolerations:  []corev1.Toleration{toleration},
+		}
+		pod.Spec.NodeSelector = map[string]string{"existing": "value"}
+
+		res := handle(profile, runtimeClass)
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(ConsistOf(
+			runtimeClassPatch,
+			jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/overhead", Value: overhead},
+			jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/nodeSelector/example.com~1runtime", Value: "true"},
+			jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/tolerations", Value: []corev1.Toleration{}},
+			jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/tolerations/-", Value: toleration},
+		))
+	})
+
+	It("should not set the RuntimeClass if its node selector conflicts with the pod", func() {
+		runtimeClass.Scheduling = &nodev1.Scheduling{
+			NodeSelector: map[string]string{"existing": "other-value"},
+		}
+		pod.Spec.NodeSelector = map[string]string{"existing": "value"}
+
+		res := handle(profile, runtimeClass)
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(BeEmpty())
+	})
+})
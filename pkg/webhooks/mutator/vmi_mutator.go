@@ -0,0 +1,97 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+)
+
+const noProfileMessage = "the namespace has no HyperConvergedProfile"
+
+var (
+	vmiMutatorLogger = logf.Log.WithName("vmi mutator")
+
+	_ admission.Handler = &VMIMutator{}
+)
+
+// VMIMutator applies the defaults of the HyperConvergedProfile of the namespace, to new VMIs
+type VMIMutator struct {
+	decoder admission.Decoder
+	cli     client.Client
+}
+
+func NewVMIMutator(cli client.Client, decoder admission.Decoder) *VMIMutator {
+	return &VMIMutator{
+		cli:     cli,
+		decoder: decoder,
+	}
+}
+
+func (vm *VMIMutator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation == admissionv1.Create {
+		return vm.mutateVMI(ctx, req)
+	}
+
+	// ignoring other operations
+	return admission.Allowed(ignoreOperationMessage)
+}
+
+func (vm *VMIMutator) mutateVMI(ctx context.Context, req admission.Request) admission.Response {
+	vmi := &kubevirtcorev1.VirtualMachineInstance{}
+	err := vm.decoder.Decode(req, vmi)
+	if err != nil {
+		vmiMutatorLogger.Error(err, "failed to read the VirtualMachineInstance")
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to parse the VirtualMachineInstance"))
+	}
+
+	profile, err := getHyperConvergedProfile(ctx, vm.cli, req.Namespace)
+	if err != nil {
+		vmiMutatorLogger.Error(err, "failed to read the HyperConvergedProfile", "namespace", req.Namespace)
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to read the HyperConvergedProfile"))
+	}
+
+	if profile == nil {
+		return admission.Allowed(noProfileMessage)
+	}
+
+	var patches []jsonpatch.JsonPatchOperation
+
+	if profile.Spec.EvictionStrategy != nil && vmi.Spec.EvictionStrategy == nil {
+		patches = append(patches, jsonpatch.JsonPatchOperation{
+			Operation: "add",
+			Path:      "/spec/evictionStrategy",
+			Value:     *profile.Spec.EvictionStrategy,
+		})
+	}
+
+	if profile.Spec.DefaultCPUModel != nil {
+		if vmi.Spec.Domain.CPU == nil {
+			patches = append(patches, jsonpatch.JsonPatchOperation{
+				Operation: "add",
+				Path:      "/spec/domain/cpu",
+				Value:     map[string]string{"model": *profile.Spec.DefaultCPUModel},
+			})
+		} else if vmi.Spec.Domain.CPU.Model == "" {
+			patches = append(patches, jsonpatch.JsonPatchOperation{
+				Operation: "add",
+				Path:      "/spec/domain/cpu/model",
+				Value:     *profile.Spec.DefaultCPUModel,
+			})
+		}
+	}
+
+	if len(patches) > 0 {
+		vmiMutatorLogger.Info("applying the HyperConvergedProfile defaults", "namespace", req.Namespace, "name", vmi.Name)
+		return admission.Patched("mutated", patches...)
+	}
+
+	return admission.Allowed("")
+}
@@ -0,0 +1,121 @@
+package mutator
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+const profileNamespace = "profile-namespace"
+
+var _ = Describe("test VMI mutator", func() {
+	s := scheme.Scheme
+	_ = kubevirtcorev1.AddToScheme(s)
+
+	codecFactory := serializer.NewCodecFactory(s)
+	vmiCodec := codecFactory.LegacyCodec(kubevirtcorev1.SchemeGroupVersion)
+
+	var vmi *kubevirtcorev1.VirtualMachineInstance
+
+	BeforeEach(func() {
+		vmi = &kubevirtcorev1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vmi",
+				Namespace: profileNamespace,
+			},
+		}
+	})
+
+	newProfile := func() *v1beta1.HyperConvergedProfile {
+		return &v1beta1.HyperConvergedProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      v1beta1.HyperConvergedProfileName,
+				Namespace: profileNamespace,
+			},
+			Spec: v1beta1.HyperConvergedProfileSpec{
+				EvictionStrategy: ptr.To(kubevirtcorev1.EvictionStrategyLiveMigrate),
+				DefaultCPUModel:  ptr.To("Haswell"),
+			},
+		}
+	}
+
+	handle := func(objects ...client.Object) admission.Response {
+		cli := commontestutils.InitClient(objects)
+		mutator := NewVMIMutator(cli, admission.NewDecoder(s))
+
+		req := admission.Request{AdmissionRequest: newCreateRequest(vmi, vmiCodec)}
+		req.Namespace = profileNamespace
+
+		return mutator.Handle(context.TODO(), req)
+	}
+
+	It("should not mutate the VMI if the namespace has no profile", func() {
+		res := handle()
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(BeEmpty())
+	})
+
+	It("should set the profile defaults", func() {
+		res := handle(newProfile())
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(ConsistOf(
+			jsonpatch.JsonPatchOperation{
+				Operation: "add",
+				Path:      "/spec/evictionStrategy",
+				Value:     kubevirtcorev1.EvictionStrategyLiveMigrate,
+			},
+			jsonpatch.JsonPatchOperation{
+				Operation: "add",
+				Path:      "/spec/domain/cpu",
+				Value:     map[string]string{"model": "Haswell"},
+			},
+		))
+	})
+
+	It("should set the CPU model if the VMI sets other CPU fields", func() {
+		vmi.Spec.Domain.CPU = &kubevirtcorev1.CPU{Cores: 2}
+
+		res := handle(newProfile())
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(ContainElement(jsonpatch.JsonPatchOperation{
+			Operation: "add",
+			Path:      "/spec/domain/cpu/model",
+			Value:     "Haswell",
+		}))
+	})
+
+	It("should not override the VMI fields", func() {
+		vmi.Spec.EvictionStrategy = ptr.To(kubevirtcorev1.EvictionStrategyNone)
+		vmi.Spec.Domain.CPU = &kubevirtcorev1.CPU{Model: "host-passthrough"}
+
+		res := handle(newProfile())
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(BeEmpty())
+	})
+
+	It("should ignore other operations", func() {
+		cli := commontestutils.InitClient([]client.Object{newProfile()})
+		mutator := NewVMIMutator(cli, admission.NewDecoder(s))
+
+		req := admission.Request{AdmissionRequest: newCreateRequest(vmi, vmiCodec)}
+		req.Operation = admissionv1.Update
+
+		res := mutator.Handle(context.TODO(), req)
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Patches).To(BeEmpty())
+	})
+})
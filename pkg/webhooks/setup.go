@@ -36,6 +36,8 @@ func SetupWebhookWithManager(ctx context.Context, mgr ctrl.Manager, isOpenshift
 	whHandler := validator.NewWebhookHandler(logger, mgr.GetClient(), decoder, operatorNsEnv, isOpenshift, hcoTLSSecurityProfile)
 	nsMutator := mutator.NewNsMutator(mgr.GetClient(), decoder, operatorNsEnv)
 	hyperConvergedMutator := mutator.NewHyperConvergedMutator(mgr.GetClient(), decoder)
+	vmiMutator := mutator.NewVMIMutator(mgr.GetClient(), decoder)
+	virtLauncherMutator := mutator.NewVirtLauncherMutator(mgr.GetClient(), decoder)
 
 	if err := allowWatchAllNamespaces(ctx, mgr); err != nil {
 		return err
@@ -46,6 +48,8 @@ func SetupWebhookWithManager(ctx context.Context, mgr ctrl.Manager, isOpenshift
 	srv.Register(hcoutil.HCONSWebhookPath, &webhook.Admission{Handler: nsMutator})
 	srv.Register(hcoutil.HCOMutatingWebhookPath, &webhook.Admission{Handler: hyperConvergedMutator})
 	srv.Register(hcoutil.HCOWebhookPath, &webhook.Admission{Handler: whHandler})
+	srv.Register(hcoutil.HCOVMIWebhookPath, &webhook.Admission{Handler: vmiMutator})
+	srv.Register(hcoutil.HCOLauncherWebhookPath, &webhook.Admission{Handler: virtLauncherMutator})
 
 	return nil
 }
@@ -91,5 +95,45 @@ func allowWatchAllNamespaces(ctx context.Context, mgr ctrl.Manager) error {
 			}
 		}
 	}
+
+	// The HyperConvergedProfile mutating webhooks must intercept the VMIs and the virt-launcher pods in all the
+	// namespaces, as well.
+	for _, whName := range []string{hcoutil.HcoMutatingWebhookVMI, hcoutil.HcoMutatingWebhookVirtLauncher} {
+		if err = allowMutatingWebhookWatchAllNamespaces(ctx, mgr, whName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func allowMutatingWebhookWatchAllNamespaces(ctx context.Context, mgr ctrl.Manager, whName string) error {
+	mwcList := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	err := mgr.GetAPIReader().List(ctx, mwcList, client.MatchingLabels{"olm.webhook-description-generate-name": whName})
+	if err != nil {
+		logger.Error(err, "failed to list the mutating webhooks", "webhook", whName)
+		return err
+	}
+
+	for _, mwc := range mwcList.Items {
+		update := false
+
+		for i, wh := range mwc.Webhooks {
+			if wh.Name == whName {
+				mwc.Webhooks[i].NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{}}
+				update = true
+			}
+		}
+
+		if update {
+			logger.Info("Removing namespace scope from webhook", "webhook", mwc.Name)
+			err = mgr.GetClient().Update(ctx, &mwc)
+			if err != nil {
+				logger.Error(err, "Failed updating webhook", "webhook", mwc.Name)
+				return err
+			}
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,181 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	sspv1beta3 "kubevirt.io/ssp-operator/api/v1beta3"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+)
+
+const (
+	jsonPatchFieldWarning    = "the %s annotation modifies the %s %s field from %s to %s"
+	jsonPatchNoChangeWarning = "the %s annotation does not modify the %s CR"
+	// the preview only detects unknown fields; the enum, pattern, range and CEL rules of the operand CRD are not checked
+	jsonPatchNotValidatedWarning = "the values set by the %s annotation are not validated against the %s CRD schema, and may still be rejected"
+	jsonPatchUnsetValue          = "<unset>"
+)
+
+// jsonPatchOperand describes an operand CR that can be modified by a jsonpatch annotation in the HyperConverged CR
+type jsonPatchOperand struct {
+	annotation string
+	kind       string
+	newOperand func(hc *v1beta1.HyperConverged) (client.Object, error)
+	newEmpty   func() client.Object
+}
+
+var jsonPatchOperands = []jsonPatchOperand{
+	{
+		annotation: common.JSONPatchKVAnnotationName,
+		kind:       "KubeVirt",
+		newOperand: func(hc *v1beta1.HyperConverged) (client.Object, error) {
+			return handlers.NewKubeVirt(hc)
+		},
+		newEmpty: func() client.Object {
+			return &kubevirtcorev1.KubeVirt{}
+		},
+	},
+	{
+		annotation: common.JSONPatchCDIAnnotationName,
+		kind:       "CDI",
+		newOperand: func(hc *v1beta1.HyperConverged) (client.Object, error) {
+			return handlers.NewCDI(hc)
+		},
+		newEmpty: func() client.Object {
+			return &cdiv1beta1.CDI{}
+		},
+	},
+	{
+		annotation: common.JSONPatchCNAOAnnotationName,
+		kind:       "NetworkAddonsConfig",
+		newOperand: func(hc *v1beta1.HyperConverged) (client.Object, error) {
+			return handlers.NewNetworkAddons(hc)
+		},
+		newEmpty: func() client.Object {
+			return &networkaddonsv1.NetworkAddonsConfig{}
+		},
+	},
+}
+
+var sspJSONPatchOperand = jsonPatchOperand{
+	annotation: common.JSONPatchSSPAnnotationName,
+	kind:       "SSP",
+	newOperand: func(hc *v1beta1.HyperConverged) (client.Object, error) {
+		ssp, _, err := handlers.NewSSP(hc)
+		return ssp, err
+	},
+	newEmpty: func() client.Object {
+		return &sspv1beta3.SSP{}
+	},
+}
+
+// previewJSONPatchAnnotations applies the jsonpatch annotations of the requested HyperConverged CR to the operand
+// CRs, and returns the resulting modifications as warnings. The patch is rejected if it fails, or if it sets fields
+// that do not exist in the operand CR. The values of the fields are not validated against the operand CRD schema
+// (e.g. enums, patterns, ranges or CEL rules), so a patch that passes the preview may still be rejected when the
+// operand CR is updated.
+//
+// Only the annotations that differ from the ones in the exists HyperConverged CR are checked. exists may be nil, on
+// create.
+func (wh *WebhookHandler) previewJSONPatchAnnotations(requested, exists *v1beta1.HyperConverged) ([]string, error) {
+	patchOperands := jsonPatchOperands
+	if wh.isOpenshift {
+		patchOperands = append(slices.Clone(jsonPatchOperands), sspJSONPatchOperand)
+	}
+
+	var warnings []string
+	for _, po := range patchOperands {
+		patch, ok := requested.Annotations[po.annotation]
+		if !ok || (exists != nil && exists.Annotations[po.annotation] == patch) {
+			continue
+		}
+
+		operandWarnings, err := po.preview(requested, patch)
+		if err != nil {
+			return nil, err
+		}
+		warnings = append(warnings, operandWarnings...)
+	}
+
+	return warnings, nil
+}
+
+func (po jsonPatchOperand) preview(hc *v1beta1.HyperConverged, patch string) ([]string, error) {
+	unpatchedHC := hc.DeepCopy()
+	delete(unpatchedHC.Annotations, po.annotation)
+
+	unpatched, err := po.newOperand(unpatchedHC)
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := po.newOperand(hc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = po.validateFields(unpatched, patch); err != nil {
+		return nil, fmt.Errorf("invalid jsonPatch in the %s annotation: %w", po.annotation, err)
+	}
+
+	fields, err := operands.GetDriftedFields(unpatched, patched)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return []string{fmt.Sprintf(jsonPatchNoChangeWarning, po.annotation, po.kind)}, nil
+	}
+
+	warnings := make([]string, 0, len(fields)+1)
+	for _, field := range fields {
+		warnings = append(warnings, fmt.Sprintf(jsonPatchFieldWarning, po.annotation, po.kind, field.Path, jsonPatchValue(field.Previous), jsonPatchValue(field.Desired)))
+	}
+	warnings = append(warnings, fmt.Sprintf(jsonPatchNotValidatedWarning, po.annotation, po.kind))
+
+	return warnings, nil
+}
+
+// validateFields applies the patch to the unpatched operand, and makes sure all the resulting fields are known to the
+// operand CR. Unknown fields are silently dropped when the patched operand is built, so without this check, a typo in
+// the patch path would be ignored. Only the structure of the operand CR is checked, not the values of its fields.
+func (po jsonPatchOperand) validateFields(unpatched client.Object, patch string) error {
+	patches, err := jsonpatch.DecodePatch([]byte(patch))
+	if err != nil {
+		return err
+	}
+
+	objBytes, err := json.Marshal(unpatched)
+	if err != nil {
+		return err
+	}
+
+	patchedBytes, err := patches.Apply(objBytes)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(patchedBytes))
+	dec.DisallowUnknownFields()
+
+	return dec.Decode(po.newEmpty())
+}
+
+func jsonPatchValue(value string) string {
+	if value == "" {
+		return jsonPatchUnsetValue
+	}
+	return value
+}
@@ -28,8 +28,15 @@ import (
 	sspv1beta3 "kubevirt.io/ssp-operator/api/v1beta3"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/maintenancewindow"
+	hcorules "github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules"
+	observabilityrules "github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/overrides"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/registrymirror"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -47,6 +54,15 @@ func newValidationWarning(warnings []string) *ValidationWarning {
 	}
 }
 
+// newValidationWarningIfAny returns a ValidationWarning with the warnings, or nil if there are no warnings
+func newValidationWarningIfAny(warnings []string) error {
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	return newValidationWarning(warnings)
+}
+
 func (v *ValidationWarning) Error() string {
 	return ""
 }
@@ -157,7 +173,31 @@ func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1b
 		return err
 	}
 
-	if err := wh.validateFeatureGatesOnCreate(hc); err != nil {
+	if err := wh.validateTuningPolicy(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateMaintenanceWindows(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateBootImageRegistryMirrors(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateBootImageArchitecturePolicy(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateLiveMigrationNetwork(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateAlertRuleOverrides(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateServiceLevelObjectives(hc); err != nil {
 		return err
 	}
 
@@ -181,11 +221,16 @@ func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1b
 		return err
 	}
 
+	patchWarnings, err := wh.previewJSONPatchAnnotations(hc, nil)
+	if err != nil {
+		return err
+	}
+
 	if !dryrun {
 		hcoTLSConfigCache = hc.Spec.TLSSecurityProfile
 	}
 
-	return nil
+	return newValidationWarningIfAny(append(wh.validateFeatureGatesOnCreate(hc), patchWarnings...))
 }
 
 func (wh *WebhookHandler) getOperands(requested *v1beta1.HyperConverged) (*kubevirtcorev1.KubeVirt, *cdiv1beta1.CDI, *networkaddonsv1.NetworkAddonsConfig, error) {
@@ -228,10 +273,36 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 		return err
 	}
 
-	if err := wh.validateFeatureGatesOnUpdate(requested, exists); err != nil {
+	if err := wh.validateTuningPolicy(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateMaintenanceWindows(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateBootImageRegistryMirrors(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateBootImageArchitecturePolicy(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateLiveMigrationNetwork(requested); err != nil {
 		return err
 	}
 
+	if err := wh.validateAlertRuleOverrides(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateServiceLevelObjectives(requested); err != nil {
+		return err
+	}
+
+	fgWarnings := wh.validateFeatureGatesOnUpdate(requested, exists)
+
 	if err := wh.validateAffinity(requested); err != nil {
 		return err
 	}
@@ -239,7 +310,7 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 	// If no change is detected in the spec nor the annotations - nothing to validate
 	if reflect.DeepEqual(exists.Spec, requested.Spec) &&
 		reflect.DeepEqual(exists.Annotations, requested.Annotations) {
-		return nil
+		return newValidationWarningIfAny(fgWarnings)
 	}
 
 	kv, cdi, cna, err := wh.getOperands(requested)
@@ -247,6 +318,11 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 		return err
 	}
 
+	patchWarnings, err := wh.previewJSONPatchAnnotations(requested, exists)
+	if err != nil {
+		return err
+	}
+
 	toCtx, cancel := context.WithTimeout(ctx, updateDryRunTimeOut)
 	defer cancel()
 
@@ -298,7 +374,7 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 		hcoTLSConfigCache = requested.Spec.TLSSecurityProfile
 	}
 
-	return nil
+	return newValidationWarningIfAny(append(fgWarnings, patchWarnings...))
 }
 
 func (wh *WebhookHandler) updateOperatorCr(ctx context.Context, hc *v1beta1.HyperConverged, exists client.Object, opts *client.UpdateOptions) error {
@@ -453,33 +529,117 @@ func (wh *WebhookHandler) validateMediatedDeviceTypes(hc *v1beta1.HyperConverged
 	return nil
 }
 
-const (
-	fgMovedWarning       = "spec.featureGates.%[1]s is deprecated and ignored. It will removed in a future version; use spec.%[1]s instead"
-	fgDeprecationWarning = "spec.featureGates.%s is deprecated and ignored. It will be removed in a future version;"
-)
+func (wh *WebhookHandler) validateTuningPolicy(hc *v1beta1.HyperConverged) error {
+	custom := hc.Spec.CustomTuningPolicy
 
-func (wh *WebhookHandler) validateFeatureGatesOnCreate(hc *v1beta1.HyperConverged) error {
-	warnings := wh.validateDeprecatedFeatureGates(hc)
-	warnings = validateOldFGOnCreate(warnings, hc)
+	if hc.Spec.TuningPolicy != v1beta1.HyperConvergedCustomTuningPolicy {
+		if custom != nil {
+			return fmt.Errorf("spec.customTuningPolicy can only be set when spec.tuningPolicy is %q", v1beta1.HyperConvergedCustomTuningPolicy)
+		}
+		return nil
+	}
+
+	if custom == nil {
+		return fmt.Errorf("spec.customTuningPolicy must be set when spec.tuningPolicy is %q", v1beta1.HyperConvergedCustomTuningPolicy)
+	}
+
+	if custom.Default == nil && custom.API == nil && custom.Controller == nil && custom.Handler == nil && custom.Webhook == nil {
+		return fmt.Errorf("spec.customTuningPolicy must contain at least one rate limiter")
+	}
+
+	for _, rl := range []struct {
+		name        string
+		rateLimiter *v1beta1.ComponentRateLimiter
+	}{
+		{name: "default", rateLimiter: custom.Default},
+		{name: "api", rateLimiter: custom.API},
+		{name: "controller", rateLimiter: custom.Controller},
+		{name: "handler", rateLimiter: custom.Handler},
+		{name: "webhook", rateLimiter: custom.Webhook},
+	} {
+		if rl.rateLimiter != nil && (rl.rateLimiter.QPS.Sign() <= 0 || rl.rateLimiter.Burst == 0) {
+			return fmt.Errorf("spec.customTuningPolicy.%s: qps and burst must be positive", rl.name)
+		}
+	}
 
-	if len(warnings) > 0 {
-		return newValidationWarning(warnings)
+	if _, ok := hc.Annotations[common.TuningPolicyAnnotationName]; ok {
+		return fmt.Errorf("the %s annotation can't be used with the %q tuning policy", common.TuningPolicyAnnotationName, v1beta1.HyperConvergedCustomTuningPolicy)
 	}
 
 	return nil
 }
 
-func (wh *WebhookHandler) validateFeatureGatesOnUpdate(requested, exists *v1beta1.HyperConverged) error {
-	warnings := wh.validateDeprecatedFeatureGates(requested)
-	warnings = validateOldFGOnUpdate(warnings, requested, exists)
+func (wh *WebhookHandler) validateMaintenanceWindows(hc *v1beta1.HyperConverged) error {
+	if err := maintenancewindow.Validate(hc.Spec.WorkloadUpdateStrategy.MaintenanceWindows); err != nil {
+		return fmt.Errorf("spec.workloadUpdateStrategy.%w", err)
+	}
+
+	return nil
+}
+
+func (wh *WebhookHandler) validateBootImageRegistryMirrors(hc *v1beta1.HyperConverged) error {
+	if err := registrymirror.Validate(hc.Spec.BootImageRegistryMirrors); err != nil {
+		return fmt.Errorf("spec.%w", err)
+	}
+
+	return nil
+}
 
-	if len(warnings) > 0 {
-		return newValidationWarning(warnings)
+func (wh *WebhookHandler) validateBootImageArchitecturePolicy(hc *v1beta1.HyperConverged) error {
+	if err := goldenimages.ValidateArchitecturePolicy(hc.Spec.BootImageArchitecturePolicy); err != nil {
+		return fmt.Errorf("spec.bootImageArchitecturePolicy: %w", err)
 	}
 
 	return nil
 }
 
+func (wh *WebhookHandler) validateLiveMigrationNetwork(hc *v1beta1.HyperConverged) error {
+	if err := handlers.ValidateMigrationNetwork(hc.Spec.LiveMigrationConfig); err != nil {
+		return fmt.Errorf("spec.liveMigrationConfig: %w", err)
+	}
+
+	return nil
+}
+
+// validateAlertRuleOverrides rejects the overrides of unknown alerts, and the malformed overrides. The operator ignores
+// such overrides, so they are rejected here to avoid silently ignored configuration.
+func (wh *WebhookHandler) validateAlertRuleOverrides(hc *v1beta1.HyperConverged) error {
+	knownAlerts := append(hcorules.AlertNames(), observabilityrules.AlertNames()...)
+	if err := overrides.Validate(hc.Spec.AlertRuleOverrides, knownAlerts, observabilityrules.ThresholdAlertNames()); err != nil {
+		return fmt.Errorf("spec.alertRuleOverrides: %w", err)
+	}
+
+	return nil
+}
+
+// validateServiceLevelObjectives rejects the service level objectives that the operator would skip; e.g. an objective
+// of an unknown indicator, or with a window shorter than a day.
+func (wh *WebhookHandler) validateServiceLevelObjectives(hc *v1beta1.HyperConverged) error {
+	if err := hcorules.ValidateServiceLevelObjectives(hc.Spec.ServiceLevelObjectives); err != nil {
+		return fmt.Errorf("spec.serviceLevelObjectives: %w", err)
+	}
+
+	return nil
+}
+
+const (
+	fgMovedWarning       = "spec.featureGates.%[1]s is deprecated and ignored. It will removed in a future version; use spec.%[1]s instead"
+	fgDeprecationWarning = "spec.featureGates.%s is deprecated and ignored. It will be removed in a future version;"
+)
+
+// validateFeatureGatesOnCreate returns the warnings of the deprecated feature gates. The warnings don't stop the
+// validation; they are returned together with the other warnings, once the rest of the validation passes.
+func (wh *WebhookHandler) validateFeatureGatesOnCreate(hc *v1beta1.HyperConverged) []string {
+	warnings := wh.validateDeprecatedFeatureGates(hc)
+	return validateOldFGOnCreate(warnings, hc)
+}
+
+// validateFeatureGatesOnUpdate is the same as validateFeatureGatesOnCreate, for updates
+func (wh *WebhookHandler) validateFeatureGatesOnUpdate(requested, exists *v1beta1.HyperConverged) []string {
+	warnings := wh.validateDeprecatedFeatureGates(requested)
+	return validateOldFGOnUpdate(warnings, requested, exists)
+}
+
 func (wh *WebhookHandler) validateDeprecatedFeatureGates(hc *v1beta1.HyperConverged) []string {
 	var warnings []string
 
@@ -14,6 +14,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -198,7 +199,7 @@ var _ = Describe("webhooks validator", func() {
 		},
 			Entry("should accept creation of a resource with a valid kv annotation",
 				map[string]string{common.JSONPatchKVAnnotationName: validKvAnnotation},
-				Succeed(),
+				BeAssignableToTypeOf(&ValidationWarning{}),
 			),
 			Entry("should reject creation of a resource with an invalid kv annotation",
 				map[string]string{common.JSONPatchKVAnnotationName: invalidKvAnnotation},
@@ -206,7 +207,7 @@ var _ = Describe("webhooks validator", func() {
 			),
 			Entry("should accept creation of a resource with a valid cdi annotation",
 				map[string]string{common.JSONPatchCDIAnnotationName: validCdiAnnotation},
-				Succeed(),
+				BeAssignableToTypeOf(&ValidationWarning{}),
 			),
 			Entry("should reject creation of a resource with an invalid cdi annotation",
 				map[string]string{common.JSONPatchCDIAnnotationName: invalidCdiAnnotation},
@@ -214,7 +215,7 @@ var _ = Describe("webhooks validator", func() {
 			),
 			Entry("should accept creation of a resource with a valid cna annotation",
 				map[string]string{common.JSONPatchCNAOAnnotationName: validCnaAnnotation},
-				Succeed(),
+				BeAssignableToTypeOf(&ValidationWarning{}),
 			),
 			Entry("should reject creation of a resource with an invalid cna annotation",
 				map[string]string{common.JSONPatchCNAOAnnotationName: invalidCnaAnnotation},
@@ -222,7 +223,7 @@ var _ = Describe("webhooks validator", func() {
 			),
 			Entry("should accept creation of a resource with a valid ssp annotation",
 				map[string]string{common.JSONPatchSSPAnnotationName: validSspAnnotation},
-				Succeed(),
+				BeAssignableToTypeOf(&ValidationWarning{}),
 			),
 			Entry("should reject creation of a resource with an invalid ssp annotation",
 				map[string]string{common.JSONPatchSSPAnnotationName: invalidSspAnnotation},
@@ -469,6 +470,276 @@ var _ = Describe("webhooks validator", func() {
 			})
 		})
 
+		Context("validate tuning policy", func() {
+			It("should accept a valid custom tuning policy", func() {
+				cr.Spec.TuningPolicy = v1beta1.HyperConvergedCustomTuningPolicy
+				cr.Spec.CustomTuningPolicy = &v1beta1.CustomTuningPolicy{
+					Default: &v1beta1.ComponentRateLimiter{QPS: resource.MustParse("100"), Burst: 200},
+					Handler: &v1beta1.ComponentRateLimiter{QPS: resource.MustParse("50"), Burst: 75},
+				}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(Succeed())
+			})
+
+			DescribeTable("should reject an invalid tuning policy", func(policy v1beta1.HyperConvergedTuningPolicy, custom *v1beta1.CustomTuningPolicy, annotations map[string]string, errMsg string) {
+				cr.Spec.TuningPolicy = policy
+				cr.Spec.CustomTuningPolicy = custom
+				cr.Annotations = annotations
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(MatchError(ContainSubstring(errMsg)))
+			},
+				Entry("custom tuning policy without customTuningPolicy",
+					v1beta1.HyperConvergedCustomTuningPolicy, nil, nil,
+					"spec.customTuningPolicy must be set",
+				),
+				Entry("empty customTuningPolicy",
+					v1beta1.HyperConvergedCustomTuningPolicy, &v1beta1.CustomTuningPolicy{}, nil,
+					"spec.customTuningPolicy must contain at least one rate limiter",
+				),
+				Entry("zero qps",
+					v1beta1.HyperConvergedCustomTuningPolicy,
+					&v1beta1.CustomTuningPolicy{Webhook: &v1beta1.ComponentRateLimiter{Burst: 200}}, nil,
+					"spec.customTuningPolicy.webhook: qps and burst must be positive",
+				),
+				Entry("customTuningPolicy with another tuning policy",
+					v1beta1.HyperConvergedHighBurstProfile,
+					&v1beta1.CustomTuningPolicy{Default: &v1beta1.ComponentRateLimiter{QPS: resource.MustParse("100"), Burst: 200}}, nil,
+					"spec.customTuningPolicy can only be set when spec.tuningPolicy is \"custom\"",
+				),
+				Entry("custom tuning policy with the tuningPolicy annotation",
+					v1beta1.HyperConvergedCustomTuningPolicy,
+					&v1beta1.CustomTuningPolicy{Default: &v1beta1.ComponentRateLimiter{QPS: resource.MustParse("100"), Burst: 200}},
+					map[string]string{common.TuningPolicyAnnotationName: `{"qps": 100, "burst": 200}`},
+					"the hco.kubevirt.io/tuningPolicy annotation can't be used with the \"custom\" tuning policy",
+				),
+			)
+		})
+
+		Context("validate maintenance windows", func() {
+			It("should accept valid maintenance windows", func() {
+				cr.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []v1beta1.MaintenanceWindow{
+					{Schedule: "0 1 * * 6,0", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+					{Schedule: "30 22 1 * *", Duration: metav1.Duration{Duration: time.Hour}},
+				}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(Succeed())
+			})
+
+			DescribeTable("should reject an invalid maintenance window", func(window v1beta1.MaintenanceWindow, errMsg string) {
+				cr.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []v1beta1.MaintenanceWindow{window}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(MatchError(ContainSubstring(errMsg)))
+			},
+				Entry("wrong number of fields",
+					v1beta1.MaintenanceWindow{Schedule: "0 1 * *", Duration: metav1.Duration{Duration: time.Hour}},
+					"spec.workloadUpdateStrategy.maintenanceWindows[0]: invalid schedule",
+				),
+				Entry("value out of range",
+					v1beta1.MaintenanceWindow{Schedule: "0 25 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+					"invalid value \"25\" in the hour field",
+				),
+				Entry("zero duration",
+					v1beta1.MaintenanceWindow{Schedule: "0 1 * * *"},
+					"spec.workloadUpdateStrategy.maintenanceWindows[0]: the duration must be positive",
+				),
+			)
+		})
+
+		Context("validate boot image registry mirrors", func() {
+			It("should accept valid registry mirrors", func() {
+				cr.Spec.BootImageRegistryMirrors = []v1beta1.RegistryMirror{
+					{Source: "quay.io", Mirror: "mirror.example.com:5000"},
+					{Source: "quay.io/containerdisks", Mirror: "mirror.example.com:5000/golden-images"},
+				}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(Succeed())
+			})
+
+			DescribeTable("should reject an invalid registry mirror", func(mirror v1beta1.RegistryMirror, errMsg string) {
+				cr.Spec.BootImageRegistryMirrors = []v1beta1.RegistryMirror{mirror}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(MatchError(ContainSubstring(errMsg)))
+			},
+				Entry("source with a scheme",
+					v1beta1.RegistryMirror{Source: "docker://quay.io", Mirror: "mirror.example.com"},
+					`spec.bootImageRegistryMirrors[0].source: "docker://quay.io" must not contain a scheme`,
+				),
+				Entry("mirror with a tag",
+					v1beta1.RegistryMirror{Source: "quay.io", Mirror: "mirror.example.com/images:latest"},
+					`spec.bootImageRegistryMirrors[0].mirror: "mirror.example.com/images:latest" must not contain a tag or a digest`,
+				),
+			)
+
+			It("should reject invalid registry mirrors on update", func() {
+				newCR := cr.DeepCopy()
+				newCR.Spec.BootImageRegistryMirrors = []v1beta1.RegistryMirror{{Source: "quay.io", Mirror: "quay.io"}}
+
+				Expect(wh.ValidateUpdate(ctx, dryRun, newCR, cr)).To(MatchError(ContainSubstring("spec.bootImageRegistryMirrors[0]: the mirror must be different than the source")))
+			})
+		})
+
+		Context("validate alert rule overrides", func() {
+			It("should accept valid alert rule overrides", func() {
+				cr.Spec.AlertRuleOverrides = []v1beta1.AlertRuleOverride{
+					{Alert: "HighCPUWorkload", Threshold: ptr.To("0.95"), Severity: ptr.To("critical")},
+					{Alert: "KubeVirtCRModified", Disabled: true},
+					{Alert: "VMAvailabilityErrorBudgetBurn", Labels: map[string]string{"team": "virt"}},
+				}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(Succeed())
+			})
+
+			DescribeTable("should reject an invalid alert rule override", func(override v1beta1.AlertRuleOverride, errMsg string) {
+				cr.Spec.AlertRuleOverrides = []v1beta1.AlertRuleOverride{override}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(MatchError(ContainSubstring(errMsg)))
+			},
+				Entry("unknown alert",
+					v1beta1.AlertRuleOverride{Alert: "HighCPUWorkloads", Disabled: true},
+					`spec.alertRuleOverrides: unknown alert "HighCPUWorkloads"`,
+				),
+				Entry("threshold out of range",
+					v1beta1.AlertRuleOverride{Alert: "HighCPUWorkload", Threshold: ptr.To("95")},
+					`spec.alertRuleOverrides: invalid override of the HighCPUWorkload alert: invalid threshold "95"; the threshold must be a ratio between 0 and 1`,
+				),
+				Entry("threshold of an alert with no configurable threshold",
+					v1beta1.AlertRuleOverride{Alert: "KubeVirtCRModified", Threshold: ptr.To("0.5")},
+					"spec.alertRuleOverrides: invalid override of the KubeVirtCRModified alert: the alert has no configurable threshold",
+				),
+			)
+
+			It("should reject invalid alert rule overrides on update", func() {
+				newCR := cr.DeepCopy()
+				newCR.Spec.AlertRuleOverrides = []v1beta1.AlertRuleOverride{{Alert: "PersistentVolumeFillingUp", Threshold: ptr.To("1.5")}}
+
+				Expect(wh.ValidateUpdate(ctx, dryRun, newCR, cr)).To(MatchError(ContainSubstring("the threshold must be a ratio between 0 and 1")))
+			})
+		})
+
+		Context("validate service level objectives", func() {
+			It("should accept valid service level objectives", func() {
+				cr.Spec.ServiceLevelObjectives = []v1beta1.ServiceLevelObjective{
+					{Name: "vm-availability", Indicator: v1beta1.VMAvailabilityIndicator, Objective: "99.9"},
+					{Name: "migrations", Indicator: v1beta1.LiveMigrationSuccessIndicator, Objective: "99", Window: &metav1.Duration{Duration: 7 * 24 * time.Hour}},
+				}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(Succeed())
+			})
+
+			DescribeTable("should reject an invalid service level objective", func(objective v1beta1.ServiceLevelObjective, errMsg string) {
+				cr.Spec.ServiceLevelObjectives = []v1beta1.ServiceLevelObjective{objective}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(MatchError(ContainSubstring(errMsg)))
+			},
+				Entry("unknown indicator",
+					v1beta1.ServiceLevelObjective{Name: "slo", Indicator: "Unknown", Objective: "99"},
+					`spec.serviceLevelObjectives: invalid service level objective slo: unknown indicator "Unknown"`,
+				),
+				Entry("zero objective",
+					v1beta1.ServiceLevelObjective{Name: "slo", Indicator: v1beta1.VMAvailabilityIndicator, Objective: "0"},
+					"spec.serviceLevelObjectives: invalid service level objective slo: objective must be greater than 0 and less than 100",
+				),
+				Entry("short window",
+					v1beta1.ServiceLevelObjective{Name: "slo", Indicator: v1beta1.VMAvailabilityIndicator, Objective: "99", Window: &metav1.Duration{Duration: time.Hour}},
+					"spec.serviceLevelObjectives: invalid service level objective slo: window must be at least 24h",
+				),
+			)
+
+			It("should reject invalid service level objectives on update", func() {
+				newCR := cr.DeepCopy()
+				newCR.Spec.ServiceLevelObjectives = []v1beta1.ServiceLevelObjective{
+					{Name: "slo", Indicator: v1beta1.BootImageFreshnessIndicator, Objective: "99", Window: &metav1.Duration{Duration: 12 * time.Hour}},
+				}
+
+				Expect(wh.ValidateUpdate(ctx, dryRun, newCR, cr)).To(MatchError(ContainSubstring("window must be at least 24h")))
+			})
+		})
+
+		Context("validate boot image architecture policy", func() {
+			It("should accept a valid architecture policy", func() {
+				cr.Spec.BootImageArchitecturePolicy = &v1beta1.BootImageArchitecturePolicy{
+					ArchitecturePolicy: v1beta1.ArchitecturePolicy{
+						Architectures:         []string{"amd64", "arm64"},
+						PreferredArchitecture: "amd64",
+						MinArchitectures:      ptr.To[int32](2),
+					},
+				}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(Succeed())
+			})
+
+			It("should reject an invalid architecture policy", func() {
+				cr.Spec.BootImageArchitecturePolicy = &v1beta1.BootImageArchitecturePolicy{
+					ArchitecturePolicy: v1beta1.ArchitecturePolicy{
+						Architectures:         []string{"amd64", "arm64"},
+						PreferredArchitecture: "s390x",
+					},
+				}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(MatchError(ContainSubstring(`spec.bootImageArchitecturePolicy: the preferred architecture "s390x" is not in the architecture list`)))
+			})
+
+			It("should reject an invalid architecture policy on update", func() {
+				newCR := cr.DeepCopy()
+				newCR.Spec.BootImageArchitecturePolicy = &v1beta1.BootImageArchitecturePolicy{
+					Templates: []v1beta1.DataImportCronTemplateArchitecturePolicy{
+						{
+							Name: "centos-stream10-image-cron",
+							ArchitecturePolicy: v1beta1.ArchitecturePolicy{
+								Architectures:    []string{"amd64"},
+								MinArchitectures: ptr.To[int32](2),
+							},
+						},
+					},
+				}
+
+				Expect(wh.ValidateUpdate(ctx, dryRun, newCR, cr)).To(MatchError(ContainSubstring("spec.bootImageArchitecturePolicy: templates[0]: minArchitectures is 2, but only 1 architectures are listed")))
+			})
+		})
+
+		Context("validate live migration dedicated network", func() {
+			It("should accept a valid dedicated network", func() {
+				cr.Spec.LiveMigrationConfig.DedicatedNetwork = &v1beta1.LiveMigrationNetwork{
+					Interface:     "eth1",
+					VLAN:          ptr.To[int32](100),
+					IPRange:       "10.200.5.0/24",
+					ExcludeRanges: []string{"10.200.5.0/28"},
+				}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(Succeed())
+			})
+
+			It("should reject an invalid IP range", func() {
+				cr.Spec.LiveMigrationConfig.DedicatedNetwork = &v1beta1.LiveMigrationNetwork{
+					Interface: "eth1",
+					IPRange:   "10.200.5.0/33",
+				}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(MatchError(ContainSubstring(`spec.liveMigrationConfig: dedicatedNetwork.ipRange: "10.200.5.0/33" is not a valid CIDR`)))
+			})
+
+			It("should reject setting both the network and the dedicated network", func() {
+				cr.Spec.LiveMigrationConfig.Network = ptr.To("my-network")
+				cr.Spec.LiveMigrationConfig.DedicatedNetwork = &v1beta1.LiveMigrationNetwork{
+					Interface: "eth1",
+					IPRange:   "10.200.5.0/24",
+				}
+
+				Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(MatchError(ContainSubstring("spec.liveMigrationConfig: the network and the dedicatedNetwork fields can't be set together")))
+			})
+
+			It("should reject an exclude range outside the IP range on update", func() {
+				newCR := cr.DeepCopy()
+				newCR.Spec.LiveMigrationConfig.DedicatedNetwork = &v1beta1.LiveMigrationNetwork{
+					Interface:     "eth1",
+					IPRange:       "10.200.5.0/24",
+					ExcludeRanges: []string{"192.168.0.0/28"},
+				}
+
+				Expect(wh.ValidateUpdate(ctx, dryRun, newCR, cr)).To(MatchError(ContainSubstring(`spec.liveMigrationConfig: dedicatedNetwork.excludeRanges[0]: "192.168.0.0/28" is not within the "10.200.5.0/24" IP range`)))
+			})
+		})
+
 		Context("validate deprecated FGs", func() {
 			DescribeTable("should return warning for deprecated feature gate", func(fgs v1beta1.HyperConvergedFeatureGates, fgNames ...string) {
 				cr.Spec.FeatureGates = fgs
@@ -1401,6 +1672,12 @@ var _ = Describe("webhooks validator", func() {
 		})
 
 		Context("validate deprecated FGs", func() {
+			// the deprecated feature gates don't stop the validation, so the operands must exist for the dry-run update
+			var wh *WebhookHandler
+			BeforeEach(func() {
+				wh = NewWebhookHandler(logger, getFakeClient(hco), decoder, HcoValidNamespace, true, nil)
+			})
+
 			DescribeTable("should return warning for deprecated feature gate", func(fgs v1beta1.HyperConvergedFeatureGates, fgNames ...string) {
 				newHCO := hco.DeepCopy()
 				newHCO.Spec.FeatureGates = fgs
@@ -1453,6 +1730,12 @@ var _ = Describe("webhooks validator", func() {
 		})
 
 		Context("validate moved FG on update", func() {
+			// the deprecated feature gates don't stop the validation, so the operands must exist for the dry-run update
+			var wh *WebhookHandler
+			BeforeEach(func() {
+				wh = NewWebhookHandler(logger, getFakeClient(hco), decoder, HcoValidNamespace, true, nil)
+			})
+
 			//nolint:staticcheck
 			DescribeTable("should return warning for enableApplicationAwareQuota on update", func(newFG, oldFG *bool) {
 				newHCO := hco.DeepCopy()
@@ -1783,6 +2066,123 @@ var _ = Describe("webhooks validator", func() {
 		)
 	})
 
+	Context("preview jsonpatch annotations", func() {
+		var hco *v1beta1.HyperConverged
+		var wh *WebhookHandler
+		BeforeEach(func() {
+			Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(Succeed())
+			hco = commontestutils.NewHco()
+			wh = NewWebhookHandler(logger, getFakeClient(hco), decoder, HcoValidNamespace, true, nil)
+		})
+
+		It("should return the patch modifications as warnings on create", func() {
+			hco.Annotations = map[string]string{common.JSONPatchKVAnnotationName: validKvAnnotation}
+
+			err := wh.ValidateCreate(context.TODO(), false, hco)
+			expected := &ValidationWarning{}
+			Expect(errors.As(err, &expected)).To(BeTrue())
+
+			Expect(expected.Warnings()).To(HaveLen(3))
+			Expect(expected.Warnings()).To(ContainElements(
+				`the kubevirt.kubevirt.io/jsonpatch annotation modifies the KubeVirt /spec/configuration/cpuRequest field from <unset> to "12m"`,
+				And(
+					HavePrefix(`the kubevirt.kubevirt.io/jsonpatch annotation modifies the KubeVirt /spec/configuration/developerConfiguration/featureGates field from ["CPUManager",`),
+					HaveSuffix(`] to ["fg1","fg2"]`),
+				),
+				"the values set by the kubevirt.kubevirt.io/jsonpatch annotation are not validated against the KubeVirt CRD schema, and may still be rejected",
+			))
+		})
+
+		It("should return the patch modifications as warnings on update, if the annotation was modified", func() {
+			newHco := hco.DeepCopy()
+			newHco.Annotations = map[string]string{common.JSONPatchCNAOAnnotationName: validCnaAnnotation}
+
+			err := wh.ValidateUpdate(context.TODO(), false, newHco, hco)
+			expected := &ValidationWarning{}
+			Expect(errors.As(err, &expected)).To(BeTrue())
+
+			Expect(expected.Warnings()).To(ConsistOf(
+				`the networkaddonsconfigs.kubevirt.io/jsonpatch annotation modifies the NetworkAddonsConfig /spec/imagePullPolicy field from <unset> to "Always"`,
+				`the networkaddonsconfigs.kubevirt.io/jsonpatch annotation modifies the NetworkAddonsConfig /spec/kubeMacPool/rangeEnd field from <unset> to "5.5.5.5.5.5"`,
+				`the networkaddonsconfigs.kubevirt.io/jsonpatch annotation modifies the NetworkAddonsConfig /spec/kubeMacPool/rangeStart field from <unset> to "1.1.1.1.1.1"`,
+				"the values set by the networkaddonsconfigs.kubevirt.io/jsonpatch annotation are not validated against the NetworkAddonsConfig CRD schema, and may still be rejected",
+			))
+		})
+
+		DescribeTable("should reject an invalid patch, with a deprecated feature gate", func(validate func(*v1beta1.HyperConverged) error) {
+			hco.Spec.FeatureGates.WithHostPassthroughCPU = ptr.To(true) //nolint:staticcheck
+			hco.Annotations = map[string]string{common.JSONPatchKVAnnotationName: `[{"op": "add", "path": "/spec/configuration/cpuRequests", "value": "12m"}]`}
+
+			err := validate(hco)
+			Expect(err).To(MatchError(ContainSubstring(`unknown field "cpuRequests"`)))
+			Expect(errors.As(err, new(*ValidationWarning))).To(BeFalse())
+		},
+			Entry("on create", func(hc *v1beta1.HyperConverged) error {
+				return wh.ValidateCreate(context.TODO(), false, hc)
+			}),
+			Entry("on update", func(hc *v1beta1.HyperConverged) error {
+				return wh.ValidateUpdate(context.TODO(), false, hc, commontestutils.NewHco())
+			}),
+		)
+
+		It("should return the warnings of the deprecated feature gates and of the patch together", func() {
+			newHco := hco.DeepCopy()
+			newHco.Spec.FeatureGates.WithHostPassthroughCPU = ptr.To(true) //nolint:staticcheck
+			newHco.Annotations = map[string]string{common.JSONPatchCNAOAnnotationName: validCnaAnnotation}
+
+			err := wh.ValidateUpdate(context.TODO(), false, newHco, hco)
+			expected := &ValidationWarning{}
+			Expect(errors.As(err, &expected)).To(BeTrue())
+
+			Expect(expected.Warnings()).To(HaveLen(5))
+			Expect(expected.Warnings()).To(ContainElements(
+				ContainSubstring("spec.featureGates.withHostPassthroughCPU is deprecated"),
+				`the networkaddonsconfigs.kubevirt.io/jsonpatch annotation modifies the NetworkAddonsConfig /spec/imagePullPolicy field from <unset> to "Always"`,
+			))
+		})
+
+		It("should not return warnings on update, if the annotation was not modified", func() {
+			hco.Annotations = map[string]string{common.JSONPatchCDIAnnotationName: validCdiAnnotation}
+			newHco := hco.DeepCopy()
+			newHco.Spec.UninstallStrategy = v1beta1.HyperConvergedUninstallStrategyRemoveWorkloads
+
+			Expect(wh.ValidateUpdate(context.TODO(), false, newHco, hco)).To(Succeed())
+		})
+
+		It("should warn if the patch does not modify the operand", func() {
+			hco.Annotations = map[string]string{common.JSONPatchKVAnnotationName: `[{"op": "test", "path": "/spec/uninstallStrategy", "value": "BlockUninstallIfWorkloadsExist"}]`}
+
+			err := wh.ValidateCreate(context.TODO(), false, hco)
+			expected := &ValidationWarning{}
+			Expect(errors.As(err, &expected)).To(BeTrue())
+
+			Expect(expected.Warnings()).To(ConsistOf("the kubevirt.kubevirt.io/jsonpatch annotation does not modify the KubeVirt CR"))
+		})
+
+		DescribeTable("should reject a patch that sets unknown fields", func(validate func(*v1beta1.HyperConverged) error) {
+			hco.Annotations = map[string]string{common.JSONPatchKVAnnotationName: `[{"op": "add", "path": "/spec/configuration/cpuRequests", "value": "12m"}]`}
+
+			Expect(validate(hco)).To(MatchError(And(
+				ContainSubstring("invalid jsonPatch in the kubevirt.kubevirt.io/jsonpatch annotation"),
+				ContainSubstring(`unknown field "cpuRequests"`),
+			)))
+		},
+			Entry("on create", func(hc *v1beta1.HyperConverged) error {
+				return wh.ValidateCreate(context.TODO(), false, hc)
+			}),
+			Entry("on update", func(hc *v1beta1.HyperConverged) error {
+				return wh.ValidateUpdate(context.TODO(), false, hc, commontestutils.NewHco())
+			}),
+		)
+
+		It("should ignore the ssp annotation on kubernetes", func() {
+			wh.isOpenshift = false
+			hco.Annotations = map[string]string{common.JSONPatchSSPAnnotationName: validSspAnnotation}
+
+			Expect(wh.ValidateCreate(context.TODO(), false, hco)).To(Succeed())
+		})
+	})
+
 	Context("hcoTLSConfigCache", func() {
 		var cr *v1beta1.HyperConverged
 		var ctx context.Context
@@ -6,12 +6,12 @@ import (
 	"fmt"
 	"net/http"
 	"os/exec"
+	"slices"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
-	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -65,32 +65,19 @@ var _ = Describe("Observability Controller", Label(tests.OpenshiftLabel, testNam
 			Expect(err).ToNot(HaveOccurred())
 
 			// PodDisruptionBudgetAtLimit silence should have been created by the controller
-			podDisruptionBudgetAtLimitSilence := observability.FindPodDisruptionBudgetAtLimitSilence(amSilences)
-			Expect(podDisruptionBudgetAtLimitSilence).ToNot(BeNil())
+			idx := slices.IndexFunc(amSilences, observability.IsPodDisruptionBudgetAtLimitSilence)
+			Expect(idx).To(BeNumerically(">=", 0))
 
-			err = amAPI.DeleteSilence(podDisruptionBudgetAtLimitSilence.ID)
+			err = amAPI.DeleteSilence(amSilences[idx].ID)
 			Expect(err).ToNot(HaveOccurred())
 
-			// Restart pod to force reconcile (reconcile periodicity is 1h)
-			var hcoPods v1.PodList
-			err = cli.List(ctx, &hcoPods, &client.MatchingLabels{
-				"name": "hyperconverged-cluster-operator",
-			})
-			Expect(err).ToNot(HaveOccurred())
-			Expect(hcoPods.Items).ToNot(BeEmpty())
-
-			for _, pod := range hcoPods.Items {
-				err = cli.Delete(ctx, &pod)
-				Expect(err).ToNot(HaveOccurred())
-			}
-
-			// Wait for the controller to recreate the silence
+			// Wait for the controller to recreate the silence, on the next poll of the silences
 			Eventually(func() bool {
 				amSilences, err := amAPI.ListSilences()
 				Expect(err).ToNot(HaveOccurred())
 
-				return observability.FindPodDisruptionBudgetAtLimitSilence(amSilences) != nil
-			}, "5m", "10s").Should(BeTrue())
+				return slices.ContainsFunc(amSilences, observability.IsPodDisruptionBudgetAtLimitSilence)
+			}, "7m", "10s").Should(BeTrue())
 		})
 	})
 })
@@ -35,7 +35,7 @@ func init() {
 }
 
 func main() {
-	crd, err := getOperatorCRD()
+	crds, err := getOperatorCRDs()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "Failed to generate CRD", err)
 		panic(err)
@@ -54,14 +54,25 @@ func main() {
 		output = f
 	}
 
-	err = util.MarshallObject(crd, output)
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Failed to marshall CRD", err)
-		panic(err)
+	for _, crd := range crds {
+		err = util.MarshallObject(crd, output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to marshall CRD", err)
+			panic(err)
+		}
 	}
 }
 
-func getOperatorCRD() (*extv1.CustomResourceDefinition, error) {
+// crdNames maps the kinds of the operator CRDs to the only allowed name of their CRs
+var crdNames = []struct {
+	kind   string
+	crName string
+}{
+	{kind: hcoutil.HyperConvergedKind, crName: hcov1beta1.HyperConvergedName},
+	{kind: hcoutil.HyperConvergedProfileKind, crName: hcov1beta1.HyperConvergedProfileName},
+}
+
+func getOperatorCRDs() ([]*extv1.CustomResourceDefinition, error) {
 	pkgs, err := loader.LoadRoots(importPath)
 	if err != nil {
 		return nil, err
@@ -85,28 +96,34 @@ func getOperatorCRD() (*extv1.CustomResourceDefinition, error) {
 	for _, p := range pkgs {
 		parser.NeedPackage(p)
 	}
-	groupKind := schema.GroupKind{Kind: hcoutil.HyperConvergedKind, Group: hcoutil.APIVersionGroup}
-	parser.NeedCRDFor(groupKind, nil)
+	for _, crdName := range crdNames {
+		parser.NeedCRDFor(schema.GroupKind{Kind: crdName.kind, Group: hcoutil.APIVersionGroup}, nil)
+	}
 	for _, p := range pkgs {
 		err = packageErrors(p, packages.TypeError)
 		if err != nil {
 			panic(err)
 		}
 	}
-	c := parser.CustomResourceDefinitions[groupKind]
-	// enforce validation of CR name to prevent multiple CRs
-	for _, v := range c.Spec.Versions {
-		v.Schema.OpenAPIV3Schema.Properties["metadata"] = extv1.JSONSchemaProps{
-			Type: objectType,
-			Properties: map[string]extv1.JSONSchemaProps{
-				"name": {
-					Type:    "string",
-					Pattern: hcov1beta1.HyperConvergedName,
+
+	crds := make([]*extv1.CustomResourceDefinition, 0, len(crdNames))
+	for _, crdName := range crdNames {
+		c := parser.CustomResourceDefinitions[schema.GroupKind{Kind: crdName.kind, Group: hcoutil.APIVersionGroup}]
+		// enforce validation of CR name to prevent multiple CRs
+		for _, v := range c.Spec.Versions {
+			v.Schema.OpenAPIV3Schema.Properties["metadata"] = extv1.JSONSchemaProps{
+				Type: objectType,
+				Properties: map[string]extv1.JSONSchemaProps{
+					"name": {
+						Type:    "string",
+						Pattern: crdName.crName,
+					},
 				},
-			},
+			}
 		}
+		crds = append(crds, &c)
 	}
-	return &c, nil
+	return crds, nil
 }
 
 func packageErrors(pkg *loader.Package, filterKinds ...packages.ErrorKind) error {
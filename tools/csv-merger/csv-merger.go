@@ -94,6 +94,7 @@ var (
 	passtImage          = flag.String("network-passt-binding-image-name", "", "Passt binding image")
 	passtCNIImage       = flag.String("network-passt-binding-cni-image-name", "", "Passt binding cni image")
 	waspAgentImage      = flag.String("wasp-agent-image-name", "", "Wasp Agent image")
+	monitoringBackend   = flag.String("monitoring-backend", "", "The monitoring backend of HCO; one of openshift or kube-prometheus-stack. Detected by the cluster type if empty")
 	_                   = flag.String("primary-udn-binding-image-name", "", "deprecated. This flag is ignored")
 	smbios              = flag.String("smbios", "", "Custom SMBIOS string for KubeVirt ConfigMap")
 	machinetype         = flag.String("machinetype", "", "Custom MACHINETYPE string for KubeVirt ConfigMap (Deprecated, use amd64-machinetype)")
@@ -435,6 +436,7 @@ func getDeploymentParams() *components.DeploymentOperatorParams {
 		PasstImage:             *passtImage,
 		PasstCNIImage:          *passtCNIImage,
 		WaspAgentImage:         *waspAgentImage,
+		MonitoringBackend:      *monitoringBackend,
 		Env:                    envVars,
 		AddNetworkPolicyLabels: *dumpNetworkPolicies,
 	}
@@ -62,6 +62,7 @@ var (
 	cliDownloadsImage       = flag.String("cli-downloads-image", "", "Downloads Server image")
 	kvVirtIOWinImage        = flag.String("kv-virtiowin-image-name", "", "KubeVirt VirtIO Win image")
 	waspAgentImage          = flag.String("wasp-agent-image-name", "", "wasp-agent image")
+	monitoringBackend       = flag.String("monitoring-backend", "", "The monitoring backend of HCO; one of openshift or kube-prometheus-stack. Detected by the cluster type if empty")
 	smbios                  = flag.String("smbios", "", "Custom SMBIOS string for KubeVirt ConfigMap")
 	machinetype             = flag.String("machinetype", "", "Custom MACHINETYPE string for KubeVirt ConfigMap (Deprecated, use amd64-machinetype)")
 	amd64MachineType        = flag.String("amd64-machinetype", "", "Custom AMD64_MACHINETYPE string for KubeVirt ConfigMap")
@@ -414,6 +415,7 @@ func getOperatorParameters() *components.DeploymentOperatorParams {
 		ImagePullPolicy:        "IfNotPresent",
 		VirtIOWinContainer:     *kvVirtIOWinImage,
 		WaspAgentImage:         *waspAgentImage,
+		MonitoringBackend:      *monitoringBackend,
 		Smbios:                 *smbios,
 		Machinetype:            *machinetype,
 		Amd64MachineType:       *amd64MachineType,
@@ -0,0 +1,176 @@
+// operand-renderer renders all the operand objects HCO would create for a given HyperConverged CR, without accessing
+// any cluster. Its output is a stable, multi-document YAML, so two revisions of the same HyperConverged CR can be
+// compared with a plain diff.
+//
+// The image and version environment variables (e.g. KUBEVIRT_VERSION, VIRTIOWIN_CONTAINER) are taken as is from the
+// environment of the tool, like the operator takes them from its deployment.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	netattdefv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	consolev1 "github.com/openshift/api/console/v1"
+	imagev1 "github.com/openshift/api/image/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	securityv1 "github.com/openshift/api/security/v1"
+	csvv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	sspv1beta3 "kubevirt.io/ssp-operator/api/v1beta3"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api"
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operandhandler"
+)
+
+var (
+	hcFile     string
+	factsFile  string
+	namespace  string
+	outputFile string
+)
+
+func init() {
+	flag.StringVar(&hcFile, "hc", "", "path to the HyperConverged CR yaml or json file. Mandatory")
+	flag.StringVar(&factsFile, "cluster-facts", "", "path to an optional yaml or json file with the simulated cluster facts")
+	flag.StringVar(&namespace, "namespace", "kubevirt-hyperconverged", "the namespace of the HyperConverged CR, if not set in the file")
+	flag.StringVar(&outputFile, "out", "", "output file name. Default is stdout")
+}
+
+func main() {
+	flag.Parse()
+
+	if hcFile == "" {
+		fmt.Fprintln(os.Stderr, "the --hc flag is mandatory")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	hc := &hcov1beta1.HyperConverged{}
+	if err := readFile(hcFile, hc); err != nil {
+		exitOnError(err, "can't read the HyperConverged CR")
+	}
+
+	if hc.Name == "" {
+		hc.Name = hcov1beta1.HyperConvergedName
+	}
+	if hc.Namespace == "" {
+		hc.Namespace = namespace
+	}
+
+	facts := operandhandler.ClusterFacts{}
+	if factsFile != "" {
+		if err := readFile(factsFile, &facts); err != nil {
+			exitOnError(err, "can't read the cluster facts")
+		}
+	}
+
+	objects, err := operandhandler.Render(hc, facts, getScheme())
+	if err != nil {
+		exitOnError(err, "failed to render the operands")
+	}
+
+	if outputFile == "" {
+		if err = writeObjects(os.Stdout, objects); err != nil {
+			exitOnError(err, "can't write the output")
+		}
+		return
+	}
+
+	out, err := os.Create(outputFile)
+	if err != nil {
+		exitOnError(err, "can't create output file "+outputFile)
+	}
+
+	// exitOnError does not run the deferred functions, so the file is closed explicitly before checking the error
+	err = writeObjects(out, objects)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		exitOnError(err, "can't write the output file "+outputFile)
+	}
+}
+
+func writeObjects(w io.Writer, objects []client.Object) error {
+	for _, obj := range objects {
+		if err := writeObject(w, obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readFile(fileName string, obj any) error {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	return yaml.Unmarshal(content, obj)
+}
+
+func writeObject(w io.Writer, obj any) error {
+	objBytes, err := yaml.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	if _, err = fmt.Fprintln(w, "---"); err != nil {
+		return err
+	}
+	_, err = w.Write(objBytes)
+	return err
+}
+
+func getScheme() *apiruntime.Scheme {
+	scheme := apiruntime.NewScheme()
+	for _, f := range []func(*apiruntime.Scheme) error{
+		api.AddToScheme,
+		schedulingv1.AddToScheme,
+		corev1.AddToScheme,
+		appsv1.AddToScheme,
+		rbacv1.AddToScheme,
+		networkingv1.AddToScheme,
+		cdiv1beta1.AddToScheme,
+		networkaddonsv1.AddToScheme,
+		sspv1beta3.AddToScheme,
+		csvv1alpha1.AddToScheme,
+		kubevirtcorev1.AddToScheme,
+		aaqv1alpha1.AddToScheme,
+		netattdefv1.AddToScheme,
+		monitoringv1.AddToScheme,
+		consolev1.Install,
+		routev1.Install,
+		imagev1.Install,
+		securityv1.Install,
+	} {
+		if err := f(scheme); err != nil {
+			exitOnError(err, "can't build the scheme")
+		}
+	}
+
+	return scheme
+}
+
+func exitOnError(err error, msg string) {
+	fmt.Fprintf(os.Stderr, "%s; %v\n", msg, err)
+	os.Exit(1)
+}
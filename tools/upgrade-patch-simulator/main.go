@@ -0,0 +1,156 @@
+// upgrade-patch-simulator replays the upgrade patches from an upgradePatches.json file against a corpus of
+// HyperConverged CR json files, as HCO would do when upgrading from a given source version. For each file, it prints the
+// upgrade patches that affect the source version, whether they were applied or skipped by a failed test operation,
+// and the diff between the HyperConverged CR before and after the upgrade. It also prints the objects that would be
+// removed by the upgrade.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/blang/semver/v4"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/upgradepatch"
+)
+
+var (
+	patchesFile string
+	fromVersion string
+	corpusDir   string
+	outputFile  string
+)
+
+func init() {
+	flag.StringVar(&patchesFile, "patches", "assets/upgradePatches.json", "path to the upgradePatches.json file")
+	flag.StringVar(&fromVersion, "from-version", "", "the HCO version to upgrade from. Mandatory")
+	flag.StringVar(&corpusDir, "corpus", "", "path to a directory with HyperConverged CR json files. The files can also be passed as arguments")
+	flag.StringVar(&outputFile, "out", "", "output file name. Default is stdout")
+	flag.Parse()
+
+	if fromVersion == "" {
+		fmt.Fprintln(os.Stderr, "the --from-version flag is mandatory")
+		flag.Usage()
+		os.Exit(1)
+	}
+}
+
+func main() {
+	knownHcoSV, err := semver.ParseTolerant(fromVersion)
+	if err != nil {
+		exitOnError(err, "invalid source version "+fromVersion)
+	}
+
+	patches, err := upgradepatch.ReadUpgradePatches(patchesFile)
+	if err != nil {
+		exitOnError(err, "can't read the upgrade patches file "+patchesFile)
+	}
+
+	files, err := getCorpusFiles()
+	if err != nil {
+		exitOnError(err, "can't read the corpus")
+	}
+
+	if len(files) == 0 {
+		exitOnError(fmt.Errorf("no HyperConverged CR files"), "nothing to simulate")
+	}
+
+	out := os.Stdout
+	if outputFile != "" {
+		out, err = os.Create(outputFile)
+		if err != nil {
+			exitOnError(err, "can't create output file "+outputFile)
+		}
+		defer out.Close()
+	}
+
+	if err = writeObjectsToBeRemoved(out, patches.GetObjectsToBeRemoved(knownHcoSV)); err != nil {
+		exitOnError(err, "can't write the output")
+	}
+
+	for _, file := range files {
+		if err = simulate(out, patches, knownHcoSV, file); err != nil {
+			exitOnError(err, "failed to simulate the upgrade of "+file)
+		}
+	}
+}
+
+func getCorpusFiles() ([]string, error) {
+	files := flag.Args()
+	if corpusDir != "" {
+		corpusFiles, err := filepath.Glob(filepath.Join(corpusDir, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, corpusFiles...)
+	}
+
+	return files, nil
+}
+
+func simulate(w io.Writer, patches upgradepatch.UpgradePatches, knownHcoSV semver.Version, fileName string) error {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	hc := &v1beta1.HyperConverged{}
+	if err = json.Unmarshal(content, hc); err != nil {
+		return err
+	}
+
+	patched, reports, err := patches.Apply(logr.Discard(), hc, knownHcoSV)
+	if err != nil {
+		return err
+	}
+
+	if _, err = fmt.Fprintf(w, "=== %s\n", fileName); err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		msg := "applied"
+		if report.Result == upgradepatch.PatchTestFailed {
+			msg = "skipped; a test operation failed"
+		}
+
+		if _, err = fmt.Fprintf(w, "patch #%d (%s): %s\n", report.Index, report.SemverRange, msg); err != nil {
+			return err
+		}
+	}
+
+	diff := cmp.Diff(hc, patched)
+	if diff == "" {
+		diff = "no changes\n"
+	}
+
+	_, err = fmt.Fprintf(w, "--- before\n+++ after\n%s\n", diff)
+	return err
+}
+
+func writeObjectsToBeRemoved(w io.Writer, objects []upgradepatch.ObjectToBeRemoved) error {
+	if _, err := fmt.Fprintln(w, "=== objects to be removed"); err != nil {
+		return err
+	}
+
+	for _, obj := range objects {
+		if _, err := fmt.Fprintf(w, "%s %s\n", obj.GroupVersionKind.String(), obj.ObjectKey.String()); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+func exitOnError(err error, msg string) {
+	fmt.Fprintf(os.Stderr, "%s; %v\n", msg, err)
+	os.Exit(1)
+}